@@ -88,6 +88,8 @@ import (
 	alliancetypes "github.com/terra-money/alliance/x/alliance/types"
 	feeshare "github.com/terra-money/core/v2/x/feeshare"
 	feesharetypes "github.com/terra-money/core/v2/x/feeshare/types"
+	"github.com/terra-money/core/v2/x/tokenfactory"
+	tokenfactorytypes "github.com/terra-money/core/v2/x/tokenfactory/types"
 
 	tmjson "github.com/cometbft/cometbft/libs/json"
 
@@ -201,6 +203,7 @@ func NewTerraApp(
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
 		invCheckPeriod:    invCheckPeriod,
+		basicManager:      ModuleBasics,
 	}
 	app.Keepers = keepers.NewTerraAppKeepers(
 		appCodec,
@@ -275,6 +278,9 @@ func NewTerraApp(
 			FeeShareKeeper: app.Keepers.FeeShareKeeper,
 			BankKeeper:     app.Keepers.BankKeeper,
 			WasmKeeper:     app.Keepers.WasmKeeper,
+
+			DistributionKeeper: app.Keepers.DistrKeeper,
+			AccountKeeper:      app.Keepers.AccountKeeper,
 		},
 	)
 
@@ -457,7 +463,8 @@ func (app *TerraApp) SimulationManager() *module.SimulationManager {
 		packetforward.NewAppModule(&app.Keepers.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		customwasmodule.NewAppModule(appCodec, &app.Keepers.WasmKeeper, app.Keepers.StakingKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.BaseApp.MsgServiceRouter(), app.Keepers.GetSubspace(wasmtypes.ModuleName)),
 		alliance.NewAppModule(appCodec, app.Keepers.AllianceKeeper, app.Keepers.StakingKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.interfaceRegistry, app.Keepers.GetSubspace(alliancetypes.ModuleName)),
-		feeshare.NewAppModule(app.Keepers.FeeShareKeeper, app.Keepers.AccountKeeper, app.GetSubspace(feesharetypes.ModuleName)),
+		tokenfactory.NewAppModule(app.Keepers.TokenFactoryKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
+		feeshare.NewAppModule(app.Keepers.FeeShareKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.Keepers.WasmKeeper, app.GetSubspace(feesharetypes.ModuleName)),
 	)
 
 	sm.RegisterStoreDecoders()
@@ -482,7 +489,8 @@ func (app *TerraApp) GetWasmOpts(appOpts servertypes.AppOptions) []wasmkeeper.Op
 	wasmOpts = append(wasmOpts, custom_queriers.RegisterCustomPlugins(
 		&app.Keepers.BankKeeper.BaseKeeper,
 		&app.Keepers.TokenFactoryKeeper,
-		&app.Keepers.AllianceKeeper)...,
+		&app.Keepers.AllianceKeeper,
+		&app.Keepers.FeeShareKeeper)...,
 	)
 
 	return wasmOpts
@@ -223,4 +223,5 @@ const (
 	Upgrade2_11   = "v2.11"
 	Upgrade2_12   = "v2.12"
 	Upgrade2_13   = "v2.13"
+	Upgrade2_14   = "v2.14"
 )
@@ -9,6 +9,8 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	alliancebindings "github.com/terra-money/alliance/x/alliance/bindings"
 	alliancekeeper "github.com/terra-money/alliance/x/alliance/keeper"
+	feesharebindings "github.com/terra-money/core/v2/x/feeshare/bindings"
+	feesharekeeper "github.com/terra-money/core/v2/x/feeshare/keeper"
 	tokenfactorybindings "github.com/terra-money/core/v2/x/tokenfactory/bindings"
 	tokenfactorykeeper "github.com/terra-money/core/v2/x/tokenfactory/keeper"
 
@@ -35,10 +37,12 @@ func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
 	allianceKeeper *alliancekeeper.Keeper,
+	feeShare *feesharekeeper.Keeper,
 ) []wasmkeeper.Option {
 	tfQuerier := tokenfactorybindings.CustomQuerier(tokenfactorybindings.NewQueryPlugin(bank, tokenFactory))
 	allianceQuerier := alliancebindings.CustomQuerier(alliancebindings.NewAllianceQueryPlugin(allianceKeeper))
-	queriers := CustomQueriers(tfQuerier, allianceQuerier)
+	feeShareQuerier := feesharebindings.CustomQuerier(feesharebindings.NewQueryPlugin(feeShare))
+	queriers := CustomQueriers(tfQuerier, allianceQuerier, feeShareQuerier)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: queriers,
@@ -46,9 +50,15 @@ func RegisterCustomPlugins(
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
 		tokenfactorybindings.CustomMessageDecorator(bank, tokenFactory),
 	)
+	// The feeshare messenger wraps the token factory messenger
+	// and forwards the custom messages it doesn't handle to it.
+	feeShareMessengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
+		feesharebindings.CustomMessageDecorator(feeShare),
+	)
 
 	return []wasm.Option{
 		queryPluginOpt,
 		messengerDecoratorOpt,
+		feeShareMessengerDecoratorOpt,
 	}
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	alliancebindings "github.com/terra-money/alliance/x/alliance/bindings"
 	"github.com/terra-money/alliance/x/alliance/bindings/types"
+	feesharebindings "github.com/terra-money/core/v2/x/feeshare/bindings"
+	feesharebindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
 	"github.com/terra-money/core/v2/x/tokenfactory/bindings"
 	types2 "github.com/terra-money/core/v2/x/tokenfactory/bindings/types"
 
@@ -89,6 +91,34 @@ func TestWithTfAndAllianceButCallTf(t *testing.T) {
 	require.Fail(t, "should panic")
 }
 
+func TestWithTfAllianceAndFeeShareButCallFeeShare(t *testing.T) {
+	tfQuerier := bindings.CustomQuerier(&bindings.QueryPlugin{})
+	allianceQuerier := alliancebindings.CustomQuerier(&alliancebindings.QueryPlugin{})
+	feeShareQuerier := feesharebindings.CustomQuerier(&feesharebindings.QueryPlugin{})
+	querier := CustomQueriers(tfQuerier, allianceQuerier, feeShareQuerier)
+
+	query := feesharebindingstypes.FeeShareQuery{
+		FeeShare: &feesharebindingstypes.FeeShareQueries{
+			Params: &feesharebindingstypes.GetParams{},
+		},
+	}
+	bz, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	defer func() {
+		if r := recover(); r != nil {
+			stack := make([]byte, 1024)
+			runtime.Stack(stack, false)
+			// We make sure feeshare is called here
+			require.Containsf(t, string(stack), "feeshare/bindings.QueryPlugin.GetParams", "")
+		}
+	}()
+
+	// We call querier but it will panic because we don't have a keeper
+	_, err = querier(sdk.Context{}, bz)
+	require.Fail(t, "should panic")
+}
+
 func TestWithTfAndAllianceButRandomCall(t *testing.T) {
 	tfQuerier := bindings.CustomQuerier(&bindings.QueryPlugin{})
 	allianceQuerier := alliancebindings.CustomQuerier(&alliancebindings.QueryPlugin{})
@@ -104,6 +134,6 @@ func TestWithTfAndAllianceButRandomCall(t *testing.T) {
 }
 
 func TestRegisterCustomPlugins(t *testing.T) {
-	options := RegisterCustomPlugins(nil, nil, nil)
-	require.Len(t, options, 2)
+	options := RegisterCustomPlugins(nil, nil, nil, nil)
+	require.Len(t, options, 3)
 }
@@ -0,0 +1,34 @@
+package app_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
+)
+
+func (s *AppGenesisTestSuite) TestExecutedContractsDoNotAffectAppHash() {
+	s.Setup()
+	cms := s.App.CommitMultiStore()
+	appHash := cms.Commit().Hash
+
+	// Track an executed contract as the custom wasm
+	// message server does during a transaction.
+	cacheMs := cms.CacheMultiStore()
+	ctx := s.Ctx.WithMultiStore(cacheMs)
+	err := s.App.Keepers.WasmKeeper.AfterContractCall(ctx, s.TestAccs[0].String(), 100)
+	s.Require().NoError(err)
+	cacheMs.Write()
+
+	executedContracts, found := s.App.Keepers.WasmKeeper.GetExecutedContractAddresses(s.Ctx.WithMultiStore(cms))
+	s.Require().True(found)
+	s.Require().Equal([]string{s.TestAccs[0].String()}, executedContracts.ContractAddresses)
+	s.Require().Equal(appHash, cms.Commit().Hash)
+
+	// The transient store is cleared on commit
+	_, found = s.App.Keepers.WasmKeeper.GetExecutedContractAddresses(s.Ctx.WithMultiStore(cms))
+	s.Require().False(found)
+
+	// Writing the same data in the persistent store changes the app hash
+	cms.GetKVStore(s.App.GetKey(wasmtypes.StoreKey)).Set(customwasmtypes.GetExecutedContractsKey(), []byte{1})
+	s.Require().NotEqual(appHash, cms.Commit().Hash)
+}
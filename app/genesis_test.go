@@ -240,7 +240,7 @@ func (s *AppGenesisTestSuite) TestMigration() {
 		"distribution":           3,
 		"evidence":               1,
 		"feegrant":               2,
-		"feeshare":               2,
+		"feeshare":               6,
 		"feeibc":                 1,
 		"genutil":                1,
 		"gov":                    4,
@@ -254,7 +254,7 @@ func (s *AppGenesisTestSuite) TestMigration() {
 		"params":                 1,
 		"slashing":               3,
 		"staking":                4,
-		"tokenfactory":           3,
+		"tokenfactory":           5,
 		"transfer":               3,
 		"upgrade":                2,
 		"vesting":                1,
@@ -356,9 +356,25 @@ func (s *AppGenesisTestSuite) TestGenesis() {
 			"params": {
 				"enable_fee_share": true,
 				"developer_shares": "0.500000000000000000",
-				"allowed_denoms": []
+				"allowed_denoms": [],
+				"distribution_mode": "DISTRIBUTION_MODE_EQUAL",
+				"payout_mode": "PAYOUT_MODE_DIRECT",
+				"block_revenue_retention_blocks": "100800",
+				"disallowed_denom_policy": "DISALLOWED_DENOM_POLICY_COMMUNITY_POOL",
+				"denom_policies": [],
+				"max_payout_recipients": 20,
+				"payout_remainder_policy": "PAYOUT_REMAINDER_POLICY_TOP_RECIPIENTS",
+				"payout_epoch_blocks": "100"
 			},
-			"fee_share": []
+			"fee_share": [],
+			"pending_rewards": [],
+			"contract_revenues": [],
+			"withdrawer_revenues": [],
+			"block_revenues": [],
+			"share_overrides": [],
+			"blocklist": [],
+			"pending_owners": [],
+			"escrowed_payouts": []
 		},
 		"genutil": {
 			"gen_txs": []
@@ -695,7 +711,12 @@ func (s *AppGenesisTestSuite) TestGenesis() {
 						"amount": "10000000"
 					}
 				],
-				"denom_creation_gas_consume": "1000000"
+				"denom_creation_gas_consume": "1000000",
+				"track_before_send_gas_limit": "100000",
+				"block_before_send_gas_limit": "1000000",
+				"max_before_send_gas_limit": "2000000",
+				"max_before_send_hook_failures": "10",
+				"mint_batch_gas_per_recipient": "0"
 			},
 			"factory_denoms": []
 		},
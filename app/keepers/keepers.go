@@ -86,7 +86,9 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	icahost "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host"
+	customwasmodule "github.com/terra-money/core/v2/x/wasm"
 	customwasmkeeper "github.com/terra-money/core/v2/x/wasm/keeper"
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
 
 	icacontroller "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
@@ -119,6 +121,7 @@ var maccPerms = map[string][]string{
 	icqtypes.ModuleName:            nil,
 	wasmtypes.ModuleName:           {authtypes.Burner},
 	tokenfactorytypes.ModuleName:   {authtypes.Burner, authtypes.Minter},
+	feesharetypes.ModuleName:       {authtypes.Burner},
 	alliancetypes.ModuleName:       {authtypes.Burner, authtypes.Minter},
 	alliancetypes.RewardsPoolName:  nil,
 }
@@ -368,6 +371,9 @@ func NewTerraAppKeepers(
 	transferIBCModule := ibctransfer.NewIBCModule(keepers.TransferKeeper)
 
 	hooksTransferStack := ibchooks.NewIBCMiddleware(&transferIBCModule, &keepers.HooksICS4Wrapper)
+	// Collect the contracts executed by the hooks to share the relayer fees with them,
+	// the keepers.WasmKeeper is set later.
+	trackedHooksTransferStack := customwasmodule.NewIBCHooksMiddleware(hooksTransferStack, &keepers.WasmKeeper)
 	keepers.PacketForwardKeeper = *packetforwardkeeper.NewKeeper(
 		appCodec,
 		keepers.keys[packetforwardtypes.StoreKey],
@@ -379,7 +385,7 @@ func NewTerraAppKeepers(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	keepers.TransferStack = packetforward.NewIBCMiddleware(
-		hooksTransferStack,
+		trackedHooksTransferStack,
 		&keepers.PacketForwardKeeper,
 		5,
 		packetforwardkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
@@ -450,6 +456,7 @@ func NewTerraAppKeepers(
 	keepers.WasmKeeper = customwasmkeeper.NewKeeper(
 		appCodec,
 		keys[wasmtypes.StoreKey],
+		tkeys[customwasmtypes.TStoreKey],
 		keepers.AccountKeeper,
 		keepers.BankKeeper,
 		keepers.StakingKeeper,
@@ -40,6 +40,7 @@ import (
 	ibchookstypes "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
 
 	tokenfactorytypes "github.com/terra-money/core/v2/x/tokenfactory/types"
 
@@ -63,7 +64,7 @@ func (keepers *TerraAppKeepers) GenerateKeys() {
 		alliancetypes.StoreKey, feesharetypes.StoreKey, icqtypes.StoreKey,
 	)
 
-	keepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	keepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, customwasmtypes.TStoreKey)
 	keepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 }
 
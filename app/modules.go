@@ -152,7 +152,7 @@ func appModules(app *TerraApp, encodingConfig terrappsparams.EncodingConfig, ski
 		ibchooks.NewAppModule(app.Keepers.AccountKeeper),
 		tokenfactory.NewAppModule(app.Keepers.TokenFactoryKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
 		alliance.NewAppModule(app.appCodec, app.Keepers.AllianceKeeper, app.Keepers.StakingKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.interfaceRegistry, app.GetSubspace(alliancetypes.ModuleName)),
-		feeshare.NewAppModule(app.Keepers.FeeShareKeeper, app.Keepers.AccountKeeper, app.GetSubspace(feesharetypes.ModuleName)),
+		feeshare.NewAppModule(app.Keepers.FeeShareKeeper, app.Keepers.AccountKeeper, app.Keepers.BankKeeper, app.Keepers.WasmKeeper, app.GetSubspace(feesharetypes.ModuleName)),
 		icq.NewAppModule(app.Keepers.ICQKeeper),
 	}
 }
@@ -171,7 +171,6 @@ var initGenesisOrder = []string{
 	slashingtypes.ModuleName,
 	govtypes.ModuleName,
 	minttypes.ModuleName,
-	crisistypes.ModuleName,
 	genutiltypes.ModuleName,
 	evidencetypes.ModuleName,
 	authz.ModuleName,
@@ -191,6 +190,9 @@ var initGenesisOrder = []string{
 	feesharetypes.ModuleName,
 	consensusparamtypes.ModuleName,
 	icqtypes.ModuleName,
+	// crisis must occur last so that the genesis invariants are
+	// asserted once every module has been initialized
+	crisistypes.ModuleName,
 }
 
 var beginBlockersOrder = []string{
@@ -12,12 +12,21 @@ type HandlerOptions struct {
 	FeeShareKeeper feesharepost.FeeShareKeeper
 	BankKeeper     feesharepost.BankKeeper
 	WasmKeeper     customwasmkeeper.Keeper
+
+	DistributionKeeper feesharepost.DistributionKeeper
+	AccountKeeper      feesharepost.AccountKeeper
 }
 
 func NewPostHandler(options HandlerOptions) sdk.PostHandler {
 
 	postDecorators := []sdk.PostDecorator{
-		feesharepost.NewFeeSharePayoutDecorator(options.FeeShareKeeper, options.BankKeeper, options.WasmKeeper),
+		feesharepost.NewFeeSharePayoutDecorator(
+			options.FeeShareKeeper,
+			options.BankKeeper,
+			options.WasmKeeper,
+			options.DistributionKeeper,
+			options.AccountKeeper,
+		),
 		wasmpost.NewWasmdDecorator(options.WasmKeeper),
 	}
 
@@ -1,20 +1,29 @@
 package app_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
+	"strings"
 	"testing"
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"github.com/stretchr/testify/require"
 	"github.com/terra-money/core/v2/app"
 	"github.com/terra-money/core/v2/app/keepers"
+	feesharetypes "github.com/terra-money/core/v2/x/feeshare/types"
+	tokenfactorytypes "github.com/terra-money/core/v2/x/tokenfactory/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	alliancetypes "github.com/terra-money/alliance/x/alliance/types"
 
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 )
@@ -100,3 +109,182 @@ func TestSimulationManager(t *testing.T) {
 	sm := terraApp.SimulationManager()
 	require.NotNil(t, sm)
 }
+
+// TestAppImportExport runs the chain simulation, exports its state and
+// imports it in a new app, checking that the stores of the Terra modules
+// are restored identically.
+// Running as go test:
+// `go test -run ^TestAppImportExport ./app -NumBlocks=50 -BlockSize 50 -Commit=true -Enabled=true`
+func TestAppImportExport(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+
+	db, dir, logger, skip, err := simtestutil.SetupSimulation(config, "goleveldb-app-sim", "Simulation", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	if skip {
+		t.Skip("skipping application import/export simulation")
+	}
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	})
+
+	terraApp := newSimulationApp(logger, db)
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		terraApp.BaseApp,
+		simtestutil.AppStateFn(terraApp.AppCodec(), terraApp.SimulationManager(), terraApp.DefaultGenesis()),
+		simulationtypes.RandomAccounts,
+		simtestutil.SimulationOperations(terraApp, terraApp.AppCodec(), config),
+		keepers.ModuleAccountAddrs(),
+		config,
+		terraApp.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(terraApp, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	exported, err := terraApp.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+
+	newDB, newDir, _, _, err := simtestutil.SetupSimulation(config, "goleveldb-app-sim-2", "Simulation-2", simcli.FlagVerboseValue, simcli.FlagEnabledValue)
+	require.NoError(t, err, "simulation setup failed")
+
+	t.Cleanup(func() {
+		require.NoError(t, newDB.Close())
+		require.NoError(t, os.RemoveAll(newDir))
+	})
+
+	newApp := newSimulationApp(log.NewNopLogger(), newDB)
+
+	var genesisState app.GenesisState
+	require.NoError(t, json.Unmarshal(exported.AppState, &genesisState))
+
+	defer func() {
+		if r := recover(); r != nil {
+			if !strings.Contains(fmt.Sprintf("%v", r), "validator set is empty after InitGenesis") {
+				panic(r)
+			}
+			t.Log("Skipping simulation as all validators have been unbonded")
+		}
+	}()
+
+	ctxA := terraApp.NewContext(true, tmproto.Header{Height: terraApp.LastBlockHeight()})
+	ctxB := newApp.NewContext(true, tmproto.Header{Height: terraApp.LastBlockHeight()})
+	newApp.GetModuleManager().InitGenesis(ctxB, terraApp.AppCodec(), genesisState)
+	newApp.StoreConsensusParams(ctxB, exported.ConsensusParams)
+
+	storeKeysPrefixes := []struct {
+		storeKey string
+		prefixes [][]byte
+	}{
+		{banktypes.StoreKey, [][]byte{banktypes.BalancesPrefix}},
+		{tokenfactorytypes.StoreKey, [][]byte{}},
+		{feesharetypes.StoreKey, [][]byte{}},
+	}
+	for _, skp := range storeKeysPrefixes {
+		storeA := ctxA.KVStore(terraApp.GetKey(skp.storeKey))
+		storeB := ctxB.KVStore(newApp.GetKey(skp.storeKey))
+		failedKVAs, failedKVBs := sdk.DiffKVStores(storeA, storeB, skp.prefixes)
+		require.Equal(t, len(failedKVAs), len(failedKVBs), "unequal sets of key-values to compare")
+		require.Empty(t, failedKVAs, simtestutil.GetSimulationLog(skp.storeKey, terraApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
+	}
+}
+
+// TestAppStateDeterminism runs the chain simulation several times with
+// the same seed, checking that the store hashes are always the same.
+// Running as go test:
+// `go test -run ^TestAppStateDeterminism ./app -NumBlocks=50 -BlockSize 50 -Commit=true -Enabled=true`
+func TestAppStateDeterminism(t *testing.T) {
+	if !simcli.FlagEnabledValue {
+		t.Skip("skipping application simulation")
+	}
+
+	config := simcli.NewConfigFromFlags()
+	config.InitialBlockHeight = 1
+	config.ExportParamsPath = ""
+	config.OnOperation = false
+	config.AllInvariants = false
+
+	numSeeds := 3
+	numTimesToRunPerSeed := 3
+	storeHashList := make([]map[string][]byte, numTimesToRunPerSeed)
+
+	for i := 0; i < numSeeds; i++ {
+		config.Seed += int64(i)
+
+		for j := 0; j < numTimesToRunPerSeed; j++ {
+			db := dbm.NewMemDB()
+			terraApp := newSimulationApp(log.NewNopLogger(), db)
+
+			fmt.Printf(
+				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
+				config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+			)
+
+			_, _, err := simulation.SimulateFromSeed(
+				t,
+				os.Stdout,
+				terraApp.BaseApp,
+				simtestutil.AppStateFn(terraApp.AppCodec(), terraApp.SimulationManager(), terraApp.DefaultGenesis()),
+				simulationtypes.RandomAccounts,
+				simtestutil.SimulationOperations(terraApp, terraApp.AppCodec(), config),
+				keepers.ModuleAccountAddrs(),
+				config,
+				terraApp.AppCodec(),
+			)
+			require.NoError(t, err)
+
+			storeHashList[j] = storeHashes(terraApp)
+			if j != 0 {
+				require.Equal(
+					t, storeHashList[0], storeHashList[j],
+					"non-determinism in seed %d: %d/%d, attempt: %d/%d", config.Seed, i+1, numSeeds, j+1, numTimesToRunPerSeed,
+				)
+			}
+		}
+	}
+}
+
+// storeHashes returns the last commit hash of every store of the app but
+// the alliance and wasm ones. The alliance simulation genesis sets the
+// asset reward change times from the wall clock, so the alliance store and
+// the gas its hooks consume differ between runs. Wasm records the block gas
+// consumed as the creation position of the contracts, so its store differs
+// as well.
+func storeHashes(terraApp *app.TerraApp) map[string][]byte {
+	hashes := make(map[string][]byte)
+	for name, key := range terraApp.Keepers.GetKVStoreKey() {
+		if name == alliancetypes.StoreKey || name == wasmtypes.StoreKey {
+			continue
+		}
+		hashes[name] = terraApp.CommitMultiStore().GetCommitKVStore(key).LastCommitID().Hash
+	}
+	return hashes
+}
+
+// newSimulationApp creates a TerraApp with a home directory of its own,
+// so that the wasm contracts of different apps do not collide.
+func newSimulationApp(logger log.Logger, db dbm.DB) *app.TerraApp {
+	encoding := app.MakeEncodingConfig()
+	homeDir, err := os.MkdirTemp("", "terra-sim")
+	if err != nil {
+		panic(err)
+	}
+
+	return app.NewTerraApp(
+		logger,
+		db,
+		nil,
+		true,
+		map[int64]bool{},
+		homeDir,
+		0,
+		encoding,
+		simtestutil.EmptyAppOptions{},
+		wasmtypes.DefaultWasmConfig(),
+	)
+}
@@ -10,6 +10,7 @@ import (
 	v2_11 "github.com/terra-money/core/v2/app/upgrades/v2.11"
 	v2_12 "github.com/terra-money/core/v2/app/upgrades/v2.12"
 	v2_13 "github.com/terra-money/core/v2/app/upgrades/v2.13"
+	v2_14 "github.com/terra-money/core/v2/app/upgrades/v2.14"
 	v2_2_0 "github.com/terra-money/core/v2/app/upgrades/v2.2.0"
 	v2_3_0 "github.com/terra-money/core/v2/app/upgrades/v2.3.0"
 	v2_4 "github.com/terra-money/core/v2/app/upgrades/v2.4"
@@ -27,6 +28,8 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	icqtypes "github.com/cosmos/ibc-apps/modules/async-icq/v7/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
 // RegisterUpgradeHandlers returns upgrade handlers
@@ -129,6 +132,14 @@ func (app *TerraApp) RegisterUpgradeHandlers() {
 			app.Keepers,
 		),
 	)
+	app.Keepers.UpgradeKeeper.SetUpgradeHandler(
+		terraappconfig.Upgrade2_14,
+		v2_14.CreateUpgradeHandler(
+			app.GetModuleManager(),
+			app.GetConfigurator(),
+			app.GetKey(wasmtypes.StoreKey),
+		),
+	)
 }
 
 func (app *TerraApp) RegisterUpgradeStores() {
@@ -0,0 +1,95 @@
+package app_test
+
+import (
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	terraappconfig "github.com/terra-money/core/v2/app/config"
+	feesharetypes "github.com/terra-money/core/v2/x/feeshare/types"
+	tokenfactorytypes "github.com/terra-money/core/v2/x/tokenfactory/types"
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
+)
+
+func (s *AppGenesisTestSuite) TestUpgrade2_14() {
+	s.Setup()
+	contract := sdk.AccAddress("contract________________________")
+	withdrawer := sdk.AccAddress("withdrawer__________")
+
+	// Store a FeeShare with the single withdrawer of the previous versions
+	s.App.Keepers.FeeShareKeeper.SetFeeShare(s.Ctx, feesharetypes.FeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   withdrawer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	})
+
+	// Store the executed contracts left in the persistent store
+	wasmStore := s.Ctx.KVStore(s.App.Keepers.GetKVStoreKey()[wasmtypes.StoreKey])
+	wasmStore.Set(customwasmtypes.GetExecutedContractsKey(), []byte("executed contracts"))
+
+	// Store the params without the block revenue retention and
+	// the max payout recipients of the previous versions
+	feeshareParams := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+	feeshareParams.BlockRevenueRetentionBlocks = 0
+	feeshareParams.MaxPayoutRecipients = 0
+	s.Require().NoError(s.App.Keepers.FeeShareKeeper.SetParams(s.Ctx, feeshareParams))
+
+	// Store the feeshare module account without the burner permission
+	ak := s.App.Keepers.AccountKeeper
+	moduleAcc := ak.GetModuleAccount(s.Ctx, feesharetypes.ModuleName).(*authtypes.ModuleAccount)
+	moduleAcc.Permissions = nil
+	ak.SetModuleAccount(s.Ctx, moduleAcc)
+
+	// Store the authority metadata of a denom without the roles of the previous versions
+	tfKeeper := s.App.Keepers.TokenFactoryKeeper
+	tfParams := tfKeeper.GetParams(s.Ctx)
+	tfParams.TrackBeforeSendGasLimit = 0
+	tfParams.BlockBeforeSendGasLimit = 0
+	tfParams.MaxBeforeSendGasLimit = 0
+	tfParams.MaxBeforeSendHookFailures = 0
+	s.Require().NoError(tfKeeper.SetParams(s.Ctx, tfParams))
+	admin := s.TestAccs[0].String()
+	denom, err := tfKeeper.CreateDenom(s.Ctx, admin, "upgrade")
+	s.Require().NoError(err)
+	legacyMetadata := tokenfactorytypes.DenomAuthorityMetadata{Admin: admin}
+	tfKeeper.GetDenomPrefixStore(s.Ctx, denom).Set([]byte(tokenfactorytypes.DenomAuthorityMetadataKey), s.App.AppCodec().MustMarshal(&legacyMetadata))
+
+	// Versions of the modules before the upgrade
+	fromVM := s.App.GetModuleManager().GetVersionMap()
+	fromVM[feesharetypes.ModuleName] = 2
+	fromVM[tokenfactorytypes.ModuleName] = 3
+	s.App.Keepers.UpgradeKeeper.SetModuleVersionMap(s.Ctx, fromVM)
+
+	s.App.Keepers.UpgradeKeeper.ApplyUpgrade(s.Ctx, upgradetypes.Plan{
+		Name:   terraappconfig.Upgrade2_14,
+		Height: s.Ctx.BlockHeight(),
+	})
+
+	toVM := s.App.Keepers.UpgradeKeeper.GetModuleVersionMap(s.Ctx)
+	s.Require().Equal(uint64(6), toVM[feesharetypes.ModuleName])
+	s.Require().Equal(uint64(5), toVM[tokenfactorytypes.ModuleName])
+
+	s.Require().False(wasmStore.Has(customwasmtypes.GetExecutedContractsKey()))
+
+	params := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+	s.Require().Equal(feesharetypes.DefaultBlockRevenueRetentionBlocks, params.BlockRevenueRetentionBlocks)
+	s.Require().Equal(feesharetypes.DefaultMaxPayoutRecipients, params.MaxPayoutRecipients)
+
+	s.Require().True(ak.GetModuleAccount(s.Ctx, feesharetypes.ModuleName).HasPermission(authtypes.Burner))
+
+	feeShare, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().Empty(feeShare.WithdrawerAddress)
+	s.Require().Equal([]feesharetypes.Withdrawer{feesharetypes.NewWithdrawer(withdrawer, feesharetypes.BasisPointsTotal)}, feeShare.Withdrawers)
+
+	metadata, err := tfKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(tokenfactorytypes.NewAdminAuthorityMetadata(admin), metadata)
+
+	tfParams = tfKeeper.GetParams(s.Ctx)
+	s.Require().Equal(tokenfactorytypes.DefaultTrackBeforeSendGasLimit, tfParams.TrackBeforeSendGasLimit)
+	s.Require().Equal(tokenfactorytypes.DefaultBlockBeforeSendGasLimit, tfParams.BlockBeforeSendGasLimit)
+	s.Require().Equal(tokenfactorytypes.DefaultMaxBeforeSendGasLimit, tfParams.MaxBeforeSendGasLimit)
+	s.Require().Equal(tokenfactorytypes.DefaultMaxBeforeSendHookFailures, tfParams.MaxBeforeSendHookFailures)
+}
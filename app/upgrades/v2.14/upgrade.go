@@ -0,0 +1,28 @@
+package v2_14
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
+)
+
+// CreateUpgradeHandler runs the store migrations of the modules
+// whose consensus version was bumped since the v2.13 upgrade.
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	cfg module.Configurator,
+	wasmStoreKey storetypes.StoreKey,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// The executed contracts are tracked in a transient store since
+		// v2.14, so remove the list left in the persistent wasm store. This
+		// is done here rather than in a wasm migration to keep the consensus
+		// version of the wasmd module untouched.
+		ctx.KVStore(wasmStoreKey).Delete(customwasmtypes.GetExecutedContractsKey())
+
+		return mm.RunMigrations(ctx, cfg, fromVM)
+	}
+}
@@ -0,0 +1,82 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/app/test_helpers"
+	"github.com/terra-money/core/v2/x/feeshare/bindings"
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+)
+
+var errWrappedMessenger = errors.New("wrapped messenger")
+
+type BindingsTestSuite struct {
+	test_helpers.AppTestSuite
+}
+
+func TestBindingsTestSuite(t *testing.T) {
+	suite.Run(t, new(BindingsTestSuite))
+}
+
+// wrappedMessenger stands for the messengers decorated by the
+// feeshare messenger and records the messages forwarded to it.
+type wrappedMessenger struct {
+	msgs []wasmvmtypes.CosmosMsg
+}
+
+func (m *wrappedMessenger) DispatchMsg(_ sdk.Context, _ sdk.AccAddress, _ string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	m.msgs = append(m.msgs, msg)
+	return nil, nil, errWrappedMessenger
+}
+
+// instantiateReflectContract stores the reflect contract and
+// instantiates it with the contract itself as its admin.
+func (s *BindingsTestSuite) instantiateReflectContract(creator sdk.AccAddress) sdk.AccAddress {
+	wasmCode, err := os.ReadFile("../keeper/testdata/reflect.wasm")
+	s.Require().NoError(err)
+
+	contractKeeper := keeper.NewDefaultPermissionKeeper(s.App.Keepers.WasmKeeper)
+	codeID, _, err := contractKeeper.Create(s.Ctx, creator, wasmCode, &wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeEverybody})
+	s.Require().NoError(err)
+
+	contract, _, err := contractKeeper.Instantiate(s.Ctx, codeID, creator, creator, []byte("{}"), "reflect", nil)
+	s.Require().NoError(err)
+	s.Require().NoError(contractKeeper.UpdateContractAdmin(s.Ctx, contract, creator, contract))
+
+	return contract
+}
+
+// executeCustom dispatches the feeshare message from the contract
+// as the wasm keeper does for the messages returned by a contract.
+func (s *BindingsTestSuite) executeCustom(contract sdk.AccAddress, msg bindingstypes.FeeShareMsgs) error {
+	bz, err := json.Marshal(bindingstypes.FeeShareMsg{FeeShare: &msg})
+	s.Require().NoError(err)
+
+	messenger := bindings.CustomMessageDecorator(&s.App.Keepers.FeeShareKeeper)(&wrappedMessenger{})
+	_, _, err = messenger.DispatchMsg(s.Ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: bz})
+	return err
+}
+
+func (s *BindingsTestSuite) queryCustom(request bindingstypes.FeeShareQueries, response interface{}) error {
+	bz, err := json.Marshal(bindingstypes.FeeShareQuery{FeeShare: &request})
+	s.Require().NoError(err)
+
+	querier := bindings.CustomQuerier(bindings.NewQueryPlugin(&s.App.Keepers.FeeShareKeeper))
+	resBz, err := querier(s.Ctx, bz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBz, response)
+}
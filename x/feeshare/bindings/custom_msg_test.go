@@ -0,0 +1,137 @@
+package bindings_test
+
+import (
+	"encoding/json"
+
+	"github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/bindings"
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *BindingsTestSuite) TestSelfRegistration() {
+	s.Setup()
+	sender := s.TestAccs[0]
+	withdrawer := s.TestAccs[1]
+	reflect := s.instantiateReflectContract(sender)
+
+	// The contract registers itself...
+	err := s.executeCustom(reflect, bindingstypes.FeeShareMsgs{
+		Register: &bindingstypes.Register{
+			Withdrawers: []bindingstypes.Withdrawer{{Address: withdrawer.String(), WeightBps: types.BasisPointsTotal}},
+		},
+	})
+	s.Require().NoError(err)
+
+	var res bindingstypes.FeeShareResponse
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		FeeShare: &bindingstypes.GetFeeShare{ContractAddress: reflect.String()},
+	}, &res)
+	s.Require().NoError(err)
+	s.Require().Equal(&bindingstypes.FeeShare{
+		ContractAddress: reflect.String(),
+		DeployerAddress: reflect.String(),
+		Withdrawers:     []bindingstypes.Withdrawer{{Address: withdrawer.String(), WeightBps: types.BasisPointsTotal}},
+	}, res.FeeShare)
+
+	// ... updates its withdrawers ...
+	err = s.executeCustom(reflect, bindingstypes.FeeShareMsgs{
+		Update: &bindingstypes.Update{
+			ContractAddress: reflect.String(),
+			Withdrawers: []bindingstypes.Withdrawer{
+				{Address: withdrawer.String(), WeightBps: 4000},
+				{Address: sender.String(), WeightBps: 6000},
+			},
+		},
+	})
+	s.Require().NoError(err)
+	feeShare, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, reflect)
+	s.Require().True(found)
+	s.Require().Equal([]sdk.AccAddress{withdrawer, sender}, feeShare.GetWithdrawerAddrs())
+
+	// ... and cancels the registration
+	err = s.executeCustom(reflect, bindingstypes.FeeShareMsgs{
+		Cancel: &bindingstypes.Cancel{ContractAddress: reflect.String()},
+	})
+	s.Require().NoError(err)
+
+	res = bindingstypes.FeeShareResponse{}
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		FeeShare: &bindingstypes.GetFeeShare{ContractAddress: reflect.String()},
+	}, &res)
+	s.Require().NoError(err)
+	s.Require().Nil(res.FeeShare)
+}
+
+func (s *BindingsTestSuite) TestFactoryRegistration() {
+	s.Setup()
+	sender := s.TestAccs[0]
+	factory := s.instantiateReflectContract(sender)
+
+	// The factory instantiates a child contract without admin...
+	contractKeeper := keeper.NewDefaultPermissionKeeper(s.App.Keepers.WasmKeeper)
+	child, _, err := contractKeeper.Instantiate(s.Ctx, 1, factory, nil, []byte("{}"), "child", nil)
+	s.Require().NoError(err)
+
+	// ... that can only be registered to itself
+	err = s.executeCustom(factory, bindingstypes.FeeShareMsgs{
+		Register: &bindingstypes.Register{
+			ContractAddress: child.String(),
+			Withdrawers:     []bindingstypes.Withdrawer{{Address: sender.String(), WeightBps: types.BasisPointsTotal}},
+		},
+	})
+	s.Require().Error(err)
+
+	err = s.executeCustom(factory, bindingstypes.FeeShareMsgs{
+		Register: &bindingstypes.Register{ContractAddress: child.String()},
+	})
+	s.Require().NoError(err)
+	feeShare, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, child)
+	s.Require().True(found)
+	s.Require().Equal(child.String(), feeShare.DeployerAddress)
+	s.Require().Equal([]sdk.AccAddress{child}, feeShare.GetWithdrawerAddrs())
+}
+
+func (s *BindingsTestSuite) TestRegistrationOfAnotherContractFails() {
+	s.Setup()
+	sender := s.TestAccs[0]
+	reflect := s.instantiateReflectContract(sender)
+	other := s.instantiateReflectContract(sender)
+
+	// A contract cannot register a contract it isn't the admin of
+	// to receive the fees of the contract
+	err := s.executeCustom(reflect, bindingstypes.FeeShareMsgs{
+		Register: &bindingstypes.Register{
+			ContractAddress: other.String(),
+			Withdrawers:     []bindingstypes.Withdrawer{{Address: reflect.String(), WeightBps: types.BasisPointsTotal}},
+		},
+	})
+	s.Require().Error(err)
+	s.Require().False(s.App.Keepers.FeeShareKeeper.IsFeeShareRegistered(s.Ctx, other))
+
+	// Unknown feeshare messages are rejected
+	err = s.executeCustom(reflect, bindingstypes.FeeShareMsgs{})
+	s.Require().ErrorContains(err, "unknown feeshare msg")
+}
+
+func (s *BindingsTestSuite) TestOtherMessagesAreForwarded() {
+	s.Setup()
+	wrapped := &wrappedMessenger{}
+	messenger := bindings.CustomMessageDecorator(&s.App.Keepers.FeeShareKeeper)(wrapped)
+
+	tokenMsg, err := json.Marshal(map[string]interface{}{"token": map[string]interface{}{}})
+	s.Require().NoError(err)
+	msgs := []wasmvmtypes.CosmosMsg{
+		{Custom: tokenMsg},
+		{Bank: &wasmvmtypes.BankMsg{Burn: &wasmvmtypes.BurnMsg{}}},
+	}
+	for _, msg := range msgs {
+		_, _, err = messenger.DispatchMsg(s.Ctx, s.TestAccs[0], "", msg)
+		s.Require().ErrorIs(err, errWrappedMessenger)
+	}
+	s.Require().Equal(msgs, wrapped.msgs)
+}
@@ -0,0 +1,63 @@
+package bindings_test
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+)
+
+func (s *BindingsTestSuite) TestQuery() {
+	s.Setup()
+	sender := s.TestAccs[0]
+	withdrawer := s.TestAccs[1]
+	reflect := s.instantiateReflectContract(sender)
+
+	// Query params info
+	var paramsRes bindingstypes.ParamsResponse
+	err := s.queryCustom(bindingstypes.FeeShareQueries{Params: &bindingstypes.GetParams{}}, &paramsRes)
+	s.Require().NoError(err)
+	s.Require().Equal(bindingstypes.ParamsResponse{
+		Params: bindingstypes.Params{
+			EnableFeeShare:   true,
+			DeveloperShares:  "0.500000000000000000",
+			AllowedDenoms:    []string{},
+			DistributionMode: "DISTRIBUTION_MODE_EQUAL",
+			PayoutMode:       "PAYOUT_MODE_DIRECT",
+		},
+	}, paramsRes)
+
+	// Query the revenue of the contract and the pending
+	// rewards of the withdrawer before and after a payout
+	var revenueRes bindingstypes.ContractRevenueResponse
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		ContractRevenue: &bindingstypes.ContractRevenue{ContractAddress: reflect.String()},
+	}, &revenueRes)
+	s.Require().NoError(err)
+	s.Require().Empty(revenueRes.Revenue)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+	params := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, reflect, withdrawer, fees)
+	s.App.Keepers.FeeShareKeeper.AccruePendingRewards(s.Ctx, withdrawer, fees)
+
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		ContractRevenue: &bindingstypes.ContractRevenue{ContractAddress: reflect.String()},
+	}, &revenueRes)
+	s.Require().NoError(err)
+	s.Require().Equal(wasmvmtypes.Coins{{Denom: "uluna", Amount: "100"}}, revenueRes.Revenue)
+
+	var rewardsRes bindingstypes.PendingRewardsResponse
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		PendingRewards: &bindingstypes.PendingRewards{WithdrawerAddress: withdrawer.String()},
+	}, &rewardsRes)
+	s.Require().NoError(err)
+	s.Require().Equal(wasmvmtypes.Coins{{Denom: "uluna", Amount: "100"}}, rewardsRes.Rewards)
+
+	// Invalid addresses are rejected
+	err = s.queryCustom(bindingstypes.FeeShareQueries{
+		FeeShare: &bindingstypes.GetFeeShare{ContractAddress: "invalid"},
+	}, &bindingstypes.FeeShareResponse{})
+	s.Require().Error(err)
+}
@@ -0,0 +1,169 @@
+package bindings
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+	feesharekeeper "github.com/terra-money/core/v2/x/feeshare/keeper"
+	feesharetypes "github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
+func CustomMessageDecorator(feeShare *feesharekeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:  old,
+			feeShare: feeShare,
+		}
+	}
+}
+
+type CustomMessenger struct {
+	wrapped  wasmkeeper.Messenger
+	feeShare *feesharekeeper.Keeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg executes on the contractMsg. Custom messages without
+// a feeshare field are left for the wrapped messenger, so other
+// modules bindings keep working.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, error) {
+	if msg.Custom != nil {
+		var contractMsg bindingstypes.FeeShareMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err == nil && contractMsg.FeeShare != nil {
+			feeShareMsg := contractMsg.FeeShare
+
+			if feeShareMsg.Register != nil {
+				return m.register(ctx, contractAddr, feeShareMsg.Register)
+			}
+			if feeShareMsg.Update != nil {
+				return m.update(ctx, contractAddr, feeShareMsg.Update)
+			}
+			if feeShareMsg.Cancel != nil {
+				return m.cancel(ctx, contractAddr, feeShareMsg.Cancel)
+			}
+			return nil, nil, wasmvmtypes.InvalidRequest{Err: "unknown feeshare msg"}
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
+
+// register registers a contract for fee distribution.
+func (m *CustomMessenger) register(ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.Register) ([]sdk.Event, [][]byte, error) {
+	err := PerformRegister(m.feeShare, ctx, contractAddr, register)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform register")
+	}
+	return nil, nil, nil
+}
+
+// PerformRegister is used with register to validate the register message
+// and register the contract with the sender contract as the deployer.
+func PerformRegister(f *feesharekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, register *bindingstypes.Register) error {
+	if register == nil {
+		return wasmvmtypes.InvalidRequest{Err: "register null register"}
+	}
+
+	contract := register.ContractAddress
+	if contract == "" {
+		contract = contractAddr.String()
+	}
+	withdrawers := wasmWithdrawersToSdk(register.Withdrawers)
+	if len(withdrawers) == 0 {
+		withdrawers = []feesharetypes.Withdrawer{{Address: contract, WeightBps: feesharetypes.BasisPointsTotal}}
+	}
+
+	sdkMsg := &feesharetypes.MsgRegisterFeeShare{
+		ContractAddress: contract,
+		DeployerAddress: contractAddr.String(),
+		Withdrawers:     withdrawers,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// Register through the feeshare message server
+	_, err := f.RegisterFeeShare(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "registering contract from message")
+	}
+	return nil
+}
+
+// update replaces the withdrawers of a registered contract.
+func (m *CustomMessenger) update(ctx sdk.Context, contractAddr sdk.AccAddress, update *bindingstypes.Update) ([]sdk.Event, [][]byte, error) {
+	err := PerformUpdate(m.feeShare, ctx, contractAddr, update)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform update")
+	}
+	return nil, nil, nil
+}
+
+// PerformUpdate is used with update to validate the update message and dispatch.
+func PerformUpdate(f *feesharekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, update *bindingstypes.Update) error {
+	if update == nil {
+		return wasmvmtypes.InvalidRequest{Err: "update null update"}
+	}
+
+	sdkMsg := &feesharetypes.MsgUpdateFeeShare{
+		ContractAddress: update.ContractAddress,
+		DeployerAddress: contractAddr.String(),
+		Withdrawers:     wasmWithdrawersToSdk(update.Withdrawers),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := f.UpdateFeeShare(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "updating withdrawers from message")
+	}
+	return nil
+}
+
+// cancel removes the registration of a contract.
+func (m *CustomMessenger) cancel(ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.Cancel) ([]sdk.Event, [][]byte, error) {
+	err := PerformCancel(m.feeShare, ctx, contractAddr, cancel)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform cancel")
+	}
+	return nil, nil, nil
+}
+
+// PerformCancel is used with cancel to validate the cancel message and dispatch.
+func PerformCancel(f *feesharekeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, cancel *bindingstypes.Cancel) error {
+	if cancel == nil {
+		return wasmvmtypes.InvalidRequest{Err: "cancel null cancel"}
+	}
+
+	sdkMsg := &feesharetypes.MsgCancelFeeShare{
+		ContractAddress: cancel.ContractAddress,
+		DeployerAddress: contractAddr.String(),
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	_, err := f.CancelFeeShare(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "cancelling registration from message")
+	}
+	return nil
+}
+
+// wasmWithdrawersToSdk converts the withdrawers of a binding message
+// to the withdrawers of the feeshare messages.
+func wasmWithdrawersToSdk(withdrawers []bindingstypes.Withdrawer) []feesharetypes.Withdrawer {
+	var res []feesharetypes.Withdrawer
+	for _, w := range withdrawers {
+		res = append(res, feesharetypes.Withdrawer{Address: w.Address, WeightBps: w.WeightBps})
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+package bindings
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+	feesharekeeper "github.com/terra-money/core/v2/x/feeshare/keeper"
+)
+
+type QueryPlugin struct {
+	feeShareKeeper *feesharekeeper.Keeper
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(fk *feesharekeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		feeShareKeeper: fk,
+	}
+}
+
+// GetFeeShare is a query to get the registration of a contract.
+func (qp QueryPlugin) GetFeeShare(ctx sdk.Context, contractAddress string) (*bindingstypes.FeeShareResponse, error) {
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	feeShare, found := qp.feeShareKeeper.GetFeeShare(ctx, contract)
+	if !found {
+		return &bindingstypes.FeeShareResponse{}, nil
+	}
+
+	withdrawers := []bindingstypes.Withdrawer{}
+	for _, w := range feeShare.GetWeightedWithdrawers() {
+		withdrawers = append(withdrawers, bindingstypes.Withdrawer{Address: w.Address, WeightBps: w.WeightBps})
+	}
+	return &bindingstypes.FeeShareResponse{
+		FeeShare: &bindingstypes.FeeShare{
+			ContractAddress: feeShare.ContractAddress,
+			DeployerAddress: feeShare.DeployerAddress,
+			Withdrawers:     withdrawers,
+		},
+	}, nil
+}
+
+func (qp QueryPlugin) GetParams(ctx sdk.Context) (*bindingstypes.ParamsResponse, error) {
+	params := qp.feeShareKeeper.GetParams(ctx)
+	allowedDenoms := params.AllowedDenoms
+	if allowedDenoms == nil {
+		allowedDenoms = []string{}
+	}
+	return &bindingstypes.ParamsResponse{
+		Params: bindingstypes.Params{
+			EnableFeeShare:   params.EnableFeeShare,
+			DeveloperShares:  params.DeveloperShares.String(),
+			AllowedDenoms:    allowedDenoms,
+			DistributionMode: params.DistributionMode.String(),
+			PayoutMode:       params.PayoutMode.String(),
+		},
+	}, nil
+}
+
+func (qp QueryPlugin) GetContractRevenue(ctx sdk.Context, contractAddress string) (*bindingstypes.ContractRevenueResponse, error) {
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue := qp.feeShareKeeper.GetContractRevenue(ctx, contract)
+	return &bindingstypes.ContractRevenueResponse{Revenue: ConvertSdkCoinsToWasmCoins(revenue)}, nil
+}
+
+func (qp QueryPlugin) GetPendingRewards(ctx sdk.Context, withdrawerAddress string) (*bindingstypes.PendingRewardsResponse, error) {
+	withdrawer, err := sdk.AccAddressFromBech32(withdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	rewards := qp.feeShareKeeper.GetPendingRewards(ctx, withdrawer)
+	return &bindingstypes.PendingRewardsResponse{Rewards: ConvertSdkCoinsToWasmCoins(rewards)}, nil
+}
@@ -0,0 +1,97 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	bindingstypes "github.com/terra-money/core/v2/x/feeshare/bindings/types"
+)
+
+// CustomQuerier dispatches custom CosmWasm bindings queries.
+func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var contractQuery bindingstypes.FeeShareQuery
+		if err := json.Unmarshal(request, &contractQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "failed query")
+		}
+		if contractQuery.FeeShare == nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "unknown query: nil feeshare field")
+		}
+		feeShareQuery := contractQuery.FeeShare
+
+		switch {
+		case feeShareQuery.FeeShare != nil:
+			res, err := qp.GetFeeShare(ctx, feeShareQuery.FeeShare.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal FeeShareResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case feeShareQuery.Params != nil:
+			res, err := qp.GetParams(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ParamsResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case feeShareQuery.ContractRevenue != nil:
+			res, err := qp.GetContractRevenue(ctx, feeShareQuery.ContractRevenue.ContractAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal ContractRevenueResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case feeShareQuery.PendingRewards != nil:
+			res, err := qp.GetPendingRewards(ctx, feeShareQuery.PendingRewards.WithdrawerAddress)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal PendingRewardsResponse: %w", err)
+			}
+
+			return bz, nil
+
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown query"}
+		}
+	}
+}
+
+// ConvertSdkCoinsToWasmCoins converts sdk type coins to wasm vm type coins
+func ConvertSdkCoinsToWasmCoins(coins []sdk.Coin) wasmvmtypes.Coins {
+	toSend := wasmvmtypes.Coins{}
+	for _, coin := range coins {
+		toSend = append(toSend, wasmvmtypes.Coin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.String(),
+		})
+	}
+	return toSend
+}
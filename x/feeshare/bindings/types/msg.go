@@ -0,0 +1,41 @@
+package types
+
+type FeeShareMsg struct {
+	FeeShare *FeeShareMsgs `json:"feeshare,omitempty"`
+}
+
+type FeeShareMsgs struct {
+	/// Contracts can register a contract for fee distribution,
+	/// the sender contract is used as the deployer address.
+	Register *Register `json:"register,omitempty"`
+	/// Contracts can update the withdrawers of a contract
+	/// that they have registered.
+	Update *Update `json:"update,omitempty"`
+	/// Contracts can cancel the registration of a contract
+	/// that they have registered.
+	Cancel *Cancel `json:"cancel,omitempty"`
+}
+
+// Register registers a contract for fee distribution. An empty
+// ContractAddress registers the sender contract itself, and an
+// empty list of Withdrawers sends the fees to the registered contract.
+type Register struct {
+	ContractAddress string       `json:"contract_address"`
+	Withdrawers     []Withdrawer `json:"withdrawers"`
+}
+
+// Update replaces the withdrawers of a registered contract.
+type Update struct {
+	ContractAddress string       `json:"contract_address"`
+	Withdrawers     []Withdrawer `json:"withdrawers"`
+}
+
+// Cancel removes the registration of a contract.
+type Cancel struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type Withdrawer struct {
+	Address   string `json:"address"`
+	WeightBps uint32 `json:"weight_bps"`
+}
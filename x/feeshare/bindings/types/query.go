@@ -0,0 +1,69 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+)
+
+type FeeShareQuery struct {
+	FeeShare *FeeShareQueries `json:"feeshare,omitempty"`
+}
+
+type FeeShareQueries struct {
+	/// Returns the registration of a contract, if any.
+	FeeShare *GetFeeShare `json:"fee_share,omitempty"`
+	/// Returns the feeshare module parameters.
+	Params *GetParams `json:"params,omitempty"`
+	/// Returns the cumulative fees distributed for a contract.
+	ContractRevenue *ContractRevenue `json:"contract_revenue,omitempty"`
+	/// Returns the fees accrued by a withdrawer that
+	/// have not been withdrawn yet.
+	PendingRewards *PendingRewards `json:"pending_rewards,omitempty"`
+}
+
+// query types
+
+type GetFeeShare struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type GetParams struct{}
+
+type ContractRevenue struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type PendingRewards struct {
+	WithdrawerAddress string `json:"withdrawer_address"`
+}
+
+// responses
+
+type FeeShareResponse struct {
+	FeeShare *FeeShare `json:"fee_share,omitempty"`
+}
+
+type FeeShare struct {
+	ContractAddress string       `json:"contract_address"`
+	DeployerAddress string       `json:"deployer_address"`
+	Withdrawers     []Withdrawer `json:"withdrawers"`
+}
+
+type ParamsResponse struct {
+	Params Params `json:"params"`
+}
+
+type Params struct {
+	EnableFeeShare   bool     `json:"enable_fee_share"`
+	DeveloperShares  string   `json:"developer_shares"`
+	AllowedDenoms    []string `json:"allowed_denoms"`
+	DistributionMode string   `json:"distribution_mode"`
+	PayoutMode       string   `json:"payout_mode"`
+}
+
+type ContractRevenueResponse struct {
+	Revenue wasmvmtypes.Coins `json:"revenue"`
+}
+
+type PendingRewardsResponse struct {
+	Rewards wasmvmtypes.Coins `json:"rewards"`
+}
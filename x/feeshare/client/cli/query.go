@@ -29,6 +29,15 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryParams(),
 		GetCmdQueryDeployerFeeShares(),
 		GetCmdQueryWithdrawerFeeShares(),
+		GetCmdQueryPendingRewards(),
+		GetCmdQueryPendingFeeShareOwner(),
+		GetCmdQueryContractRevenue(),
+		GetCmdQueryWithdrawerRevenue(),
+		GetCmdQueryTopEarners(),
+		GetCmdQueryBlockRevenues(),
+		GetCmdQueryShareOverrides(),
+		GetCmdQueryBlocklist(),
+		GetCmdQueryContractBlocked(),
 	)
 
 	return feesQueryCmd
@@ -223,3 +232,358 @@ func GetCmdQueryWithdrawerFeeShares() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPendingRewards implements a command that returns the fees
+// accrued by a withdrawer that have not been withdrawn yet
+func GetCmdQueryPendingRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-rewards [withdraw_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the fees accrued by a withdrawer that have not been withdrawn yet",
+		Long:    "Query the fees accrued by a withdrawer that have not been withdrawn yet",
+		Example: fmt.Sprintf("%s query feeshare pending-rewards <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRewardsRequest{
+				WithdrawerAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.PendingRewards(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPendingFeeShareOwner implements a command that returns the
+// owner proposed for a registered contract
+func GetCmdQueryPendingFeeShareOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-owner [contract_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the owner proposed for a registered contract",
+		Long:    "Query the owner proposed for a registered contract that has not accepted the ownership yet",
+		Example: fmt.Sprintf("%s query feeshare pending-owner <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingFeeShareOwnerRequest{
+				ContractAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.PendingFeeShareOwner(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryContractRevenue implements a command that returns the
+// cumulative fees distributed for a registered contract
+func GetCmdQueryContractRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-revenue [contract_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the cumulative fees distributed for a registered contract",
+		Long:    "Query the cumulative fees distributed for a registered contract",
+		Example: fmt.Sprintf("%s query feeshare contract-revenue <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryContractRevenueRequest{
+				ContractAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.ContractRevenue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryWithdrawerRevenue implements a command that returns the
+// cumulative fees distributed to a withdrawer
+func GetCmdQueryWithdrawerRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdrawer-revenue [withdraw_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the cumulative fees distributed to a withdrawer",
+		Long:    "Query the cumulative fees distributed to a withdrawer",
+		Example: fmt.Sprintf("%s query feeshare withdrawer-revenue <withdrawer-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryWithdrawerRevenueRequest{
+				WithdrawerAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.WithdrawerRevenue(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTopEarners implements a command that returns the withdrawers
+// sorted by the cumulative fees received in a denom
+func GetCmdQueryTopEarners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top-earners [denom]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query the withdrawers sorted by the cumulative fees received in a denom",
+		Long:    "Query the withdrawers sorted by the cumulative fees received in a denom",
+		Example: fmt.Sprintf("%s query feeshare top-earners uluna --limit 10", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTopEarnersRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.TopEarners(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "top earners")
+	return cmd
+}
+
+// GetCmdQueryBlockRevenues implements a command that returns the
+// fees distributed in each block
+func GetCmdQueryBlockRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "block-revenues",
+		Args:    cobra.NoArgs,
+		Short:   "Query the fees distributed in each block",
+		Long:    "Query the fees distributed in each block",
+		Example: fmt.Sprintf("%s query feeshare block-revenues --reverse --limit 100", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlockRevenuesRequest{
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.BlockRevenues(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "block revenues")
+	return cmd
+}
+
+// GetCmdQueryShareOverrides implements a command to return the developer
+// shares overrides set by governance for contracts and code ids.
+func GetCmdQueryShareOverrides() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "share-overrides",
+		Args:    cobra.NoArgs,
+		Short:   "Query the developer shares overrides of contracts and code ids",
+		Long:    "Query the developer shares overrides of contracts and code ids",
+		Example: fmt.Sprintf("%s query feeshare share-overrides", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryShareOverridesRequest{
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.ShareOverrides(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "share overrides")
+	return cmd
+}
+
+// GetCmdQueryBlocklist implements a command to return the contracts
+// and code ids blocked by governance from receiving fee shares.
+func GetCmdQueryBlocklist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "blocklist",
+		Args:    cobra.NoArgs,
+		Short:   "Query the contracts and code ids blocked from receiving fee shares",
+		Long:    "Query the contracts and code ids blocked from receiving fee shares",
+		Example: fmt.Sprintf("%s query feeshare blocklist", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryBlocklistRequest{
+				Pagination: pageReq,
+			}
+
+			// Query store
+			res, err := queryClient.Blocklist(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocklist")
+	return cmd
+}
+
+// GetCmdQueryContractBlocked implements a command that returns whether
+// a contract is blocked from receiving fee shares.
+func GetCmdQueryContractBlocked() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "contract-blocked [contract_address]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "Query whether a contract is blocked from receiving fee shares",
+		Long:    "Query whether a contract is blocked from receiving fee shares, either directly or through its code id",
+		Example: fmt.Sprintf("%s query feeshare contract-blocked <contract-address>", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryContractBlockedRequest{
+				ContractAddress: args[0],
+			}
+
+			if err := req.ValidateBasic(); err != nil {
+				return err
+			}
+
+			// Query store
+			res, err := queryClient.ContractBlocked(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -28,6 +30,9 @@ func NewTxCmd() *cobra.Command {
 		NewRegisterFeeShare(),
 		NewCancelFeeShare(),
 		NewUpdateFeeShare(),
+		NewWithdrawFeeShareRewards(),
+		NewProposeFeeShareOwner(),
+		NewAcceptFeeShareOwner(),
 	)
 	return txCmd
 }
@@ -36,9 +41,9 @@ func NewTxCmd() *cobra.Command {
 // contract for fee distribution
 func NewRegisterFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "register [contract_bech32] [withdraw_bech32]",
+		Use:   "register [contract_bech32] [withdraw_bech32 | withdraw_bech32:weight_bps,...]",
 		Short: "Register a contract for fee distribution. Only the contract admin can register a contract.",
-		Long:  "Register a contract for feeshare distribution. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
+		Long:  "Register a contract for feeshare distribution. The fees can be split between multiple withdrawers by passing a comma separated list of address:weight_bps pairs where the weights add up to 10000. **NOTE** Please ensure, that the admin of the contract (or the DAO/factory that deployed the contract) is an account that is owned by your project, to avoid that an individual admin who leaves your project becomes malicious.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -49,12 +54,16 @@ func NewRegisterFeeShare() *cobra.Command {
 			deployer := cliCtx.GetFromAddress()
 
 			contract := args[0]
-			withdrawer := args[1]
+			withdrawer, withdrawers, err := parseWithdrawers(args[1])
+			if err != nil {
+				return err
+			}
 
 			msg := &types.MsgRegisterFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -104,13 +113,13 @@ func NewCancelFeeShare() *cobra.Command {
 	return cmd
 }
 
-// NewUpdateFeeShare returns a CLI command handler for updating the withdraw
-// address of a contract for fee distribution
+// NewUpdateFeeShare returns a CLI command handler for updating the withdrawers
+// of a contract for fee distribution
 func NewUpdateFeeShare() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update [contract_bech32] [new_withdraw_bech32]",
+		Use:   "update [contract_bech32] [new_withdraw_bech32 | withdraw_bech32:weight_bps,...]",
 		Short: "Update withdrawer address for a contract registered for feeshare distribution.",
-		Long:  "Update withdrawer address for a contract registered for feeshare distribution. \nOnly the contract admin can update the withdrawer address.",
+		Long:  "Update withdrawer address for a contract registered for feeshare distribution. The fees can be split between multiple withdrawers by passing a comma separated list of address:weight_bps pairs where the weights add up to 10000. \nOnly the contract admin can update the withdrawer address.",
 		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
@@ -125,12 +134,16 @@ func NewUpdateFeeShare() *cobra.Command {
 				return fmt.Errorf("invalid contract bech32 address %w", err)
 			}
 
-			withdrawer := args[1]
+			withdrawer, withdrawers, err := parseWithdrawers(args[1])
+			if err != nil {
+				return err
+			}
 
 			msg := &types.MsgUpdateFeeShare{
 				ContractAddress:   contract,
 				DeployerAddress:   deployer.String(),
 				WithdrawerAddress: withdrawer,
+				Withdrawers:       withdrawers,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -144,3 +157,122 @@ func NewUpdateFeeShare() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewWithdrawFeeShareRewards returns a CLI command handler for withdrawing
+// the fees accrued by the sender
+func NewWithdrawFeeShareRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards",
+		Short: "Withdraw the feeshare rewards accrued by the sender.",
+		Long:  "Withdraw the feeshare rewards accrued by the sender. Rewards are only accrued when the payout mode of the module is set to accrue.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawFeeShareRewards(cliCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewProposeFeeShareOwner returns a CLI command handler for proposing a
+// new owner for a contract registered for fee distribution
+func NewProposeFeeShareOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-owner [contract_bech32] [new_owner_bech32]",
+		Short: "Propose a new owner for a contract registered for feeshare distribution.",
+		Long:  "Propose a new owner for a contract registered for feeshare distribution. The new owner must accept the ownership with the accept-owner command.\nOnly the current owner can propose a new owner, proposing the current owner cancels the pending transfer.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposeFeeShareOwner{
+				ContractAddress: args[0],
+				DeployerAddress: cliCtx.GetFromAddress().String(),
+				NewOwnerAddress: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAcceptFeeShareOwner returns a CLI command handler for accepting the
+// ownership of a contract registered for fee distribution
+func NewAcceptFeeShareOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-owner [contract_bech32]",
+		Short: "Accept the ownership of a contract registered for feeshare distribution.",
+		Long:  "Accept the ownership of a contract registered for feeshare distribution.\nOnly the owner proposed by the current owner can accept the ownership.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptFeeShareOwner{
+				ContractAddress: args[0],
+				NewOwnerAddress: cliCtx.GetFromAddress().String(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseWithdrawers parses either a single withdraw address or a comma
+// separated list of address:weight_bps pairs.
+func parseWithdrawers(arg string) (string, []types.Withdrawer, error) {
+	if !strings.Contains(arg, ":") {
+		return arg, nil, nil
+	}
+
+	var withdrawers []types.Withdrawer
+	for _, pair := range strings.Split(arg, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), ":")
+		if len(parts) != 2 {
+			return "", nil, fmt.Errorf("invalid withdrawer %s, expected address:weight_bps", pair)
+		}
+
+		weight, err := strconv.ParseUint(parts[1], 10, 32)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid weight for withdrawer %s: %w", parts[0], err)
+		}
+
+		withdrawers = append(withdrawers, types.Withdrawer{
+			Address:   parts[0],
+			WeightBps: uint32(weight),
+		})
+	}
+
+	return "", withdrawers, nil
+}
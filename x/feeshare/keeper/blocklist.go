@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// HasBlocklistEntry returns true if governance
+// has added the given entry to the blocklist.
+func (k Keeper) HasBlocklistEntry(ctx sdk.Context, entry types.BlocklistEntry) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyBlocklistEntry(entry))
+}
+
+// AddBlocklistEntry adds a contract or a code id to the blocklist.
+func (k Keeper) AddBlocklistEntry(ctx sdk.Context, entry types.BlocklistEntry) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&entry)
+	store.Set(types.GetKeyBlocklistEntry(entry), bz)
+}
+
+// DeleteBlocklistEntry removes a contract or a code id from the blocklist.
+func (k Keeper) DeleteBlocklistEntry(ctx sdk.Context, entry types.BlocklistEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyBlocklistEntry(entry))
+}
+
+// GetBlocklist returns the blocklist entries, the
+// contracts are listed before the code ids.
+func (k Keeper) GetBlocklist(ctx sdk.Context) []types.BlocklistEntry {
+	entries := []types.BlocklistEntry{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlocklist)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlocklistEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// IsContractBlocked returns true if the contract or the code
+// id it was instantiated from are in the blocklist.
+func (k Keeper) IsContractBlocked(ctx sdk.Context, contract sdk.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.GetKeyContractBlocklist(contract)) {
+		return true
+	}
+
+	info := k.wasmKeeper.GetContractInfo(ctx, sdk.AccAddress(contract.Bytes()))
+	return info != nil && store.Has(types.GetKeyCodeBlocklist(info.CodeID))
+}
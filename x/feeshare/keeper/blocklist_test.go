@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestSetBlocklistEntry() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	testCases := []struct {
+		name       string
+		msg        *types.MsgSetBlocklistEntry
+		expErr     bool
+		expBlocked bool
+	}{
+		{
+			"invalid authority",
+			&types.MsgSetBlocklistEntry{Authority: sender.String(), CodeId: 1},
+			true,
+			false,
+		},
+		{
+			"code id does not exist",
+			&types.MsgSetBlocklistEntry{Authority: authority, CodeId: 2},
+			true,
+			false,
+		},
+		{
+			"contract does not exist",
+			&types.MsgSetBlocklistEntry{Authority: authority, ContractAddress: s.TestAccs[1].String()},
+			true,
+			false,
+		},
+		{
+			"contract address and code id",
+			&types.MsgSetBlocklistEntry{Authority: authority, ContractAddress: contractAddress, CodeId: 1},
+			true,
+			false,
+		},
+		{
+			"remove an entry that does not exist",
+			&types.MsgSetBlocklistEntry{Authority: authority, CodeId: 1, Remove: true},
+			true,
+			false,
+		},
+		{
+			"block the code id",
+			&types.MsgSetBlocklistEntry{Authority: authority, CodeId: 1},
+			false,
+			true,
+		},
+		{
+			"block the code id twice",
+			&types.MsgSetBlocklistEntry{Authority: authority, CodeId: 1},
+			true,
+			true,
+		},
+		{
+			"block the contract",
+			&types.MsgSetBlocklistEntry{Authority: authority, ContractAddress: contractAddress},
+			false,
+			true,
+		},
+		{
+			"unblock the code id keeps the contract blocked",
+			&types.MsgSetBlocklistEntry{Authority: authority, CodeId: 1, Remove: true},
+			false,
+			true,
+		},
+		{
+			"unblock the contract",
+			&types.MsgSetBlocklistEntry{Authority: authority, ContractAddress: contractAddress, Remove: true},
+			false,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := s.App.Keepers.FeeShareKeeper.SetBlocklistEntry(goCtx, tc.msg)
+		if tc.expErr {
+			s.Require().Error(err, tc.name)
+		} else {
+			s.Require().NoError(err, tc.name)
+			s.AssertEventEmitted(s.Ctx, types.EventTypeSetBlocklistEntry, 1)
+		}
+		s.Require().Equal(tc.expBlocked, s.App.Keepers.FeeShareKeeper.IsContractBlocked(s.Ctx, contract), tc.name)
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+		goCtx = sdk.WrapSDKContext(s.Ctx)
+	}
+}
+
+func (s *IntegrationTestSuite) TestBlockedContractRegistration() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	withdrawer := s.TestAccs[1]
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	msg := &types.MsgRegisterFeeShare{
+		ContractAddress:   contractAddress,
+		DeployerAddress:   sender.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+
+	// Contracts of a blocked code id cannot be registered
+	s.App.Keepers.FeeShareKeeper.AddBlocklistEntry(s.Ctx, types.NewCodeBlocklistEntry(1))
+	_, err := s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, msg)
+	s.Require().ErrorIs(err, types.ErrFeeShareContractBlocked)
+
+	// Once unblocked the contract can be registered
+	s.App.Keepers.FeeShareKeeper.DeleteBlocklistEntry(s.Ctx, types.NewCodeBlocklistEntry(1))
+	_, err = s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, msg)
+	s.Require().NoError(err)
+
+	// Blocking a registered contract suspends the registration without deleting it
+	s.App.Keepers.FeeShareKeeper.AddBlocklistEntry(s.Ctx, types.NewContractBlocklistEntry(contract))
+	_, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsContractBlocked(s.Ctx, contract))
+}
+
+func (s *IntegrationTestSuite) TestBlocklistQueries() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	res, err := s.queryClient.ContractBlocked(goCtx, &types.QueryContractBlockedRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().False(res.Blocked)
+
+	_, err = s.queryClient.ContractBlocked(goCtx, &types.QueryContractBlockedRequest{ContractAddress: "invalid"})
+	s.Require().Error(err)
+
+	s.App.Keepers.FeeShareKeeper.AddBlocklistEntry(s.Ctx, types.NewCodeBlocklistEntry(1))
+	s.App.Keepers.FeeShareKeeper.AddBlocklistEntry(s.Ctx, types.NewContractBlocklistEntry(contract))
+
+	res, err = s.queryClient.ContractBlocked(goCtx, &types.QueryContractBlockedRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().True(res.Blocked)
+
+	listRes, err := s.queryClient.Blocklist(goCtx, &types.QueryBlocklistRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.BlocklistEntry{
+		types.NewContractBlocklistEntry(contract),
+		types.NewCodeBlocklistEntry(1),
+	}, listRes.Blocklist)
+
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(listRes.Blocklist, genesis.Blocklist)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.Keepers.FeeShareKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis.Blocklist, s.App.Keepers.FeeShareKeeper.GetBlocklist(s.Ctx))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// GetEscrowedPayout returns the developer shares escrowed for
+// a withdrawer of a contract until the end of the payout epoch.
+func (k Keeper) GetEscrowedPayout(ctx sdk.Context, contract sdk.Address, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEscrowedPayout)
+	bz := store.Get(types.GetKeyEscrowedPayout(contract, withdrawer))
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var payout types.EscrowedPayout
+	k.cdc.MustUnmarshal(bz, &payout)
+	return payout.Fees
+}
+
+// SetEscrowedPayout stores the developer shares escrowed for a withdrawer
+// of a contract, the entry is removed when there are no fees left.
+func (k Keeper) SetEscrowedPayout(ctx sdk.Context, contract sdk.Address, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixEscrowedPayout)
+	key := types.GetKeyEscrowedPayout(contract, withdrawer)
+	if fees.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&types.EscrowedPayout{
+		ContractAddress:   contract.String(),
+		WithdrawerAddress: withdrawer.String(),
+		Fees:              fees,
+	})
+	store.Set(key, bz)
+}
+
+// EscrowPayout adds the fees to the developer shares escrowed for a
+// withdrawer of a contract. The caller is responsible for moving the
+// fees to the module account.
+func (k Keeper) EscrowPayout(ctx sdk.Context, contract sdk.Address, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	k.SetEscrowedPayout(ctx, contract, withdrawer, k.GetEscrowedPayout(ctx, contract, withdrawer).Add(fees...))
+}
+
+// GetAllEscrowedPayouts returns the developer shares escrowed
+// for all withdrawers ordered by contract.
+func (k Keeper) GetAllEscrowedPayouts(ctx sdk.Context) []types.EscrowedPayout {
+	payouts := []types.EscrowedPayout{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEscrowedPayout)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var payout types.EscrowedPayout
+		k.cdc.MustUnmarshal(iterator.Value(), &payout)
+
+		payouts = append(payouts, payout)
+	}
+
+	return payouts
+}
+
+// HasEscrowedPayouts returns true if developer shares are escrowed
+// for any withdrawer.
+func (k Keeper) HasEscrowedPayouts(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixEscrowedPayout)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// IsPayoutEpochEnd returns true if the escrowed developer shares have to
+// be distributed at the current block, which happens every payout epoch
+// blocks or as soon as governance switches to another payout mode.
+func (k Keeper) IsPayoutEpochEnd(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	if params.PayoutMode != types.PayoutModeEpoch || params.PayoutEpochBlocks == 0 {
+		return true
+	}
+	return uint64(ctx.BlockHeight())%params.PayoutEpochBlocks == 0
+}
+
+// DistributeEscrowedPayouts sends the escrowed developer shares to the
+// withdrawers with a single bank send per withdrawer, records the revenue
+// of each contract and emits a single FeePayoutBatch event. The shares of a
+// withdrawer that cannot receive funds stay escrowed for the next epoch.
+//
+// The sends go through the before send hooks of the bank keeper like the
+// direct payouts, so that the hook of a factory denom cannot be bypassed.
+// This is safe in EndBlock because the hooks run with the gas limits of
+// the tokenfactory params and recover from running out of gas, and each
+// withdrawer is paid in its own cache context, so a hook rejecting a send
+// only keeps the shares of that withdrawer escrowed.
+func (k Keeper) DistributeEscrowedPayouts(ctx sdk.Context) error {
+	escrowedPayouts := k.GetAllEscrowedPayouts(ctx)
+	if len(escrowedPayouts) == 0 {
+		return nil
+	}
+
+	// aggregate the payouts of each withdrawer in store order
+	var withdrawers []string
+	payoutsByWithdrawer := make(map[string][]types.EscrowedPayout)
+	for _, ep := range escrowedPayouts {
+		if _, found := payoutsByWithdrawer[ep.WithdrawerAddress]; !found {
+			withdrawers = append(withdrawers, ep.WithdrawerAddress)
+		}
+		payoutsByWithdrawer[ep.WithdrawerAddress] = append(payoutsByWithdrawer[ep.WithdrawerAddress], ep)
+	}
+
+	params := k.GetParams(ctx)
+	batch := types.FeePayoutBatch{Height: ctx.BlockHeight()}
+	var totalFees sdk.Coins
+	for _, withdrawerAddress := range withdrawers {
+		withdrawer := sdk.MustAccAddressFromBech32(withdrawerAddress)
+		var fees sdk.Coins
+		for _, ep := range payoutsByWithdrawer[withdrawerAddress] {
+			fees = fees.Add(ep.Fees...)
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, withdrawer, fees); err != nil {
+			k.Logger(ctx).Error("failed to distribute escrowed fees", "withdrawer", withdrawerAddress, "error", err)
+			continue
+		}
+		for _, ep := range payoutsByWithdrawer[withdrawerAddress] {
+			contract := sdk.MustAccAddressFromBech32(ep.ContractAddress)
+			k.RecordRevenue(cacheCtx, params, contract, withdrawer, ep.Fees)
+			k.SetEscrowedPayout(cacheCtx, contract, withdrawer, nil)
+		}
+		write()
+
+		batch.Payouts = append(batch.Payouts, types.FeePayoutEvent{
+			WithdrawAddress: withdrawerAddress,
+			FeesPaid:        fees,
+		})
+		totalFees = totalFees.Add(fees...)
+	}
+
+	if len(batch.Payouts) == 0 {
+		return nil
+	}
+	batch.TotalFees = totalFees
+	return ctx.EventManager().EmitTypedEvent(&batch)
+}
@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestDistributeEscrowedPayouts() {
+	s.SetupTest()
+	feeshareKeeper := s.App.Keepers.FeeShareKeeper
+	contractA, contractB := s.TestAccs[0], s.TestAccs[1]
+	withdrawers := s.CreateRandomAccounts(2)
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutModeEpoch
+	params.PayoutEpochBlocks = 10
+	s.Require().NoError(feeshareKeeper.SetParams(s.Ctx, params))
+
+	// Escrow the developer shares of two contracts and fund the module account accordingly
+	s.Require().NoError(s.FundModule(types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uluna", 300))))
+	feeshareKeeper.EscrowPayout(s.Ctx, contractA, withdrawers[0], sdk.NewCoins(sdk.NewInt64Coin("uluna", 60)))
+	feeshareKeeper.EscrowPayout(s.Ctx, contractA, withdrawers[0], sdk.NewCoins(sdk.NewInt64Coin("uluna", 40)))
+	feeshareKeeper.EscrowPayout(s.Ctx, contractB, withdrawers[0], sdk.NewCoins(sdk.NewInt64Coin("uluna", 50)))
+	feeshareKeeper.EscrowPayout(s.Ctx, contractA, withdrawers[1], sdk.NewCoins(sdk.NewInt64Coin("uluna", 150)))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 100)), feeshareKeeper.GetEscrowedPayout(s.Ctx, contractA, withdrawers[0]))
+	s.Require().Len(feeshareKeeper.GetAllEscrowedPayouts(s.Ctx), 3)
+	s.Require().True(feeshareKeeper.HasEscrowedPayouts(s.Ctx))
+
+	// The escrow is only distributed at the end of the epoch...
+	s.Require().False(feeshareKeeper.IsPayoutEpochEnd(s.Ctx.WithBlockHeight(11)))
+	s.Require().True(feeshareKeeper.IsPayoutEpochEnd(s.Ctx.WithBlockHeight(20)))
+
+	balances := []sdk.Coins{
+		s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawers[0]),
+		s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawers[1]),
+	}
+	s.Ctx = s.Ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(feeshareKeeper.DistributeEscrowedPayouts(s.Ctx))
+
+	// ... with a single send per withdrawer and a single batch event
+	s.Require().Equal(balances[0].Add(sdk.NewInt64Coin("uluna", 150)), s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawers[0]))
+	s.Require().Equal(balances[1].Add(sdk.NewInt64Coin("uluna", 150)), s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawers[1]))
+	s.AssertEventEmitted(s.Ctx, "transfer", 2)
+	s.AssertEventEmitted(s.Ctx, "juno.feeshare.v1.FeePayoutBatch", 1)
+	s.Require().Empty(feeshareKeeper.GetAllEscrowedPayouts(s.Ctx))
+	s.Require().False(feeshareKeeper.HasEscrowedPayouts(s.Ctx))
+
+	// The revenue is recorded when the escrow is distributed
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 250)), feeshareKeeper.GetContractRevenue(s.Ctx, contractA))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 50)), feeshareKeeper.GetContractRevenue(s.Ctx, contractB))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 300)), feeshareKeeper.GetBlockRevenue(s.Ctx, 20))
+
+	// Nothing is emitted when there is nothing to distribute
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(feeshareKeeper.DistributeEscrowedPayouts(s.Ctx))
+	s.AssertEventEmitted(s.Ctx, "juno.feeshare.v1.FeePayoutBatch", 0)
+
+	// Switching to another payout mode distributes the escrow right away
+	params.PayoutMode = types.PayoutModeDirect
+	s.Require().NoError(feeshareKeeper.SetParams(s.Ctx, params))
+	s.Require().True(feeshareKeeper.IsPayoutEpochEnd(s.Ctx.WithBlockHeight(11)))
+}
+
+func (s *IntegrationTestSuite) TestDistributeEscrowedPayoutsEndBlock() {
+	s.SetupTest()
+	feeshareKeeper := s.App.Keepers.FeeShareKeeper
+	feeshareModule := s.App.GetModuleManager().Modules[types.ModuleName].(module.EndBlockAppModule)
+	contract, withdrawer := s.TestAccs[0], s.TestAccs[1]
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutModeEpoch
+	params.PayoutEpochBlocks = 10
+	s.Require().NoError(feeshareKeeper.SetParams(s.Ctx, params))
+	s.Require().NoError(s.FundModule(types.ModuleName, fees))
+	feeshareKeeper.EscrowPayout(s.Ctx, contract, withdrawer, fees)
+
+	// The escrow is kept until the end of the epoch...
+	ctx := s.Ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	feeshareModule.EndBlock(ctx, abci.RequestEndBlock{})
+	s.Require().Equal(fees, feeshareKeeper.GetEscrowedPayout(ctx, contract, withdrawer))
+
+	// ... and distributed at the end of the epoch
+	ctx = s.Ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	feeshareModule.EndBlock(ctx, abci.RequestEndBlock{})
+	s.Require().False(feeshareKeeper.HasEscrowedPayouts(ctx))
+	s.AssertEventEmitted(ctx, "juno.feeshare.v1.FeePayoutBatch", 1)
+
+	// Nothing is distributed outside of the epoch mode when nothing is escrowed
+	params.PayoutMode = types.PayoutModeDirect
+	s.Require().NoError(feeshareKeeper.SetParams(s.Ctx, params))
+	ctx = s.Ctx.WithBlockHeight(21).WithEventManager(sdk.NewEventManager())
+	feeshareModule.EndBlock(ctx, abci.RequestEndBlock{})
+	s.AssertEventEmitted(ctx, "juno.feeshare.v1.FeePayoutBatch", 0)
+}
+
+func (s *IntegrationTestSuite) TestDistributeEscrowedPayoutsToBlockedAddress() {
+	s.SetupTest()
+	feeshareKeeper := s.App.Keepers.FeeShareKeeper
+	contract := s.TestAccs[0]
+	withdrawer := s.TestAccs[1]
+	blockedWithdrawer := s.App.Keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+
+	s.Require().NoError(s.FundModule(types.ModuleName, fees.Add(fees...)))
+	feeshareKeeper.EscrowPayout(s.Ctx, contract, withdrawer, fees)
+	feeshareKeeper.EscrowPayout(s.Ctx, contract, blockedWithdrawer, fees)
+	s.Require().NoError(feeshareKeeper.DistributeEscrowedPayouts(s.Ctx))
+
+	// The fees of the withdrawer that cannot receive funds stay escrowed
+	s.Require().Equal(fees, feeshareKeeper.GetEscrowedPayout(s.Ctx, contract, blockedWithdrawer))
+	s.Require().True(feeshareKeeper.GetEscrowedPayout(s.Ctx, contract, withdrawer).IsZero())
+}
+
+func (s *IntegrationTestSuite) TestEscrowedPayoutsGenesis() {
+	s.SetupTest()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+
+	s.App.Keepers.FeeShareKeeper.EscrowPayout(s.Ctx, s.TestAccs[0], s.TestAccs[1], fees)
+	s.App.Keepers.FeeShareKeeper.EscrowPayout(s.Ctx, s.TestAccs[0], s.TestAccs[2], fees)
+
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.EscrowedPayouts, 2)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.Keepers.FeeShareKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis.EscrowedPayouts, s.App.Keepers.FeeShareKeeper.GetAllEscrowedPayouts(s.Ctx))
+}
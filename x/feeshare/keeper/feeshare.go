@@ -153,3 +153,56 @@ func (k Keeper) IsWithdrawerMapSet(
 	key := append(withdrawer.Bytes(), contract.Bytes()...)
 	return store.Has(key)
 }
+
+// GetPendingOwner returns the owner proposed for a registered
+// contract that has not accepted the ownership yet.
+func (k Keeper) GetPendingOwner(
+	ctx sdk.Context,
+	contract sdk.Address,
+) (sdk.AccAddress, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingOwner)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	return sdk.AccAddress(bz), true
+}
+
+// SetPendingOwner stores the owner proposed for a registered contract
+func (k Keeper) SetPendingOwner(
+	ctx sdk.Context,
+	contract sdk.Address,
+	owner sdk.AccAddress,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingOwner)
+	store.Set(contract.Bytes(), owner.Bytes())
+}
+
+// DeletePendingOwner deletes the owner proposed for a registered contract
+func (k Keeper) DeletePendingOwner(
+	ctx sdk.Context,
+	contract sdk.Address,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingOwner)
+	store.Delete(contract.Bytes())
+}
+
+// GetAllPendingOwners returns the ownership transfers
+// that have not been accepted yet.
+func (k Keeper) GetAllPendingOwners(ctx sdk.Context) []types.PendingOwner {
+	pendingOwners := []types.PendingOwner{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingOwner)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pendingOwners = append(pendingOwners, types.PendingOwner{
+			ContractAddress: sdk.AccAddress(iterator.Key()).String(),
+			OwnerAddress:    sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+
+	return pendingOwners
+}
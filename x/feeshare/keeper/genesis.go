@@ -18,22 +18,61 @@ func (k Keeper) InitGenesis(
 	for _, share := range data.FeeShare {
 		contract := share.GetContractAddr()
 		deployer := share.GetDeployerAddr()
-		withdrawer := share.GetWithdrawerAddr()
 
 		// Set initial contracts receiving transaction fees
 		k.SetFeeShare(ctx, share)
 		k.SetDeployerMap(ctx, deployer, contract)
 
-		if len(withdrawer) != 0 {
+		for _, withdrawer := range share.GetWithdrawerAddrs() {
 			k.SetWithdrawerMap(ctx, withdrawer, contract)
 		}
 	}
+
+	for _, pr := range data.PendingRewards {
+		k.SetPendingRewards(ctx, sdk.MustAccAddressFromBech32(pr.WithdrawerAddress), pr.Rewards)
+	}
+
+	for _, cr := range data.ContractRevenues {
+		k.SetContractRevenue(ctx, sdk.MustAccAddressFromBech32(cr.ContractAddress), cr.Revenue)
+	}
+
+	for _, wr := range data.WithdrawerRevenues {
+		k.SetWithdrawerRevenue(ctx, sdk.MustAccAddressFromBech32(wr.WithdrawerAddress), wr.Revenue)
+	}
+
+	for _, br := range data.BlockRevenues {
+		k.SetBlockRevenue(ctx, br.Height, br.Revenue)
+	}
+
+	for _, so := range data.ShareOverrides {
+		k.SetShareOverride(ctx, so)
+	}
+
+	for _, entry := range data.Blocklist {
+		k.AddBlocklistEntry(ctx, entry)
+	}
+
+	for _, po := range data.PendingOwners {
+		k.SetPendingOwner(ctx, sdk.MustAccAddressFromBech32(po.ContractAddress), sdk.MustAccAddressFromBech32(po.OwnerAddress))
+	}
+
+	for _, ep := range data.EscrowedPayouts {
+		k.SetEscrowedPayout(ctx, sdk.MustAccAddressFromBech32(ep.ContractAddress), sdk.MustAccAddressFromBech32(ep.WithdrawerAddress), ep.Fees)
+	}
 }
 
 // ExportGenesis export module state
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		FeeShare: k.GetFeeShares(ctx),
+		Params:             k.GetParams(ctx),
+		FeeShare:           k.GetFeeShares(ctx),
+		PendingRewards:     k.GetAllPendingRewards(ctx),
+		ContractRevenues:   k.GetAllContractRevenues(ctx),
+		WithdrawerRevenues: k.GetAllWithdrawerRevenues(ctx),
+		BlockRevenues:      k.GetAllBlockRevenues(ctx),
+		ShareOverrides:     k.GetAllShareOverrides(ctx),
+		Blocklist:          k.GetBlocklist(ctx),
+		PendingOwners:      k.GetAllPendingOwners(ctx),
+		EscrowedPayouts:    k.GetAllEscrowedPayouts(ctx),
 	}
 }
@@ -177,3 +177,269 @@ func (q Querier) WithdrawerFeeShares( // nolint: dupl
 		Pagination:        pageRes,
 	}, nil
 }
+
+// PendingRewards returns the fees accrued by a withdrawer that
+// have not been withdrawn yet
+func (q Querier) PendingRewards(
+	c context.Context,
+	req *types.QueryPendingRewardsRequest,
+) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryPendingRewardsResponse{
+		Rewards: q.GetPendingRewards(ctx, withdrawer),
+	}, nil
+}
+
+// PendingFeeShareOwner returns the owner proposed for a
+// registered contract that has not accepted the ownership yet
+func (q Querier) PendingFeeShareOwner(
+	c context.Context,
+	req *types.QueryPendingFeeShareOwnerRequest,
+) (*types.QueryPendingFeeShareOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be bech32", req.ContractAddress,
+		)
+	}
+
+	owner, found := q.GetPendingOwner(ctx, contract)
+	if !found {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"pending owner for contract '%s'",
+			req.ContractAddress,
+		)
+	}
+
+	return &types.QueryPendingFeeShareOwnerResponse{OwnerAddress: owner.String()}, nil
+}
+
+// ContractRevenue returns the cumulative fees distributed
+// for a registered contract
+func (q Querier) ContractRevenue(
+	c context.Context,
+	req *types.QueryContractRevenueRequest,
+) (*types.QueryContractRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be bech32", req.ContractAddress,
+		)
+	}
+
+	return &types.QueryContractRevenueResponse{
+		Revenue: q.GetContractRevenue(ctx, contract),
+	}, nil
+}
+
+// WithdrawerRevenue returns the cumulative fees
+// distributed to a withdrawer
+func (q Querier) WithdrawerRevenue(
+	c context.Context,
+	req *types.QueryWithdrawerRevenueRequest,
+) (*types.QueryWithdrawerRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for withdraw addr %s, should be bech32", req.WithdrawerAddress,
+		)
+	}
+
+	return &types.QueryWithdrawerRevenueResponse{
+		Revenue: q.GetWithdrawerRevenue(ctx, withdrawer),
+	}, nil
+}
+
+// TopEarners returns the withdrawers that received fees
+// in the given denom sorted by the amount received
+func (q Querier) TopEarners(
+	c context.Context,
+	req *types.QueryTopEarnersRequest,
+) (*types.QueryTopEarnersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var earners []types.WithdrawerRevenue
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetKeyPrefixTopEarners(req.Denom))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		withdrawer := types.ParseTopEarnerKey(key)
+		earners = append(earners, types.WithdrawerRevenue{
+			WithdrawerAddress: withdrawer.String(),
+			Revenue:           q.GetWithdrawerRevenue(ctx, withdrawer),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopEarnersResponse{
+		Earners:    earners,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BlockRevenues returns the fees distributed in each block
+func (q Querier) BlockRevenues(
+	c context.Context,
+	req *types.QueryBlockRevenuesRequest,
+) (*types.QueryBlockRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var revenues []types.BlockRevenue
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixBlockRevenue)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var revenue types.BlockRevenue
+		if err := q.cdc.Unmarshal(value, &revenue); err != nil {
+			return err
+		}
+		revenues = append(revenues, revenue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlockRevenuesResponse{
+		BlockRevenues: revenues,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// ShareOverrides returns the developer shares overrides
+// set by governance for contracts and code ids
+func (q Querier) ShareOverrides(
+	c context.Context,
+	req *types.QueryShareOverridesRequest,
+) (*types.QueryShareOverridesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var overrides []types.ShareOverride
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixShareOverride)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var override types.ShareOverride
+		if err := q.cdc.Unmarshal(value, &override); err != nil {
+			return err
+		}
+		overrides = append(overrides, override)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryShareOverridesResponse{
+		ShareOverrides: overrides,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// Blocklist returns the contracts and code ids
+// blocked by governance from receiving fee shares
+func (q Querier) Blocklist(
+	c context.Context,
+	req *types.QueryBlocklistRequest,
+) (*types.QueryBlocklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var entries []types.BlocklistEntry
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.KeyPrefixBlocklist)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var entry types.BlocklistEntry
+		if err := q.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBlocklistResponse{
+		Blocklist:  entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// ContractBlocked returns whether a contract is blocked from
+// receiving fee shares directly or through its code id
+func (q Querier) ContractBlocked(
+	c context.Context,
+	req *types.QueryContractBlockedRequest,
+) (*types.QueryContractBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"invalid format for contract %s, should be bech32", req.ContractAddress,
+		)
+	}
+
+	return &types.QueryContractBlockedResponse{
+		Blocked: q.IsContractBlocked(ctx, contract),
+	}, nil
+}
@@ -30,9 +30,9 @@ func (s *IntegrationTestSuite) TestFeeShares() {
 		}
 
 		feeShare := types.FeeShare{
-			ContractAddress:   contractAddress,
-			DeployerAddress:   sender.String(),
-			WithdrawerAddress: withdrawer.String(),
+			ContractAddress: contractAddress,
+			DeployerAddress: sender.String(),
+			Withdrawers:     []types.Withdrawer{types.NewWithdrawer(withdrawer, types.BasisPointsTotal)},
 		}
 
 		feeShares = append(feeShares, feeShare)
@@ -95,9 +95,9 @@ func (s *IntegrationTestSuite) TestFeeShare() {
 	}
 
 	feeShare := types.FeeShare{
-		ContractAddress:   contractAddress,
-		DeployerAddress:   sender.String(),
-		WithdrawerAddress: withdrawer.String(),
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers:     []types.Withdrawer{types.NewWithdrawer(withdrawer, types.BasisPointsTotal)},
 	}
 	_, err := s.App.Keepers.FeeShareKeeper.RegisterFeeShare(s.Ctx, msg)
 	s.Require().NoError(err)
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// RegisterInvariants registers the feeshare module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "deployer-index", DeployerIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "withdrawer-index", WithdrawerIndexInvariant(k))
+}
+
+// DeployerIndexInvariant checks that the deployer of every FeeShare has
+// a contract-by-deployer entry, and that every entry belongs to a FeeShare.
+func DeployerIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]bool)
+		k.IterateFeeShares(ctx, func(fs types.FeeShare) bool {
+			if deployer := fs.GetDeployerAddr(); deployer != nil {
+				expected[string(append(deployer.Bytes(), fs.GetContractAddr().Bytes()...))] = true
+			}
+			return false
+		})
+
+		msg, broken := k.checkIndex(ctx, types.KeyPrefixDeployer, expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, "deployer-index",
+			fmt.Sprintf("\tinconsistent contract-by-deployer entries: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// WithdrawerIndexInvariant checks that each withdrawer of every FeeShare
+// has a contract-by-withdrawer entry, and that every entry belongs to a
+// FeeShare.
+func WithdrawerIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := make(map[string]bool)
+		k.IterateFeeShares(ctx, func(fs types.FeeShare) bool {
+			for _, withdrawer := range fs.GetWithdrawerAddrs() {
+				expected[string(append(withdrawer.Bytes(), fs.GetContractAddr().Bytes()...))] = true
+			}
+			return false
+		})
+
+		msg, broken := k.checkIndex(ctx, types.KeyPrefixWithdrawer, expected)
+		return sdk.FormatInvariant(
+			types.ModuleName, "withdrawer-index",
+			fmt.Sprintf("\tinconsistent contract-by-withdrawer entries: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// checkIndex compares the entries of an index with the entries expected
+// from the FeeShare records. The index keys are not length prefixed, so
+// the entries are compared as a whole instead of being decoded.
+func (k Keeper) checkIndex(ctx sdk.Context, indexPrefix []byte, expected map[string]bool) (msg string, broken int) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := string(iterator.Key())
+		if !expected[key] {
+			broken++
+			msg += fmt.Sprintf("\tentry %X does not belong to any FeeShare\n", iterator.Key())
+			continue
+		}
+		delete(expected, key)
+	}
+
+	missing := make([]string, 0, len(expected))
+	for key := range expected {
+		missing = append(missing, key)
+	}
+	sort.Strings(missing)
+	for _, key := range missing {
+		broken++
+		msg += fmt.Sprintf("\tentry %X is missing\n", []byte(key))
+	}
+
+	return msg, broken
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/keeper"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestInvariants() {
+	for _, tc := range []struct {
+		desc      string
+		malleate  func(contract, deployer, withdrawer sdk.AccAddress)
+		invariant func(k keeper.Keeper) sdk.Invariant
+	}{
+		{
+			desc: "missing contract-by-deployer entry",
+			malleate: func(contract, deployer, _ sdk.AccAddress) {
+				s.App.Keepers.FeeShareKeeper.DeleteDeployerMap(s.Ctx, deployer, contract)
+			},
+			invariant: keeper.DeployerIndexInvariant,
+		},
+		{
+			desc: "contract-by-deployer entry without FeeShare",
+			malleate: func(_, deployer, _ sdk.AccAddress) {
+				s.App.Keepers.FeeShareKeeper.SetDeployerMap(s.Ctx, deployer, s.CreateRandomAccounts(1)[0])
+			},
+			invariant: keeper.DeployerIndexInvariant,
+		},
+		{
+			desc: "missing contract-by-withdrawer entry",
+			malleate: func(contract, _, withdrawer sdk.AccAddress) {
+				s.App.Keepers.FeeShareKeeper.DeleteWithdrawerMap(s.Ctx, withdrawer, contract)
+			},
+			invariant: keeper.WithdrawerIndexInvariant,
+		},
+		{
+			desc: "contract-by-withdrawer entry of a deleted FeeShare",
+			malleate: func(contract, _, _ sdk.AccAddress) {
+				fee, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+				s.Require().True(found)
+				s.App.Keepers.FeeShareKeeper.DeleteFeeShare(s.Ctx, fee)
+				s.App.Keepers.FeeShareKeeper.DeleteDeployerMap(s.Ctx, fee.GetDeployerAddr(), contract)
+			},
+			invariant: keeper.WithdrawerIndexInvariant,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+			contract, deployer, withdrawer := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+
+			feeshare := types.NewFeeShare(contract, deployer, []types.Withdrawer{
+				types.NewWithdrawer(withdrawer, types.BasisPointsTotal),
+			})
+			s.App.Keepers.FeeShareKeeper.SetFeeShare(s.Ctx, feeshare)
+			s.App.Keepers.FeeShareKeeper.SetDeployerMap(s.Ctx, deployer, contract)
+			s.App.Keepers.FeeShareKeeper.SetWithdrawerMap(s.Ctx, withdrawer, contract)
+
+			// the invariant holds for a consistent state...
+			_, broken := tc.invariant(s.App.Keepers.FeeShareKeeper)(s.Ctx)
+			s.Require().False(broken)
+
+			// ... and is broken by the inconsistency
+			tc.malleate(contract, deployer, withdrawer)
+			msg, broken := tc.invariant(s.App.Keepers.FeeShareKeeper)(s.Ctx)
+			s.Require().True(broken, msg)
+		})
+	}
+}
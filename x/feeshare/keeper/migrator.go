@@ -5,6 +5,10 @@ import (
 
 	"github.com/terra-money/core/v2/x/feeshare/exported"
 	v2 "github.com/terra-money/core/v2/x/feeshare/migrations/v2"
+	v3 "github.com/terra-money/core/v2/x/feeshare/migrations/v3"
+	v4 "github.com/terra-money/core/v2/x/feeshare/migrations/v4"
+	v5 "github.com/terra-money/core/v2/x/feeshare/migrations/v5"
+	v6 "github.com/terra-money/core/v2/x/feeshare/migrations/v6"
 )
 
 // Migrator is a struct for handling in-place state migrations.
@@ -27,3 +31,31 @@ func NewMigrator(k Keeper, ss exported.Subspace) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.legacySubspace, m.keeper.cdc)
 }
+
+// Migrate2to3 migrates the x/feeshare module state from the consensus version 2 to
+// version 3. Specifically, it converts the FeeShares registered with a single
+// withdrawer address into the weighted withdrawers format.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/feeshare module state from the consensus version 3 to
+// version 4. Specifically, it sets the block revenue retention blocks param to its
+// default value.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the x/feeshare module state from the consensus version 4 to
+// version 5. Specifically, it grants the burner permission to the feeshare module
+// account so the developer shares of the fees paid in disallowed denoms can be burned.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, m.keeper.accountKeeper)
+}
+
+// Migrate5to6 migrates the x/feeshare module state from the consensus version 5 to
+// version 6. Specifically, it sets the max payout recipients param to its default
+// value.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
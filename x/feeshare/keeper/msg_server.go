@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
@@ -112,10 +113,15 @@ func (k Keeper) RegisterFeeShare(
 		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "contract is already registered %s", contract)
 	}
 
-	// Get the withdraw address of the contract
-	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address %s", msg.WithdrawerAddress)
+	// Check if governance blocked the contract or its code id
+	if k.IsContractBlocked(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareContractBlocked, "contract %s", contract)
+	}
+
+	// Get the withdrawers of the contract
+	withdrawers := msg.GetWeightedWithdrawers()
+	if err := types.ValidateWithdrawers(withdrawers); err != nil {
+		return nil, err
 	}
 
 	// ensure msg.DeployerAddress is  valid
@@ -128,8 +134,8 @@ func (k Keeper) RegisterFeeShare(
 
 	if k.GetIfContractWasCreatedFromFactory(ctx, msgSender, k.wasmKeeper.GetContractInfo(ctx, contract)) {
 		// Anyone is allowed to register a contract to itself if it was created from a factory contract
-		if msg.WithdrawerAddress != msg.ContractAddress {
-			return nil, errorsmod.Wrapf(types.ErrFeeShareInvalidWithdrawer, "withdrawer address must be the same as the contract address if it is from a factory contract withdraw:%s contract:%s", msg.WithdrawerAddress, msg.ContractAddress)
+		if len(withdrawers) != 1 || withdrawers[0].Address != msg.ContractAddress {
+			return nil, errorsmod.Wrapf(types.ErrFeeShareInvalidWithdrawer, "withdrawer address must be the same as the contract address if it is from a factory contract withdraw:%s contract:%s", withdrawers[0].Address, msg.ContractAddress)
 		}
 
 		// set the deployer address to the contract address so it can self register
@@ -147,25 +153,25 @@ func (k Keeper) RegisterFeeShare(
 	}
 
 	// prevent storing the same address for deployer and withdrawer
-	feeshare := types.NewFeeShare(contract, deployer, withdrawer)
+	feeshare := types.NewFeeShare(contract, deployer, withdrawers)
 	k.SetFeeShare(ctx, feeshare)
 	k.SetDeployerMap(ctx, deployer, contract)
-	k.SetWithdrawerMap(ctx, withdrawer, contract)
+	for _, withdrawer := range feeshare.GetWithdrawerAddrs() {
+		k.SetWithdrawerMap(ctx, withdrawer, contract)
+	}
 
 	k.Logger(ctx).Debug(
 		"registering contract for transaction fees",
 		"contract", msg.ContractAddress,
 		"deployer", msg.DeployerAddress,
-		"withdraw", msg.WithdrawerAddress,
+		"withdrawers", withdrawers,
 	)
 
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeRegisterFeeShare,
-				// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
-				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				withdrawerAttributes(msg.ContractAddress, withdrawers)...,
 			),
 		},
 	)
@@ -173,9 +179,8 @@ func (k Keeper) RegisterFeeShare(
 	return &types.MsgRegisterFeeShareResponse{}, nil
 }
 
-// UpdateFeeShare updates the withdraw address of a given FeeShare. If the given
-// withdraw address is empty or the same as the deployer address, the withdraw
-// address is removed.
+// UpdateFeeShare replaces the withdrawers of a given FeeShare, either with a
+// single withdraw address or with a list of weighted withdrawers.
 func (k Keeper) UpdateFeeShare(
 	goCtx context.Context,
 	msg *types.MsgUpdateFeeShare,
@@ -202,43 +207,39 @@ func (k Keeper) UpdateFeeShare(
 		)
 	}
 
-	// feeshare with the given withdraw address is already registered
-	if msg.WithdrawerAddress == feeshare.WithdrawerAddress {
-		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "feeshare with withdraw address %s is already registered", msg.WithdrawerAddress)
-	}
-
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
-	if err != nil {
+	withdrawers := msg.GetWeightedWithdrawers()
+	if err := types.ValidateWithdrawers(withdrawers); err != nil {
 		return nil, err
 	}
 
-	withdrawAddr, err := sdk.AccAddressFromBech32(feeshare.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(
-			sdkerrors.ErrInvalidAddress,
-			"invalid withdrawer address (%s)", err,
-		)
+	// feeshare with the given withdrawers is already registered
+	if withdrawersEqual(withdrawers, feeshare.GetWeightedWithdrawers()) {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareAlreadyRegistered, "feeshare with withdrawers %v is already registered", withdrawers)
 	}
-	newWithdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
-	if err != nil {
-		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid WithdrawerAddress %s", msg.WithdrawerAddress)
+
+	// Check that the person who signed the message is the owner of the FeeShare
+	if feeshare.DeployerAddress != msg.DeployerAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "you are not the owner of the feeshare %s", feeshare.DeployerAddress)
 	}
 
-	k.DeleteWithdrawerMap(ctx, withdrawAddr, contract)
-	k.SetWithdrawerMap(ctx, newWithdrawAddr, contract)
+	for _, withdrawAddr := range feeshare.GetWithdrawerAddrs() {
+		k.DeleteWithdrawerMap(ctx, withdrawAddr, contract)
+	}
 
 	// update feeshare
-	feeshare.WithdrawerAddress = newWithdrawAddr.String()
+	feeshare.WithdrawerAddress = ""
+	feeshare.Withdrawers = withdrawers
 	k.SetFeeShare(ctx, feeshare)
 
+	for _, newWithdrawAddr := range feeshare.GetWithdrawerAddrs() {
+		k.SetWithdrawerMap(ctx, newWithdrawAddr, contract)
+	}
+
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
 				types.EventTypeUpdateFeeShare,
-				// sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress), // SDK v47
-				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
-				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				withdrawerAttributes(msg.ContractAddress, withdrawers)...,
 			),
 		},
 	)
@@ -268,21 +269,20 @@ func (k Keeper) CancelFeeShare(
 		return nil, errorsmod.Wrapf(types.ErrFeeShareContractNotRegistered, "contract %s is not registered", msg.ContractAddress)
 	}
 
-	// Check that the person who signed the message is the wasm contract admin, if so return the deployer address
-	_, err = k.GetContractAdminOrCreatorAddress(ctx, contract, msg.DeployerAddress)
-	if err != nil {
-		return nil, err
+	// Check that the person who signed the message is the owner of the FeeShare
+	if fee.DeployerAddress != msg.DeployerAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "you are not the owner of the feeshare %s", fee.DeployerAddress)
 	}
 
 	k.DeleteFeeShare(ctx, fee)
+	k.DeletePendingOwner(ctx, contract)
 	k.DeleteDeployerMap(
 		ctx,
 		fee.GetDeployerAddr(),
 		contract,
 	)
 
-	withdrawAddr := fee.GetWithdrawerAddr()
-	if withdrawAddr != nil {
+	for _, withdrawAddr := range fee.GetWithdrawerAddrs() {
 		k.DeleteWithdrawerMap(
 			ctx,
 			withdrawAddr,
@@ -303,6 +303,153 @@ func (k Keeper) CancelFeeShare(
 	return &types.MsgCancelFeeShareResponse{}, nil
 }
 
+// ProposeFeeShareOwner proposes a new owner for a FeeShare. The proposal is
+// sent by the current owner of the FeeShare, which does not need to be the
+// contract admin so the ownership can be transferred after the admin is
+// cleared, or by the current admin of the contract (or its creator if it
+// has no admin). Proposing the current owner cancels the pending transfer.
+func (k Keeper) ProposeFeeShareOwner(
+	goCtx context.Context,
+	msg *types.MsgProposeFeeShareOwner,
+) (*types.MsgProposeFeeShareOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwnerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address %s", msg.NewOwnerAddress)
+	}
+
+	feeshare, found := k.GetFeeShare(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareContractNotRegistered, "contract %s is not registered", msg.ContractAddress)
+	}
+
+	// Only the owner of the FeeShare can propose a new owner, the contract
+	// admin could otherwise propose itself and take the FeeShare over
+	if feeshare.DeployerAddress != msg.DeployerAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "you are not the owner of the feeshare %s", feeshare.DeployerAddress)
+	}
+
+	if newOwner.String() == feeshare.DeployerAddress {
+		k.DeletePendingOwner(ctx, contract)
+	} else {
+		k.SetPendingOwner(ctx, contract, newOwner)
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeProposeFeeShareOwner,
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyOwner, msg.NewOwnerAddress),
+			),
+		},
+	)
+
+	return &types.MsgProposeFeeShareOwnerResponse{}, nil
+}
+
+// AcceptFeeShareOwner moves the ownership of a FeeShare to the owner
+// proposed by its current owner and re-indexes the FeeShare by deployer.
+func (k Keeper) AcceptFeeShareOwner(
+	goCtx context.Context,
+	msg *types.MsgAcceptFeeShareOwner,
+) (*types.MsgAcceptFeeShareOwnerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableFeeShare {
+		return nil, types.ErrFeeShareDisabled
+	}
+
+	contract, err := sdk.AccAddressFromBech32(msg.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid contract address (%s)", err)
+	}
+
+	feeshare, found := k.GetFeeShare(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareContractNotRegistered, "contract %s is not registered", msg.ContractAddress)
+	}
+
+	pendingOwner, found := k.GetPendingOwner(ctx, contract)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareNoPendingOwner, "contract %s", msg.ContractAddress)
+	}
+	if pendingOwner.String() != msg.NewOwnerAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the proposed owner of the contract %s", msg.NewOwnerAddress, msg.ContractAddress)
+	}
+
+	previousOwner := feeshare.DeployerAddress
+	if deployer := feeshare.GetDeployerAddr(); deployer != nil {
+		k.DeleteDeployerMap(ctx, deployer, contract)
+	}
+
+	feeshare.DeployerAddress = pendingOwner.String()
+	k.SetFeeShare(ctx, feeshare)
+	k.SetDeployerMap(ctx, pendingOwner, contract)
+	k.DeletePendingOwner(ctx, contract)
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeAcceptFeeShareOwner,
+				sdk.NewAttribute(types.AttributeKeyContract, msg.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyOwner, msg.NewOwnerAddress),
+				sdk.NewAttribute(types.AttributeKeyPreviousOwner, previousOwner),
+			),
+		},
+	)
+
+	return &types.MsgAcceptFeeShareOwnerResponse{}, nil
+}
+
+// WithdrawFeeShareRewards pays out the fees accrued by a withdrawer
+// from the module account and clears its pending balance.
+func (k Keeper) WithdrawFeeShareRewards(
+	goCtx context.Context,
+	msg *types.MsgWithdrawFeeShareRewards,
+) (*types.MsgWithdrawFeeShareRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address %s", msg.WithdrawerAddress)
+	}
+
+	rewards := k.GetPendingRewards(ctx, withdrawer)
+	if rewards.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareNoPendingRewards, "withdrawer %s", msg.WithdrawerAddress)
+	}
+
+	k.SetPendingRewards(ctx, withdrawer, sdk.Coins{})
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, rewards); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeWithdrawFeeShareRewards,
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, msg.WithdrawerAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards.String()),
+			),
+		},
+	)
+
+	return &types.MsgWithdrawFeeShareRewardsResponse{Amount: rewards}, nil
+}
+
 func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -315,3 +462,132 @@ func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetContractShareOverride sets or removes the developer shares of a contract or a code id
+func (k Keeper) SetContractShareOverride(goCtx context.Context, req *types.MsgSetContractShareOverride) (*types.MsgSetContractShareOverrideResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	override := req.GetShareOverride()
+
+	var found bool
+	if req.ContractAddress != "" {
+		contract, err := sdk.AccAddressFromBech32(req.ContractAddress)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "invalid contract address %s", req.ContractAddress)
+		}
+		if !k.wasmKeeper.HasContractInfo(ctx, contract) {
+			return nil, errorsmod.Wrapf(types.ErrFeeShareNoContractDeployed, "contract %s", req.ContractAddress)
+		}
+		_, found = k.GetContractShareOverride(ctx, contract)
+	} else {
+		if k.wasmKeeper.GetCodeInfo(ctx, req.CodeId) == nil {
+			return nil, errorsmod.Wrapf(types.ErrFeeShareInvalidShareOverride, "code id %d does not exist", req.CodeId)
+		}
+		_, found = k.GetCodeShareOverride(ctx, req.CodeId)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, req.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(req.CodeId, 10)),
+	}
+	if req.Remove {
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrFeeShareInvalidShareOverride, "share override not found")
+		}
+		k.DeleteShareOverride(ctx, override)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRemove, "true"))
+	} else {
+		if err := override.Validate(); err != nil {
+			return nil, err
+		}
+		k.SetShareOverride(ctx, override)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyDeveloperShares, override.DeveloperShares.String()))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSetContractShareOverride, attrs...),
+	)
+
+	return &types.MsgSetContractShareOverrideResponse{}, nil
+}
+
+// SetBlocklistEntry adds or removes a contract or a code id from the blocklist.
+// The registrations of the blocked contracts are kept but they do not
+// receive fee shares until they are removed from the blocklist.
+func (k Keeper) SetBlocklistEntry(goCtx context.Context, req *types.MsgSetBlocklistEntry) (*types.MsgSetBlocklistEntryResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	entry := req.GetBlocklistEntry()
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+
+	if req.ContractAddress != "" {
+		contract := sdk.MustAccAddressFromBech32(req.ContractAddress)
+		if !k.wasmKeeper.HasContractInfo(ctx, contract) {
+			return nil, errorsmod.Wrapf(types.ErrFeeShareNoContractDeployed, "contract %s", req.ContractAddress)
+		}
+	} else if k.wasmKeeper.GetCodeInfo(ctx, req.CodeId) == nil {
+		return nil, errorsmod.Wrapf(types.ErrFeeShareInvalidBlocklistEntry, "code id %d does not exist", req.CodeId)
+	}
+
+	found := k.HasBlocklistEntry(ctx, entry)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, req.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyCodeID, strconv.FormatUint(req.CodeId, 10)),
+	}
+	if req.Remove {
+		if !found {
+			return nil, errorsmod.Wrap(types.ErrFeeShareInvalidBlocklistEntry, "blocklist entry not found")
+		}
+		k.DeleteBlocklistEntry(ctx, entry)
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRemove, "true"))
+	} else {
+		if found {
+			return nil, errorsmod.Wrap(types.ErrFeeShareInvalidBlocklistEntry, "blocklist entry already exists")
+		}
+		k.AddBlocklistEntry(ctx, entry)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSetBlocklistEntry, attrs...),
+	)
+
+	return &types.MsgSetBlocklistEntryResponse{}, nil
+}
+
+// withdrawerAttributes returns the event attributes of a contract
+// with one withdrawer address attribute for each withdrawer.
+func withdrawerAttributes(contract string, withdrawers []types.Withdrawer) []sdk.Attribute {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+	}
+	for _, w := range withdrawers {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, w.Address))
+	}
+	return attrs
+}
+
+// withdrawersEqual returns true when both lists contain
+// the same withdrawers with the same weights.
+func withdrawersEqual(a, b []types.Withdrawer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	weights := make(map[string]uint32, len(a))
+	for _, w := range a {
+		weights[w.Address] = w.WeightBps
+	}
+	for _, w := range b {
+		if weight, ok := weights[w.Address]; !ok || weight != w.WeightBps {
+			return false
+		}
+	}
+	return true
+}
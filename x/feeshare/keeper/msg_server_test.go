@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/terra-money/core/v2/app/config"
@@ -331,6 +332,95 @@ func (s *IntegrationTestSuite) TestUpdateFeeShare() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestWeightedWithdrawersFeeShare() {
+	s.Setup()
+	sender := s.TestAccs[0]
+	withdrawer1 := s.TestAccs[1]
+	withdrawer2 := s.TestAccs[2]
+	_, _, newWithdrawer := testdata.KeyTestPubAddr()
+
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	factoryContract := s.InstantiateContract(contractAddress, contractAddress)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	// A factory contract can only register itself as the single withdrawer
+	_, err := s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, &types.MsgRegisterFeeShare{
+		ContractAddress: factoryContract,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.Withdrawer{
+			{Address: factoryContract, WeightBps: 5000},
+			types.NewWithdrawer(withdrawer1, 5000),
+		},
+	})
+	s.Require().ErrorIs(err, types.ErrFeeShareInvalidWithdrawer)
+
+	// Weights that don't add up are rejected
+	_, err = s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, &types.MsgRegisterFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers: []types.Withdrawer{
+			types.NewWithdrawer(withdrawer1, 5000),
+			types.NewWithdrawer(withdrawer2, 4000),
+		},
+	})
+	s.Require().ErrorIs(err, types.ErrFeeShareInvalidWithdrawer)
+
+	// Register the contract with two weighted withdrawers
+	withdrawers := []types.Withdrawer{
+		types.NewWithdrawer(withdrawer1, 6000),
+		types.NewWithdrawer(withdrawer2, 4000),
+	}
+	_, err = s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, &types.MsgRegisterFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers:     withdrawers,
+	})
+	s.Require().NoError(err)
+
+	feeShare, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal(withdrawers, feeShare.Withdrawers)
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, withdrawer1, contract))
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, withdrawer2, contract))
+
+	// Updating with the same withdrawers in a different order is a no-op
+	_, err = s.App.Keepers.FeeShareKeeper.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers:     []types.Withdrawer{withdrawers[1], withdrawers[0]},
+	})
+	s.Require().ErrorIs(err, types.ErrFeeShareAlreadyRegistered)
+
+	// Replace the withdrawers
+	newWithdrawers := []types.Withdrawer{
+		types.NewWithdrawer(withdrawer2, 2000),
+		types.NewWithdrawer(newWithdrawer, 8000),
+	}
+	_, err = s.App.Keepers.FeeShareKeeper.UpdateFeeShare(goCtx, &types.MsgUpdateFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+		Withdrawers:     newWithdrawers,
+	})
+	s.Require().NoError(err)
+
+	feeShare, found = s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal(newWithdrawers, feeShare.Withdrawers)
+	s.Require().False(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, withdrawer1, contract))
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, withdrawer2, contract))
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, newWithdrawer, contract))
+
+	// Cancel removes every withdrawer index
+	_, err = s.App.Keepers.FeeShareKeeper.CancelFeeShare(goCtx, &types.MsgCancelFeeShare{
+		ContractAddress: contractAddress,
+		DeployerAddress: sender.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, withdrawer2, contract))
+	s.Require().False(s.App.Keepers.FeeShareKeeper.IsWithdrawerMapSet(s.Ctx, newWithdrawer, contract))
+}
+
 func (s *IntegrationTestSuite) TestCancelFeeShare() {
 	s.AppTestSuite.Setup()
 	sender := s.AppTestSuite.TestAccs[0]
@@ -397,3 +487,102 @@ func (s *IntegrationTestSuite) TestCancelFeeShare() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestFeeShareOwnerTransfer() {
+	s.SetupTest()
+	deployer := s.TestAccs[0]
+	dao := s.TestAccs[1]
+	newOwner := s.TestAccs[2]
+	withdrawer := s.CreateRandomAccounts(1)[0]
+	contractAddress := s.InstantiateContract(deployer.String(), deployer.String())
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	_, err := s.App.Keepers.FeeShareKeeper.RegisterFeeShare(goCtx, types.NewMsgRegisterFeeShare(contract, deployer, withdrawer))
+	s.Require().NoError(err)
+
+	// Move the admin of the contract to a DAO
+	_, err = s.App.MsgServiceRouter().Handler(&wasmtypes.MsgUpdateAdmin{})(s.Ctx, &wasmtypes.MsgUpdateAdmin{
+		Sender:   deployer.String(),
+		NewAdmin: dao.String(),
+		Contract: contractAddress,
+	})
+	s.Require().NoError(err)
+
+	// Only the owner can propose a new owner, not even the new admin of the
+	// contract, which would otherwise take the feeshare over
+	_, err = s.App.Keepers.FeeShareKeeper.ProposeFeeShareOwner(goCtx, types.NewMsgProposeFeeShareOwner(contract, newOwner, newOwner))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.App.Keepers.FeeShareKeeper.ProposeFeeShareOwner(goCtx, types.NewMsgProposeFeeShareOwner(contract, dao, dao))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, found := s.App.Keepers.FeeShareKeeper.GetPendingOwner(s.Ctx, contract)
+	s.Require().False(found)
+
+	// Nothing to accept before the proposal
+	_, err = s.App.Keepers.FeeShareKeeper.AcceptFeeShareOwner(goCtx, types.NewMsgAcceptFeeShareOwner(contract, dao))
+	s.Require().ErrorIs(err, types.ErrFeeShareNoPendingOwner)
+
+	// The current owner proposes the DAO ...
+	_, err = s.App.Keepers.FeeShareKeeper.ProposeFeeShareOwner(goCtx, types.NewMsgProposeFeeShareOwner(contract, deployer, dao))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeProposeFeeShareOwner, 1)
+	res, err := s.queryClient.PendingFeeShareOwner(goCtx, &types.QueryPendingFeeShareOwnerRequest{ContractAddress: contractAddress})
+	s.Require().NoError(err)
+	s.Require().Equal(dao.String(), res.OwnerAddress)
+
+	// ... only the DAO can accept it ...
+	_, err = s.App.Keepers.FeeShareKeeper.AcceptFeeShareOwner(goCtx, types.NewMsgAcceptFeeShareOwner(contract, newOwner))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.App.Keepers.FeeShareKeeper.AcceptFeeShareOwner(goCtx, types.NewMsgAcceptFeeShareOwner(contract, dao))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeAcceptFeeShareOwner, 1)
+
+	// ... which moves the deployer and re-indexes the deployer feeshares
+	feeshare, found := s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal(dao.String(), feeshare.DeployerAddress)
+	s.Require().False(s.App.Keepers.FeeShareKeeper.IsDeployerMapSet(s.Ctx, deployer, contract))
+	s.Require().True(s.App.Keepers.FeeShareKeeper.IsDeployerMapSet(s.Ctx, dao, contract))
+	_, found = s.App.Keepers.FeeShareKeeper.GetPendingOwner(s.Ctx, contract)
+	s.Require().False(found)
+
+	// Once the admin is cleared the previous owner, the creator of the contract,
+	// can no longer manage the feeshare ...
+	_, err = s.App.MsgServiceRouter().Handler(&wasmtypes.MsgClearAdmin{})(s.Ctx, &wasmtypes.MsgClearAdmin{
+		Sender:   dao.String(),
+		Contract: contractAddress,
+	})
+	s.Require().NoError(err)
+	_, err = s.App.Keepers.FeeShareKeeper.UpdateFeeShare(goCtx, types.NewMsgUpdateFeeShare(contract, deployer, deployer))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.App.Keepers.FeeShareKeeper.CancelFeeShare(goCtx, types.NewMsgCancelFeeShare(contract, deployer))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// ... while the owner can update it and still transfer the ownership
+	_, err = s.App.Keepers.FeeShareKeeper.UpdateFeeShare(goCtx, types.NewMsgUpdateFeeShare(contract, dao, dao))
+	s.Require().NoError(err)
+	feeshare, found = s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().True(found)
+	s.Require().Equal([]sdk.AccAddress{dao}, feeshare.GetWithdrawerAddrs())
+	_, err = s.App.Keepers.FeeShareKeeper.ProposeFeeShareOwner(goCtx, types.NewMsgProposeFeeShareOwner(contract, dao, newOwner))
+	s.Require().NoError(err)
+
+	// The pending transfer is exported and imported with the genesis
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal([]types.PendingOwner{{ContractAddress: contractAddress, OwnerAddress: newOwner.String()}}, genesis.PendingOwners)
+	s.Require().NoError(genesis.Validate())
+
+	// Proposing the current owner cancels the pending transfer
+	_, err = s.App.Keepers.FeeShareKeeper.ProposeFeeShareOwner(goCtx, types.NewMsgProposeFeeShareOwner(contract, dao, dao))
+	s.Require().NoError(err)
+	_, err = s.App.Keepers.FeeShareKeeper.AcceptFeeShareOwner(goCtx, types.NewMsgAcceptFeeShareOwner(contract, newOwner))
+	s.Require().ErrorIs(err, types.ErrFeeShareNoPendingOwner)
+
+	// Only the owner can cancel the feeshare
+	_, err = s.App.Keepers.FeeShareKeeper.CancelFeeShare(goCtx, types.NewMsgCancelFeeShare(contract, newOwner))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = s.App.Keepers.FeeShareKeeper.CancelFeeShare(goCtx, types.NewMsgCancelFeeShare(contract, dao))
+	s.Require().NoError(err)
+	_, found = s.App.Keepers.FeeShareKeeper.GetFeeShare(s.Ctx, contract)
+	s.Require().False(found)
+}
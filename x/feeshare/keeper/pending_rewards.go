@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// GetPendingRewards returns the fees accrued by a withdrawer
+// that are held in the module account.
+func (k Keeper) GetPendingRewards(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRewards)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var pendingRewards types.PendingRewards
+	k.cdc.MustUnmarshal(bz, &pendingRewards)
+	return pendingRewards.Rewards
+}
+
+// SetPendingRewards stores the fees accrued by a withdrawer,
+// the entry is removed when there are no rewards left.
+func (k Keeper) SetPendingRewards(ctx sdk.Context, withdrawer sdk.AccAddress, rewards sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingRewards)
+	if rewards.IsZero() {
+		store.Delete(withdrawer.Bytes())
+		return
+	}
+
+	pendingRewards := types.PendingRewards{
+		WithdrawerAddress: withdrawer.String(),
+		Rewards:           rewards,
+	}
+	bz := k.cdc.MustMarshal(&pendingRewards)
+	store.Set(withdrawer.Bytes(), bz)
+}
+
+// AccruePendingRewards adds the fees to the pending rewards of a withdrawer.
+// The caller is responsible for moving the fees to the module account.
+func (k Keeper) AccruePendingRewards(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	k.SetPendingRewards(ctx, withdrawer, k.GetPendingRewards(ctx, withdrawer).Add(fees...))
+}
+
+// GetAllPendingRewards returns the pending rewards of all withdrawers.
+func (k Keeper) GetAllPendingRewards(ctx sdk.Context) []types.PendingRewards {
+	pendingRewards := []types.PendingRewards{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPendingRewards)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pr types.PendingRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &pr)
+
+		pendingRewards = append(pendingRewards, pr)
+	}
+
+	return pendingRewards
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestWithdrawFeeShareRewards() {
+	s.SetupTest()
+	withdrawer := s.TestAccs[0]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100), sdk.NewInt64Coin("utoken", 5))
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	// Nothing to withdraw yet
+	_, err := s.App.Keepers.FeeShareKeeper.WithdrawFeeShareRewards(goCtx, types.NewMsgWithdrawFeeShareRewards(withdrawer))
+	s.Require().ErrorIs(err, types.ErrFeeShareNoPendingRewards)
+
+	// Accrue the rewards twice and fund the module account accordingly
+	err = s.FundModule(types.ModuleName, rewards.Add(rewards...))
+	s.Require().NoError(err)
+	s.App.Keepers.FeeShareKeeper.AccruePendingRewards(s.Ctx, withdrawer, rewards)
+	s.App.Keepers.FeeShareKeeper.AccruePendingRewards(s.Ctx, withdrawer, rewards)
+
+	res, err := s.queryClient.PendingRewards(goCtx, &types.QueryPendingRewardsRequest{
+		WithdrawerAddress: withdrawer.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(rewards.Add(rewards...), res.Rewards)
+
+	balanceBefore := s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawer)
+	resp, err := s.App.Keepers.FeeShareKeeper.WithdrawFeeShareRewards(goCtx, types.NewMsgWithdrawFeeShareRewards(withdrawer))
+	s.Require().NoError(err)
+	s.Require().Equal(rewards.Add(rewards...), resp.Amount)
+	s.Require().Equal(balanceBefore.Add(resp.Amount...), s.App.Keepers.BankKeeper.GetAllBalances(s.Ctx, withdrawer))
+	s.AssertEventEmitted(s.Ctx, types.EventTypeWithdrawFeeShareRewards, 1)
+
+	// The pending balance is cleared
+	s.Require().True(s.App.Keepers.FeeShareKeeper.GetPendingRewards(s.Ctx, withdrawer).IsZero())
+	s.Require().Empty(s.App.Keepers.FeeShareKeeper.GetAllPendingRewards(s.Ctx))
+	_, err = s.App.Keepers.FeeShareKeeper.WithdrawFeeShareRewards(goCtx, types.NewMsgWithdrawFeeShareRewards(withdrawer))
+	s.Require().ErrorIs(err, types.ErrFeeShareNoPendingRewards)
+}
+
+func (s *IntegrationTestSuite) TestPendingRewardsQuery() {
+	s.SetupTest()
+
+	_, err := s.queryClient.PendingRewards(sdk.WrapSDKContext(s.Ctx), &types.QueryPendingRewardsRequest{
+		WithdrawerAddress: "invalid",
+	})
+	s.Require().Error(err)
+
+	res, err := s.queryClient.PendingRewards(sdk.WrapSDKContext(s.Ctx), &types.QueryPendingRewardsRequest{
+		WithdrawerAddress: s.TestAccs[0].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Rewards)
+}
+
+func (s *IntegrationTestSuite) TestPendingRewardsGenesis() {
+	s.SetupTest()
+	rewards := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+
+	s.App.Keepers.FeeShareKeeper.AccruePendingRewards(s.Ctx, s.TestAccs[0], rewards)
+	s.App.Keepers.FeeShareKeeper.AccruePendingRewards(s.Ctx, s.TestAccs[1], rewards)
+
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.PendingRewards, 2)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.Keepers.FeeShareKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().ElementsMatch(genesis.PendingRewards, s.App.Keepers.FeeShareKeeper.GetAllPendingRewards(s.Ctx))
+}
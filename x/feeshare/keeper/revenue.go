@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// GetContractRevenue returns the cumulative fees distributed
+// to the withdrawers of a contract.
+func (k Keeper) GetContractRevenue(ctx sdk.Context, contract sdk.Address) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRevenue)
+	bz := store.Get(contract.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var revenue types.ContractRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue.Revenue
+}
+
+// SetContractRevenue stores the cumulative fees distributed
+// to the withdrawers of a contract.
+func (k Keeper) SetContractRevenue(ctx sdk.Context, contract sdk.Address, revenue sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixContractRevenue)
+	bz := k.cdc.MustMarshal(&types.ContractRevenue{
+		ContractAddress: contract.String(),
+		Revenue:         revenue,
+	})
+	store.Set(contract.Bytes(), bz)
+}
+
+// GetWithdrawerRevenue returns the cumulative fees
+// distributed to a withdrawer.
+func (k Keeper) GetWithdrawerRevenue(ctx sdk.Context, withdrawer sdk.AccAddress) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawerRevenue)
+	bz := store.Get(withdrawer.Bytes())
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var revenue types.WithdrawerRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue.Revenue
+}
+
+// SetWithdrawerRevenue stores the cumulative fees distributed
+// to a withdrawer and ranks the withdrawer by the new amount
+// received in each denom.
+func (k Keeper) SetWithdrawerRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, revenue sdk.Coins) {
+	for _, coin := range k.GetWithdrawerRevenue(ctx, withdrawer) {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixTopEarners(coin.Denom))
+		store.Delete(types.GetKeyTopEarner(coin.Amount, withdrawer))
+	}
+	for _, coin := range revenue {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixTopEarners(coin.Denom))
+		store.Set(types.GetKeyTopEarner(coin.Amount, withdrawer), []byte{1})
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixWithdrawerRevenue)
+	bz := k.cdc.MustMarshal(&types.WithdrawerRevenue{
+		WithdrawerAddress: withdrawer.String(),
+		Revenue:           revenue,
+	})
+	store.Set(withdrawer.Bytes(), bz)
+}
+
+// GetBlockRevenue returns the fees distributed at a block height.
+func (k Keeper) GetBlockRevenue(ctx sdk.Context, height int64) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRevenue)
+	bz := store.Get(types.GetKeyBlockRevenue(height))
+	if len(bz) == 0 {
+		return sdk.Coins{}
+	}
+
+	var revenue types.BlockRevenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue.Revenue
+}
+
+// SetBlockRevenue stores the fees distributed at a block height.
+func (k Keeper) SetBlockRevenue(ctx sdk.Context, height int64, revenue sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRevenue)
+	bz := k.cdc.MustMarshal(&types.BlockRevenue{
+		Height:  height,
+		Revenue: revenue,
+	})
+	store.Set(types.GetKeyBlockRevenue(height), bz)
+}
+
+// PruneBlockRevenues deletes the fees distributed at the blocks that are
+// older than the last block revenue retention blocks of the params.
+func (k Keeper) PruneBlockRevenues(ctx sdk.Context) {
+	retention := k.GetParams(ctx).BlockRevenueRetentionBlocks
+	if retention >= uint64(ctx.BlockHeight()) {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(retention) + 1
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockRevenue)
+	iterator := store.Iterator(nil, types.GetKeyBlockRevenue(cutoff))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordRevenue adds the fees distributed to a withdrawer of a contract
+// to the running totals of the contract, the withdrawer and the current
+// block, unless no block revenues are kept.
+func (k Keeper) RecordRevenue(ctx sdk.Context, params types.Params, contract sdk.Address, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	k.AddContractRevenue(ctx, contract, fees)
+	k.AddWithdrawerRevenue(ctx, withdrawer, fees)
+	k.AddBlockRevenue(ctx, params, fees)
+}
+
+// AddContractRevenue adds the fees distributed to the
+// withdrawers of a contract to its running total.
+func (k Keeper) AddContractRevenue(ctx sdk.Context, contract sdk.Address, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+	k.SetContractRevenue(ctx, contract, k.GetContractRevenue(ctx, contract).Add(fees...))
+}
+
+// AddWithdrawerRevenue adds the fees distributed to a withdrawer
+// to its running total and re-ranks it in the top earners.
+func (k Keeper) AddWithdrawerRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+	k.SetWithdrawerRevenue(ctx, withdrawer, k.GetWithdrawerRevenue(ctx, withdrawer).Add(fees...))
+}
+
+// AddBlockRevenue adds the fees distributed to the running total
+// of the current block, unless no block revenues are kept.
+func (k Keeper) AddBlockRevenue(ctx sdk.Context, params types.Params, fees sdk.Coins) {
+	if fees.IsZero() || params.BlockRevenueRetentionBlocks == 0 {
+		return
+	}
+	k.SetBlockRevenue(ctx, ctx.BlockHeight(), k.GetBlockRevenue(ctx, ctx.BlockHeight()).Add(fees...))
+}
+
+// GetAllContractRevenues returns the cumulative fees distributed for all contracts.
+func (k Keeper) GetAllContractRevenues(ctx sdk.Context) []types.ContractRevenue {
+	revenues := []types.ContractRevenue{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixContractRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.ContractRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		revenues = append(revenues, revenue)
+	}
+
+	return revenues
+}
+
+// GetAllWithdrawerRevenues returns the cumulative fees distributed to all withdrawers.
+func (k Keeper) GetAllWithdrawerRevenues(ctx sdk.Context) []types.WithdrawerRevenue {
+	revenues := []types.WithdrawerRevenue{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixWithdrawerRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.WithdrawerRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		revenues = append(revenues, revenue)
+	}
+
+	return revenues
+}
+
+// GetAllBlockRevenues returns the fees distributed in each block.
+func (k Keeper) GetAllBlockRevenues(ctx sdk.Context) []types.BlockRevenue {
+	revenues := []types.BlockRevenue{}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixBlockRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.BlockRevenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		revenues = append(revenues, revenue)
+	}
+
+	return revenues
+}
@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestRecordRevenue() {
+	s.SetupTest()
+	contract := s.TestAccs[0]
+	withdrawer1 := s.TestAccs[1]
+	withdrawer2 := s.TestAccs[2]
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100), sdk.NewInt64Coin("utoken", 5))
+	params := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, withdrawer1, fees)
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, withdrawer2, fees)
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx.WithBlockHeight(s.Ctx.BlockHeight()+1), params, contract, withdrawer1, fees)
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, withdrawer1, sdk.Coins{})
+
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	contractRes, err := s.queryClient.ContractRevenue(goCtx, &types.QueryContractRevenueRequest{
+		ContractAddress: contract.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(fees.Add(fees...).Add(fees...), contractRes.Revenue)
+
+	withdrawerRes, err := s.queryClient.WithdrawerRevenue(goCtx, &types.QueryWithdrawerRevenueRequest{
+		WithdrawerAddress: withdrawer1.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(fees.Add(fees...), withdrawerRes.Revenue)
+
+	blocksRes, err := s.queryClient.BlockRevenues(goCtx, &types.QueryBlockRevenuesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.BlockRevenue{
+		{Height: s.Ctx.BlockHeight(), Revenue: fees.Add(fees...)},
+		{Height: s.Ctx.BlockHeight() + 1, Revenue: fees},
+	}, blocksRes.BlockRevenues)
+
+	_, err = s.queryClient.ContractRevenue(goCtx, &types.QueryContractRevenueRequest{ContractAddress: "invalid"})
+	s.Require().Error(err)
+	_, err = s.queryClient.WithdrawerRevenue(goCtx, &types.QueryWithdrawerRevenueRequest{WithdrawerAddress: "invalid"})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestTopEarnersQuery() {
+	s.SetupTest()
+	contract := s.TestAccs[0]
+	accs := s.CreateRandomAccounts(3)
+	params := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, accs[0], sdk.NewCoins(sdk.NewInt64Coin("uluna", 10)))
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, accs[1], sdk.NewCoins(sdk.NewInt64Coin("uluna", 30)))
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, accs[2], sdk.NewCoins(sdk.NewInt64Coin("uluna", 20), sdk.NewInt64Coin("utoken", 1)))
+
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+	res, err := s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Earners, 3)
+	s.Require().Equal(accs[1].String(), res.Earners[0].WithdrawerAddress)
+	s.Require().Equal(accs[2].String(), res.Earners[1].WithdrawerAddress)
+	s.Require().Equal(accs[0].String(), res.Earners[2].WithdrawerAddress)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+
+	// Paginate with offset and limit
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Earners, 1)
+	s.Require().Equal(accs[2].String(), res.Earners[0].WithdrawerAddress)
+
+	// Only the withdrawers that received the denom are ranked
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{Denom: "utoken"})
+	s.Require().NoError(err)
+	s.Require().Len(res.Earners, 1)
+	s.Require().Equal(accs[2].String(), res.Earners[0].WithdrawerAddress)
+
+	// A page past the last earner is empty
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{Offset: 3},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Earners)
+
+	// Paginate with the next key
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Earners, 2)
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Earners, 1)
+	s.Require().Equal(accs[0].String(), res.Earners[0].WithdrawerAddress)
+	s.Require().Nil(res.Pagination.NextKey)
+
+	// The ranking follows the new revenues, ties are broken by address
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, contract, accs[0], sdk.NewCoins(sdk.NewInt64Coin("uluna", 10)))
+	res, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{
+		Denom:      "uluna",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	first, second := accs[0], accs[2]
+	if bytes.Compare(second, first) < 0 {
+		first, second = second, first
+	}
+	s.Require().Equal([]types.WithdrawerRevenue{
+		{WithdrawerAddress: accs[1].String(), Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 30))},
+		{WithdrawerAddress: first.String(), Revenue: s.App.Keepers.FeeShareKeeper.GetWithdrawerRevenue(s.Ctx, first)},
+		{WithdrawerAddress: second.String(), Revenue: s.App.Keepers.FeeShareKeeper.GetWithdrawerRevenue(s.Ctx, second)},
+	}, res.Earners)
+
+	_, err = s.queryClient.TopEarners(goCtx, &types.QueryTopEarnersRequest{Denom: ""})
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestPruneBlockRevenues() {
+	s.SetupTest()
+	k := s.App.Keepers.FeeShareKeeper
+	params := k.GetParams(s.Ctx)
+	params.BlockRevenueRetentionBlocks = 10
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+	for _, height := range []int64{1, 2, 3} {
+		k.RecordRevenue(s.Ctx.WithBlockHeight(height), params, s.TestAccs[0], s.TestAccs[1], fees)
+	}
+
+	// Nothing is pruned before the end of the retention window
+	k.PruneBlockRevenues(s.Ctx.WithBlockHeight(10))
+	s.Require().Len(k.GetAllBlockRevenues(s.Ctx), 3)
+
+	// The blocks are pruned once they are older than the retention window ...
+	k.PruneBlockRevenues(s.Ctx.WithBlockHeight(12))
+	s.Require().Equal([]types.BlockRevenue{{Height: 3, Revenue: fees}}, k.GetAllBlockRevenues(s.Ctx))
+
+	// ... while the cumulative revenues are kept
+	s.Require().Equal(fees.MulInt(sdk.NewInt(3)), k.GetContractRevenue(s.Ctx, s.TestAccs[0]))
+	s.Require().Equal(fees.MulInt(sdk.NewInt(3)), k.GetWithdrawerRevenue(s.Ctx, s.TestAccs[1]))
+
+	// The module prunes the block revenues at the end of each block
+	feeshareModule := s.App.GetModuleManager().Modules[types.ModuleName].(module.EndBlockAppModule)
+	feeshareModule.EndBlock(s.Ctx.WithBlockHeight(13), abci.RequestEndBlock{})
+	s.Require().Empty(k.GetAllBlockRevenues(s.Ctx))
+
+	// No block revenues are kept without retention
+	params.BlockRevenueRetentionBlocks = 0
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	k.RecordRevenue(s.Ctx.WithBlockHeight(14), params, s.TestAccs[0], s.TestAccs[1], fees)
+	s.Require().Empty(k.GetAllBlockRevenues(s.Ctx))
+	s.Require().Equal(fees.MulInt(sdk.NewInt(4)), k.GetWithdrawerRevenue(s.Ctx, s.TestAccs[1]))
+}
+
+func (s *IntegrationTestSuite) TestRevenueGenesis() {
+	s.SetupTest()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+	params := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx)
+
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx, params, s.TestAccs[0], s.TestAccs[1], fees)
+	s.App.Keepers.FeeShareKeeper.RecordRevenue(s.Ctx.WithBlockHeight(s.Ctx.BlockHeight()+1), params, s.TestAccs[0], s.TestAccs[2], fees)
+
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.ContractRevenues, 1)
+	s.Require().Len(genesis.WithdrawerRevenues, 2)
+	s.Require().Len(genesis.BlockRevenues, 2)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.Keepers.FeeShareKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis.ContractRevenues, s.App.Keepers.FeeShareKeeper.GetAllContractRevenues(s.Ctx))
+	s.Require().ElementsMatch(genesis.WithdrawerRevenues, s.App.Keepers.FeeShareKeeper.GetAllWithdrawerRevenues(s.Ctx))
+	s.Require().Equal(genesis.BlockRevenues, s.App.Keepers.FeeShareKeeper.GetAllBlockRevenues(s.Ctx))
+
+	// The imported withdrawers are ranked
+	res, err := s.queryClient.TopEarners(sdk.WrapSDKContext(s.Ctx), &types.QueryTopEarnersRequest{Denom: "uluna"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch(genesis.WithdrawerRevenues, res.Earners)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// GetContractShareOverride returns the developer shares
+// override of a contract if governance has set one.
+func (k Keeper) GetContractShareOverride(ctx sdk.Context, contract sdk.Address) (types.ShareOverride, bool) {
+	return k.getShareOverride(ctx, types.GetKeyContractShareOverride(contract))
+}
+
+// GetCodeShareOverride returns the developer shares
+// override of a code id if governance has set one.
+func (k Keeper) GetCodeShareOverride(ctx sdk.Context, codeID uint64) (types.ShareOverride, bool) {
+	return k.getShareOverride(ctx, types.GetKeyCodeShareOverride(codeID))
+}
+
+func (k Keeper) getShareOverride(ctx sdk.Context, key []byte) (override types.ShareOverride, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return override, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &override)
+	return override, true
+}
+
+// SetShareOverride stores a developer shares override
+// for a contract or a code id.
+func (k Keeper) SetShareOverride(ctx sdk.Context, override types.ShareOverride) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&override)
+	store.Set(types.GetKeyShareOverride(override), bz)
+}
+
+// DeleteShareOverride removes the developer shares override
+// of the contract or the code id of the given override.
+func (k Keeper) DeleteShareOverride(ctx sdk.Context, override types.ShareOverride) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetKeyShareOverride(override))
+}
+
+// GetAllShareOverrides returns the developer shares overrides,
+// the contract overrides are listed before the code id overrides.
+func (k Keeper) GetAllShareOverrides(ctx sdk.Context) []types.ShareOverride {
+	overrides := []types.ShareOverride{}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixShareOverride)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.ShareOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides
+}
+
+// GetDeveloperShares returns the proportion of the transaction fees
+// distributed to a contract. The override of the contract takes
+// precedence over the override of its code id, and the defaultShares
+// apply when governance has not overridden any of them.
+func (k Keeper) GetDeveloperShares(ctx sdk.Context, contract sdk.Address, defaultShares sdk.Dec) sdk.Dec {
+	if override, found := k.GetContractShareOverride(ctx, contract); found {
+		return override.DeveloperShares
+	}
+
+	info := k.wasmKeeper.GetContractInfo(ctx, sdk.AccAddress(contract.Bytes()))
+	if info != nil {
+		if override, found := k.GetCodeShareOverride(ctx, info.CodeID); found {
+			return override.DeveloperShares
+		}
+	}
+
+	return defaultShares
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (s *IntegrationTestSuite) TestSetContractShareOverride() {
+	s.SetupTest()
+	sender := s.TestAccs[0]
+	contractAddress := s.InstantiateContract(sender.String(), "")
+	contract := sdk.MustAccAddressFromBech32(contractAddress)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	defaultShares := s.App.Keepers.FeeShareKeeper.GetParams(s.Ctx).DeveloperShares
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgSetContractShareOverride
+		expErr    bool
+		expShares sdk.Dec
+	}{
+		{
+			"invalid authority",
+			&types.MsgSetContractShareOverride{Authority: sender.String(), CodeId: 1, DeveloperShares: sdk.NewDecWithPrec(25, 2)},
+			true,
+			defaultShares,
+		},
+		{
+			"code id does not exist",
+			&types.MsgSetContractShareOverride{Authority: authority, CodeId: 2, DeveloperShares: sdk.NewDecWithPrec(25, 2)},
+			true,
+			defaultShares,
+		},
+		{
+			"contract does not exist",
+			&types.MsgSetContractShareOverride{Authority: authority, ContractAddress: s.TestAccs[1].String(), DeveloperShares: sdk.NewDecWithPrec(25, 2)},
+			true,
+			defaultShares,
+		},
+		{
+			"remove an override that does not exist",
+			&types.MsgSetContractShareOverride{Authority: authority, CodeId: 1, Remove: true},
+			true,
+			defaultShares,
+		},
+		{
+			"code id override",
+			&types.MsgSetContractShareOverride{Authority: authority, CodeId: 1, DeveloperShares: sdk.NewDecWithPrec(25, 2)},
+			false,
+			sdk.NewDecWithPrec(25, 2),
+		},
+		{
+			"contract override takes precedence over the code id override",
+			&types.MsgSetContractShareOverride{Authority: authority, ContractAddress: contractAddress, DeveloperShares: sdk.ZeroDec()},
+			false,
+			sdk.ZeroDec(),
+		},
+		{
+			"remove the contract override",
+			&types.MsgSetContractShareOverride{Authority: authority, ContractAddress: contractAddress, Remove: true},
+			false,
+			sdk.NewDecWithPrec(25, 2),
+		},
+		{
+			"remove the code id override",
+			&types.MsgSetContractShareOverride{Authority: authority, CodeId: 1, Remove: true},
+			false,
+			defaultShares,
+		},
+	}
+
+	for _, tc := range testCases {
+		_, err := s.App.Keepers.FeeShareKeeper.SetContractShareOverride(goCtx, tc.msg)
+		if tc.expErr {
+			s.Require().Error(err, tc.name)
+		} else {
+			s.Require().NoError(err, tc.name)
+			s.AssertEventEmitted(s.Ctx, types.EventTypeSetContractShareOverride, 1)
+		}
+		s.Require().Equal(tc.expShares, s.App.Keepers.FeeShareKeeper.GetDeveloperShares(s.Ctx, contract, defaultShares), tc.name)
+		s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+		goCtx = sdk.WrapSDKContext(s.Ctx)
+	}
+}
+
+func (s *IntegrationTestSuite) TestShareOverridesQuery() {
+	s.SetupTest()
+	contract := s.TestAccs[0]
+	s.App.Keepers.FeeShareKeeper.SetShareOverride(s.Ctx, types.NewCodeShareOverride(1, sdk.NewDecWithPrec(25, 2)))
+	s.App.Keepers.FeeShareKeeper.SetShareOverride(s.Ctx, types.NewContractShareOverride(contract, sdk.NewDecWithPrec(75, 2)))
+
+	res, err := s.queryClient.ShareOverrides(sdk.WrapSDKContext(s.Ctx), &types.QueryShareOverridesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.ShareOverride{
+		types.NewContractShareOverride(contract, sdk.NewDecWithPrec(75, 2)),
+		types.NewCodeShareOverride(1, sdk.NewDecWithPrec(25, 2)),
+	}, res.ShareOverrides)
+
+	genesis := s.App.Keepers.FeeShareKeeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(res.ShareOverrides, genesis.ShareOverrides)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.App.Keepers.FeeShareKeeper.InitGenesis(s.Ctx, *genesis)
+	s.Require().Equal(genesis.ShareOverrides, s.App.Keepers.FeeShareKeeper.GetAllShareOverrides(s.Ctx))
+}
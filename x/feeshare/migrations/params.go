@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// MigrateParams loads the params stored under the params key, or the
+// default params when none are stored, lets setNewParams set the params
+// introduced by a migration and stores them back once they are valid.
+func MigrateParams(
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+	paramsKey []byte,
+	setNewParams func(params *types.Params),
+) error {
+	params := types.DefaultParams()
+	if bz := store.Get(paramsKey); bz != nil {
+		params = types.Params{}
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	setNewParams(&params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(paramsKey, bz)
+
+	return nil
+}
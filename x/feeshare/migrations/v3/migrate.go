@@ -0,0 +1,60 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+const (
+	ModuleName = "feeshare"
+)
+
+// FeeSharePrefix Feeshare/types/keys.go -> prefixFeeShare
+var FeeSharePrefix = []byte{0x01}
+
+// Migrate migrates the x/feeshare module state from the consensus version 2 to
+// version 3. Specifically, it converts the FeeShares stored with a single
+// withdrawer address into a list with one withdrawer receiving all the fees.
+// The contract-by-withdrawer index does not change because the withdrawer
+// is the same account.
+func Migrate(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	feeShareStore := prefix.NewStore(store, FeeSharePrefix)
+	iterator := feeShareStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var migrated []types.FeeShare
+	for ; iterator.Valid(); iterator.Next() {
+		var feeShare types.FeeShare
+		if err := cdc.Unmarshal(iterator.Value(), &feeShare); err != nil {
+			return err
+		}
+
+		if feeShare.WithdrawerAddress == "" || len(feeShare.Withdrawers) != 0 {
+			continue
+		}
+
+		feeShare.Withdrawers = []types.Withdrawer{{
+			Address:   feeShare.WithdrawerAddress,
+			WeightBps: types.BasisPointsTotal,
+		}}
+		feeShare.WithdrawerAddress = ""
+		migrated = append(migrated, feeShare)
+	}
+
+	for _, feeShare := range migrated {
+		bz, err := cdc.Marshal(&feeShare)
+		if err != nil {
+			return err
+		}
+		feeShareStore.Set(feeShare.GetContractAddr().Bytes(), bz)
+	}
+
+	return nil
+}
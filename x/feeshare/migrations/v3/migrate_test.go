@@ -0,0 +1,75 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/terra-money/core/v2/x/feeshare"
+	v3 "github.com/terra-money/core/v2/x/feeshare/migrations/v3"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeshare.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v3.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	contract1 := sdk.AccAddress([]byte("contract1"))
+	contract2 := sdk.AccAddress([]byte("contract2"))
+	contract3 := sdk.AccAddress([]byte("contract3"))
+	deployer := sdk.AccAddress([]byte("deployer"))
+	withdrawer1 := sdk.AccAddress([]byte("withdrawer1"))
+	withdrawer2 := sdk.AccAddress([]byte("withdrawer2"))
+
+	legacy := types.FeeShare{
+		ContractAddress:   contract1.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer1.String(),
+	}
+	weighted := types.FeeShare{
+		ContractAddress: contract2.String(),
+		DeployerAddress: deployer.String(),
+		Withdrawers: []types.Withdrawer{
+			types.NewWithdrawer(withdrawer1, 5000),
+			types.NewWithdrawer(withdrawer2, 5000),
+		},
+	}
+	noWithdrawer := types.FeeShare{
+		ContractAddress: contract3.String(),
+		DeployerAddress: deployer.String(),
+	}
+
+	feeShareStore := prefix.NewStore(store, v3.FeeSharePrefix)
+	for _, fs := range []types.FeeShare{legacy, weighted, noWithdrawer} {
+		fs := fs
+		feeShareStore.Set(fs.GetContractAddr().Bytes(), cdc.MustMarshal(&fs))
+	}
+
+	require.NoError(t, v3.Migrate(ctx, store, cdc))
+
+	var res types.FeeShare
+	cdc.MustUnmarshal(feeShareStore.Get(contract1.Bytes()), &res)
+	require.Equal(t, types.FeeShare{
+		ContractAddress: contract1.String(),
+		DeployerAddress: deployer.String(),
+		Withdrawers:     []types.Withdrawer{types.NewWithdrawer(withdrawer1, types.BasisPointsTotal)},
+	}, res)
+
+	res = types.FeeShare{}
+	cdc.MustUnmarshal(feeShareStore.Get(contract2.Bytes()), &res)
+	require.Equal(t, weighted, res)
+
+	res = types.FeeShare{}
+	cdc.MustUnmarshal(feeShareStore.Get(contract3.Bytes()), &res)
+	require.Equal(t, noWithdrawer, res)
+}
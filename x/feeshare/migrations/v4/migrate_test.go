@@ -0,0 +1,58 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/terra-money/core/v2/x/feeshare"
+	v4 "github.com/terra-money/core/v2/x/feeshare/migrations/v4"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeshare.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// Store the params as they were before the block revenue retention was introduced
+	params := types.Params{
+		EnableFeeShare:  true,
+		DeveloperShares: sdk.NewDecWithPrec(25, 2),
+		AllowedDenoms:   []string{"uluna"},
+		PayoutMode:      types.PayoutModeAccrue,
+	}
+	store.Set(v4.ParamsKey, cdc.MustMarshal(&params))
+
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	cdc.MustUnmarshal(store.Get(v4.ParamsKey), &res)
+	params.BlockRevenueRetentionBlocks = types.DefaultBlockRevenueRetentionBlocks
+	require.Equal(t, params, res)
+}
+
+func TestMigrateWithoutParams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(feeshare.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(v4.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// The params are set to their default values when none are stored
+	require.NoError(t, v4.Migrate(ctx, store, cdc))
+
+	var res types.Params
+	cdc.MustUnmarshal(store.Get(v4.ParamsKey), &res)
+	require.Equal(t, types.DefaultParams(), res)
+}
@@ -0,0 +1,35 @@
+package v5
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// Migrate migrates the x/feeshare module state from the consensus version 4 to
+// version 5. Specifically, it grants the burner permission to the feeshare
+// module account so the developer shares of the fees paid in disallowed
+// denoms can be burned. Module accounts keep the permissions they were
+// created with, so the accounts created before the permission was
+// added to the module account permissions have to be updated.
+func Migrate(
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+) error {
+	acc := ak.GetModuleAccount(ctx, types.ModuleName)
+	if acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected %s module account type %T", types.ModuleName, acc)
+	}
+
+	moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+	ak.SetModuleAccount(ctx, moduleAcc)
+	return nil
+}
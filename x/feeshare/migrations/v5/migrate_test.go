@@ -0,0 +1,41 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/terra-money/core/v2/app/test_helpers"
+	v5 "github.com/terra-money/core/v2/x/feeshare/migrations/v5"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+type MigrateTestSuite struct {
+	test_helpers.AppTestSuite
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
+
+func (s *MigrateTestSuite) TestMigrate() {
+	s.Setup()
+	ak := s.App.Keepers.AccountKeeper
+
+	// Create the module account without permissions
+	// as it was created before the migration
+	acc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+	ak.SetModuleAccount(s.Ctx, ak.NewAccount(s.Ctx, acc).(authtypes.ModuleAccountI))
+	s.Require().False(ak.GetModuleAccount(s.Ctx, types.ModuleName).HasPermission(authtypes.Burner))
+
+	err := v5.Migrate(s.Ctx, ak)
+	s.Require().NoError(err)
+	s.Require().True(ak.GetModuleAccount(s.Ctx, types.ModuleName).HasPermission(authtypes.Burner))
+
+	// The migration is a no-op when the account has the permission
+	err = v5.Migrate(s.Ctx, ak)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{authtypes.Burner}, ak.GetModuleAccount(s.Ctx, types.ModuleName).GetPermissions())
+}
@@ -0,0 +1,32 @@
+package v6
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/feeshare/migrations"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+const (
+	ModuleName = "feeshare"
+)
+
+// ParamsKey Feeshare/types/keys.go -> prefixParams
+var ParamsKey = []byte{0x04}
+
+// Migrate migrates the x/feeshare module state from the consensus version 5 to
+// version 6. Specifically, it sets the max payout recipients param introduced
+// in version 6 to its default value, since it is zero in the params stored by
+// the previous versions and zero does not limit the payout recipients. The
+// other params are set to their default values when no params are stored.
+func Migrate(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	return migrations.MigrateParams(store, cdc, ParamsKey, func(params *types.Params) {
+		params.MaxPayoutRecipients = types.DefaultMaxPayoutRecipients
+		params.PayoutRemainderPolicy = types.DefaultPayoutRemainderPolicy
+	})
+}
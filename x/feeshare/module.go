@@ -22,6 +22,7 @@ import (
 	"github.com/terra-money/core/v2/x/feeshare/client/cli"
 	"github.com/terra-money/core/v2/x/feeshare/exported"
 	"github.com/terra-money/core/v2/x/feeshare/keeper"
+	"github.com/terra-money/core/v2/x/feeshare/simulation"
 	"github.com/terra-money/core/v2/x/feeshare/types"
 )
 
@@ -33,7 +34,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/feeshare module consensus version.
-const ConsensusVersion = 2
+const ConsensusVersion = 6
 
 // AppModuleBasic type for the fees module
 type AppModuleBasic struct{}
@@ -105,6 +106,8 @@ type AppModule struct {
 	AppModuleBasic
 	keeper keeper.Keeper
 	ak     authkeeper.AccountKeeper
+	bk     types.BankKeeper
+	wk     types.WasmKeeper
 
 	// legacySubspace is used solely for migration of x/params managed parameters
 	legacySubspace exported.Subspace
@@ -114,12 +117,16 @@ type AppModule struct {
 func NewAppModule(
 	k keeper.Keeper,
 	ak authkeeper.AccountKeeper,
+	bk types.BankKeeper,
+	wk types.WasmKeeper,
 	ss exported.Subspace,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
 		ak:             ak,
+		bk:             bk,
+		wk:             wk,
 		legacySubspace: ss,
 	}
 }
@@ -130,7 +137,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the fees module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // NewHandler returns nil - fees module doesn't expose tx gRPC endpoints
 func (am AppModule) NewHandler() sdk.Handler {
@@ -152,15 +161,34 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 4 to 5: %v", types.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 5 to 6: %v", types.ModuleName, err))
+	}
 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the fees module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {
 }
 
-// EndBlock executes all ABCI EndBlock logic respective to the fee-share module. It
-// returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock executes all ABCI EndBlock logic respective to the fee-share module,
+// pruning the expired block revenues and distributing the escrowed developer
+// shares at the end of each payout epoch. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.PruneBlockRevenues(ctx)
+	if am.keeper.HasEscrowedPayouts(ctx) && am.keeper.IsPayoutEpochEnd(ctx) {
+		if err := am.keeper.DistributeEscrowedPayouts(ctx); err != nil {
+			am.keeper.Logger(ctx).Error("failed to distribute escrowed payouts", "error", err)
+		}
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -185,19 +213,23 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // AppModuleSimulation functions
 
 // GenerateGenesisState creates a randomized GenState of the fees module.
-func (am AppModule) GenerateGenesisState(_ *module.SimulationState) {
+func (am AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
 }
 
-// ProposalContents returns content functions for governance proposals.
-func (am AppModule) ProposalContents(_ module.SimulationState) []simtypes.WeightedProposalMsg {
-	return []simtypes.WeightedProposalMsg{}
+// ProposalMsgs returns msgs used for governance proposals for simulations.
+func (am AppModule) ProposalMsgs(_ module.SimulationState) []simtypes.WeightedProposalMsg {
+	return simulation.ProposalMsgs()
 }
 
 // RegisterStoreDecoder registers a decoder for fees module's types.
 func (am AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {
 }
 
-// WeightedOperations returns fees module weighted operations
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return []simtypes.WeightedOperation{}
+// WeightedOperations returns fees module weighted operations, which
+// register the contracts instantiated by the wasm module operations.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, am.ak, am.bk, am.wk, am.keeper,
+	)
 }
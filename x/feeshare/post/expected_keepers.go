@@ -11,9 +11,26 @@ import (
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
 }
 
 type FeeShareKeeper interface {
 	GetParams(ctx sdk.Context) revtypes.Params
 	GetFeeShare(ctx sdk.Context, contract sdk.Address) (revtypes.FeeShare, bool)
+	AccruePendingRewards(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins)
+	EscrowPayout(ctx sdk.Context, contract sdk.Address, withdrawer sdk.AccAddress, fees sdk.Coins)
+	GetDeveloperShares(ctx sdk.Context, contract sdk.Address, defaultShares sdk.Dec) sdk.Dec
+	AddContractRevenue(ctx sdk.Context, contract sdk.Address, fees sdk.Coins)
+	AddWithdrawerRevenue(ctx sdk.Context, withdrawer sdk.AccAddress, fees sdk.Coins)
+	AddBlockRevenue(ctx sdk.Context, params revtypes.Params, fees sdk.Coins)
+	IsContractBlocked(ctx sdk.Context, contract sdk.Address) bool
 }
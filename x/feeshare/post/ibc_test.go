@@ -0,0 +1,198 @@
+package ante_test
+
+import (
+	"fmt"
+	"os"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// instantiateCounterContract stores and instantiates the counter
+// contract, which can be incremented by any account, and registers it
+// in the FeeShare module with a withdrawer without an account.
+func (suite *AnteTestSuite) instantiateCounterContract(sender sdk.AccAddress) (string, sdk.AccAddress) {
+	wasmCode, err := os.ReadFile("./testdata/counter.wasm")
+	suite.Require().NoError(err)
+
+	res := suite.handleMsg(&wasmtypes.MsgStoreCode{
+		Sender:       sender.String(),
+		WASMByteCode: wasmCode,
+	})
+	var storeRes wasmtypes.MsgStoreCodeResponse
+	suite.Require().NoError(suite.App.AppCodec().Unmarshal(res.Data, &storeRes))
+
+	res = suite.handleMsg(&wasmtypes.MsgInstantiateContract{
+		Sender: sender.String(),
+		Admin:  sender.String(),
+		CodeID: storeRes.CodeID,
+		Label:  "counter",
+		Msg:    []byte(`{"count":0}`),
+	})
+	var instantiateRes wasmtypes.MsgInstantiateContractResponse
+	suite.Require().NoError(suite.App.AppCodec().Unmarshal(res.Data, &instantiateRes))
+
+	withdrawer := newAddresses(1)[0]
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   instantiateRes.Address,
+		DeployerAddress:   sender.String(),
+		WithdrawerAddress: withdrawer.String(),
+	})
+	suite.App.Keepers.WasmKeeper.DeleteExecutedContractAddresses(suite.Ctx)
+	return instantiateRes.Address, withdrawer
+}
+
+// setIBCParams enables the transfer and the interchain accounts host
+// applications, which is not done by the suite setup.
+func (suite *AnteTestSuite) setIBCParams() {
+	suite.App.Keepers.TransferKeeper.SetParams(suite.Ctx, transfertypes.DefaultParams())
+	suite.App.Keepers.ICAHostKeeper.SetParams(suite.Ctx, icahosttypes.NewParams(true, []string{
+		sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}),
+	}))
+}
+
+// recvPacket delivers the packet to the IBC application bound to the
+// destination port, as the IBC core module does when a relayer submits
+// a MsgRecvPacket, and returns the acknowledgement.
+func (suite *AnteTestSuite) recvPacket(packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	ibcModule, found := suite.App.Keepers.IBCKeeper.Router.GetRoute(packet.DestinationPort)
+	suite.Require().True(found)
+	return ibcModule.OnRecvPacket(suite.Ctx, packet, relayer)
+}
+
+func (suite *AnteTestSuite) TestIBCHooksPostHandler() {
+	testCases := []struct {
+		name        string
+		msg         string
+		expSuccess  bool
+		expWithdraw int64
+	}{
+		{
+			"hook executing the contract",
+			`{"increment":{}}`,
+			true,
+			500,
+		},
+		{
+			"hook failing to execute the contract",
+			`{"unknown":{}}`,
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Setup()
+			suite.setIBCParams()
+			sender, relayer := suite.TestAccs[0], suite.TestAccs[1]
+			contract, withdrawer := suite.instantiateCounterContract(sender)
+
+			// The relayer delivers an ICS-20 packet with a wasm memo...
+			data := transfertypes.NewFungibleTokenPacketData(
+				"uatom",
+				"100",
+				sender.String(),
+				contract,
+				fmt.Sprintf(`{"wasm":{"contract":"%s","msg":%s}}`, contract, tc.msg),
+			)
+			packet := channeltypes.NewPacket(
+				data.GetBytes(),
+				1,
+				transfertypes.PortID,
+				"channel-0",
+				transfertypes.PortID,
+				"channel-0",
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+			ack := suite.recvPacket(packet, relayer)
+			suite.Require().Equal(tc.expSuccess, ack.Success())
+
+			// ... so the contract executed by the hook is recorded ...
+			executedContracts, found := suite.App.Keepers.WasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+			if tc.expSuccess {
+				suite.Require().True(found)
+				suite.Require().Equal([]string{contract}, executedContracts.ContractAddresses)
+				suite.Require().NotZero(executedContracts.GetTotalGas())
+			} else {
+				suite.Require().Empty(executedContracts.ContractAddresses)
+			}
+
+			// ... and shares the fees paid by the relayer
+			err := suite.feeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), types.DefaultParams())
+			suite.Require().NoError(err)
+			suite.Require().Equal(
+				sdk.NewInt(tc.expWithdraw),
+				suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, withdrawer, "uluna").Amount,
+			)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestICAHostPostHandler() {
+	suite.Setup()
+	suite.setIBCParams()
+	sender, relayer := suite.TestAccs[0], suite.TestAccs[1]
+	contract, withdrawer := suite.instantiateCounterContract(sender)
+
+	// Open an interchain account channel on the host side
+	connectionID, channelID := "connection-0", "channel-0"
+	controllerPortID, err := icatypes.NewControllerPortID(sender.String())
+	suite.Require().NoError(err)
+	interchainAccount := newAddresses(1)[0]
+	suite.App.Keepers.IBCKeeper.ChannelKeeper.SetChannel(suite.Ctx, icatypes.HostPortID, channelID, channeltypes.NewChannel(
+		channeltypes.OPEN,
+		channeltypes.ORDERED,
+		channeltypes.NewCounterparty(controllerPortID, channelID),
+		[]string{connectionID},
+		icatypes.NewDefaultMetadataString(connectionID, connectionID),
+	))
+	suite.App.Keepers.ICAHostKeeper.SetInterchainAccountAddress(suite.Ctx, connectionID, controllerPortID, interchainAccount.String())
+
+	// The relayer delivers a packet executing the contract from the interchain account...
+	txBytes, err := icatypes.SerializeCosmosTx(suite.App.AppCodec(), []proto.Message{
+		&wasmtypes.MsgExecuteContract{
+			Sender:   interchainAccount.String(),
+			Contract: contract,
+			Msg:      []byte(`{"increment":{}}`),
+		},
+	})
+	suite.Require().NoError(err)
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: txBytes,
+	}
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		1,
+		controllerPortID,
+		channelID,
+		icahosttypes.SubModuleName,
+		channelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+	ack := suite.recvPacket(packet, relayer)
+	suite.Require().True(ack.Success(), string(ack.Acknowledgement()))
+
+	// ... so the contract is recorded ...
+	executedContracts, found := suite.App.Keepers.WasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{contract}, executedContracts.ContractAddresses)
+
+	// ... and shares the fees paid by the relayer
+	err = suite.feeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), types.DefaultParams())
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(500), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, withdrawer, "uluna").Amount)
+}
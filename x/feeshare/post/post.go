@@ -1,6 +1,10 @@
 package ante
 
 import (
+	"bytes"
+	"slices"
+	"sort"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,26 +13,31 @@ import (
 
 	feeshare "github.com/terra-money/core/v2/x/feeshare/types"
 	customwasmkeeper "github.com/terra-money/core/v2/x/wasm/keeper"
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
 )
 
 type FeeSharePayoutDecorator struct {
 	feesharekeeper FeeShareKeeper
 	bankKeeper     BankKeeper
 	wasmKeeper     customwasmkeeper.Keeper
+	distrKeeper    DistributionKeeper
+	accountKeeper  AccountKeeper
 }
 
-func NewFeeSharePayoutDecorator(fs FeeShareKeeper, bk BankKeeper, wk customwasmkeeper.Keeper) FeeSharePayoutDecorator {
+func NewFeeSharePayoutDecorator(fs FeeShareKeeper, bk BankKeeper, wk customwasmkeeper.Keeper, dk DistributionKeeper, ak AccountKeeper) FeeSharePayoutDecorator {
 	return FeeSharePayoutDecorator{
 		feesharekeeper: fs,
 		bankKeeper:     bk,
 		wasmKeeper:     wk,
+		distrKeeper:    dk,
+		accountKeeper:  ak,
 	}
 }
 
 // FeeSharePostHandler if the feeshare module is enabled
 // takes the total fees paid for each transaction and
-// split these fees equally between all the contacts
-// involved in the transaction based on the module params.
+// split these fees between all the contacts involved
+// in the transaction based on the module params.
 func (fsd FeeSharePayoutDecorator) PostHandle(
 	ctx sdk.Context,
 	tx sdk.Tx,
@@ -36,10 +45,11 @@ func (fsd FeeSharePayoutDecorator) PostHandle(
 	success bool,
 	next sdk.PostHandler,
 ) (newCtx sdk.Context, err error) {
-	// Check if fee share is enabled and shares
-	// to be distribute are greater than zero.
+	// Check if fee share is enabled, the shares to be distributed
+	// are checked for each contract because governance can
+	// override the developer shares of a contract.
 	params := fsd.feesharekeeper.GetParams(ctx)
-	if !params.EnableFeeShare || params.DeveloperShares.IsZero() {
+	if !params.EnableFeeShare {
 		return next(ctx, tx, simulate, success)
 	}
 
@@ -52,7 +62,7 @@ func (fsd FeeSharePayoutDecorator) PostHandle(
 		return next(ctx, tx, simulate, success)
 	}
 
-	err = fsd.FeeSharePayout(ctx, feeTx.GetFee(), params.DeveloperShares, params.AllowedDenoms)
+	err = fsd.FeeSharePayout(ctx, feeTx.GetFee(), params)
 	if err != nil {
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrLogic, err.Error())
 	}
@@ -60,101 +70,475 @@ func (fsd FeeSharePayoutDecorator) PostHandle(
 	return next(ctx, tx, simulate, success)
 }
 
-// FeeSharePayout takes the total fees paid for a transaction and
-// split these fees equally between all the contacts involved in the
-// transaction based on the module params.
-func (fsd FeeSharePayoutDecorator) FeeSharePayout(ctx sdk.Context, txFees sdk.Coins, devShares sdk.Dec, allowedDenoms []string) (err error) {
+// FeeSharePayout splits the developer shares of the transaction
+// fees between the withdrawers of the executed contracts and
+// delivers them according to the payout mode.
+func (fsd FeeSharePayoutDecorator) FeeSharePayout(ctx sdk.Context, txFees sdk.Coins, params feeshare.Params) (err error) {
 	executedContracts, found := fsd.wasmKeeper.GetExecutedContractAddresses(ctx)
 	if !found {
 		return err
 	}
-	contractAddresses := executedContracts.ContractAddresses
-	if len(contractAddresses) == 0 {
+	if len(executedContracts.ContractAddresses) == 0 {
 		return err
 	}
 
-	withdrawerAddrs, err := GetWithdrawalAddressFromContract(ctx, contractAddresses, fsd.feesharekeeper)
+	feeShares, gasUsed, err := GetFeeSharesGasUsage(ctx, executedContracts, fsd.feesharekeeper)
 	if err != nil {
 		return err
 	}
-	if len(withdrawerAddrs) == 0 {
+	if len(feeShares) == 0 {
 		return err
 	}
 
-	feeToBePaid := CalculateFee(txFees, devShares, len(withdrawerAddrs), allowedDenoms)
-	if feeToBePaid.IsZero() {
+	// the gas is weighted against all the executed contracts, so that the
+	// shares of the unregistered or blocked contracts stay in the fee collector
+	// instead of going to the registered contracts executed alongside them
+	totalGasUsed := executedContracts.GetTotalGas()
+	gasWeighted := params.DistributionMode == feeshare.DistributionModeGasWeighted && totalGasUsed > 0
+	disallowedFees := filterDisallowedFees(txFees, params.AllowedDenoms)
+
+	// compute the fees of each contract withdrawer
+	var payouts []withdrawerPayout
+	var disallowedDevFees sdk.Coins
+	for i, feeShare := range feeShares {
+		devShares := fsd.feesharekeeper.GetDeveloperShares(ctx, feeShare.GetContractAddr(), params.DeveloperShares)
+		if devShares.IsZero() {
+			continue
+		}
+
+		var contractFees sdk.Coins
+		var contractWeight sdk.Dec
+		if gasWeighted {
+			contractFees = CalculateGasWeightedFee(txFees, devShares, gasUsed[i], totalGasUsed, params.AllowedDenoms)
+			disallowedDevFees = disallowedDevFees.Add(CalculateGasWeightedFee(disallowedFees, devShares, gasUsed[i], totalGasUsed, nil)...)
+			contractWeight = devShares.MulInt(sdk.NewIntFromUint64(gasUsed[i])).QuoInt(sdk.NewIntFromUint64(totalGasUsed))
+		} else {
+			contractFees = CalculateFee(txFees, devShares, len(feeShares), params.AllowedDenoms)
+			disallowedDevFees = disallowedDevFees.Add(CalculateFee(disallowedFees, devShares, len(feeShares), nil)...)
+			contractWeight = devShares.QuoInt64(int64(len(feeShares)))
+		}
+		if contractFees.IsZero() {
+			continue
+		}
+
+		for _, withdrawer := range feeShare.GetWeightedWithdrawers() {
+			withdrawerAddr := withdrawer.GetAddr()
+			if withdrawerAddr == nil {
+				continue
+			}
+
+			feeToBePaid := CalculateWithdrawerFee(contractFees, withdrawer.WeightBps)
+			if feeToBePaid.IsZero() {
+				continue
+			}
+			payouts = append(payouts, withdrawerPayout{
+				contract:   feeShare.GetContractAddr(),
+				withdrawer: withdrawerAddr,
+				fees:       feeToBePaid,
+				weight:     contractWeight.MulInt64(int64(withdrawer.WeightBps)).QuoInt64(feeshare.BasisPointsTotal),
+			})
+		}
+	}
+
+	recipients, remainder := limitRecipients(aggregatePayouts(payouts), params.MaxPayoutRecipients, params.PayoutRemainderPolicy)
+	switch params.PayoutMode {
+	case feeshare.PayoutModeAccrue:
+		err = fsd.accrue(ctx, recipients)
+	case feeshare.PayoutModeEpoch:
+		err = fsd.escrow(ctx, recipients)
+	default:
+		err = fsd.payout(ctx, recipients)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the revenue of the escrowed fees is recorded when they are distributed
+	if params.PayoutMode != feeshare.PayoutModeEpoch {
+		fsd.recordRevenue(ctx, recipients, params)
+	}
+	if err := fsd.fundCommunityPool(ctx, remainder); err != nil {
+		return err
+	}
+	return fsd.handleDisallowedFees(ctx, disallowedDevFees, params)
+}
+
+// handleDisallowedFees sends the developer shares of the fees paid in
+// disallowed denoms to the community pool, burns them or leaves them
+// in the fee collector depending on the policy of each denom.
+func (fsd FeeSharePayoutDecorator) handleDisallowedFees(ctx sdk.Context, fees sdk.Coins, params feeshare.Params) error {
+	var communityPoolFees, burnFees, retainedFees sdk.Coins
+	for _, fee := range fees {
+		switch params.GetDenomPolicy(fee.Denom) {
+		case feeshare.DisallowedDenomPolicyBurn:
+			burnFees = burnFees.Add(fee)
+		case feeshare.DisallowedDenomPolicyFeeCollector:
+			retainedFees = retainedFees.Add(fee)
+		default:
+			communityPoolFees = communityPoolFees.Add(fee)
+		}
+	}
+
+	if err := fsd.fundCommunityPool(ctx, communityPoolFees); err != nil {
+		return err
+	}
+
+	if !burnFees.IsZero() {
+		// the fee collector cannot burn coins so they are
+		// burned from the feeshare module account
+		err := fsd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feeshare.ModuleName, burnFees)
+		if err != nil {
+			return err
+		}
+		if err := fsd.bankKeeper.BurnCoins(ctx, feeshare.ModuleName, burnFees); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&feeshare.FeeBurnEvent{Fees: burnFees}); err != nil {
+			return err
+		}
+	}
+
+	if !retainedFees.IsZero() {
+		if err := ctx.EventManager().EmitTypedEvent(&feeshare.FeeRetainedEvent{Fees: retainedFees}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fundCommunityPool sends the fees from the fee collector to the community pool.
+func (fsd FeeSharePayoutDecorator) fundCommunityPool(ctx sdk.Context, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	feeCollector := fsd.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	if err := fsd.distrKeeper.FundCommunityPool(ctx, fees, feeCollector); err != nil {
 		return err
 	}
+	return ctx.EventManager().EmitTypedEvent(&feeshare.FeeCommunityPoolEvent{Fees: fees})
+}
+
+// withdrawerPayout is the amount of fees owed to a withdrawer
+// of a contract and the proportion of the transaction fees
+// it represents.
+type withdrawerPayout struct {
+	contract   sdk.Address
+	withdrawer sdk.AccAddress
+	fees       sdk.Coins
+	weight     sdk.Dec
+}
+
+// recipientPayout is the amount of fees owed to a withdrawer
+// across all the contracts executed in a transaction.
+type recipientPayout struct {
+	withdrawer sdk.AccAddress
+	fees       sdk.Coins
+	weight     sdk.Dec
+	payouts    []withdrawerPayout
+}
+
+// aggregatePayouts merges the payouts owed to the same withdrawer and
+// sorts the recipients by their contribution, which is the proportion of
+// the transaction fees they receive, breaking ties by address so the
+// order does not depend on the order the contracts were executed.
+func aggregatePayouts(payouts []withdrawerPayout) []recipientPayout {
+	var recipients []recipientPayout
+	indexes := make(map[string]int)
+	for _, p := range payouts {
+		i, found := indexes[string(p.withdrawer)]
+		if !found {
+			i = len(recipients)
+			indexes[string(p.withdrawer)] = i
+			recipients = append(recipients, recipientPayout{withdrawer: p.withdrawer, weight: sdk.ZeroDec()})
+		}
+		recipients[i].fees = recipients[i].fees.Add(p.fees...)
+		recipients[i].weight = recipients[i].weight.Add(p.weight)
+		recipients[i].payouts = append(recipients[i].payouts, p)
+	}
+
+	sort.Slice(recipients, func(i, j int) bool {
+		if !recipients[i].weight.Equal(recipients[j].weight) {
+			return recipients[i].weight.GT(recipients[j].weight)
+		}
+		return bytes.Compare(recipients[i].withdrawer, recipients[j].withdrawer) < 0
+	})
+	return recipients
+}
+
+// limitRecipients keeps the first maxRecipients recipients, when maxRecipients
+// is zero all recipients are kept. The fees owed to the other recipients are
+// split between the kept recipients proportionally to their contribution, with
+// the rounding remainder staying in the fee collector, or returned to be sent
+// to the community pool depending on the policy.
+func limitRecipients(recipients []recipientPayout, maxRecipients uint32, policy feeshare.PayoutRemainderPolicy) ([]recipientPayout, sdk.Coins) {
+	if maxRecipients == 0 || len(recipients) <= int(maxRecipients) {
+		return recipients, nil
+	}
+
+	kept := recipients[:maxRecipients]
+	var remainder sdk.Coins
+	for _, r := range recipients[maxRecipients:] {
+		remainder = remainder.Add(r.fees...)
+	}
+	if policy == feeshare.PayoutRemainderPolicyCommunityPool {
+		return kept, remainder
+	}
+
+	totalWeight := sdk.ZeroDec()
+	for _, r := range kept {
+		totalWeight = totalWeight.Add(r.weight)
+	}
+	if !totalWeight.IsPositive() {
+		return kept, nil
+	}
+
+	for i, r := range kept {
+		var extraFees sdk.Coins
+		for _, c := range remainder {
+			amount := r.weight.MulInt(c.Amount).Quo(totalWeight).TruncateInt()
+			if !amount.IsZero() {
+				extraFees = extraFees.Add(sdk.NewCoin(c.Denom, amount))
+			}
+		}
+		if extraFees.IsZero() {
+			continue
+		}
+
+		// the extra fees are recorded as revenue of the
+		// contract the recipient received the most from
+		payouts := slices.Clone(r.payouts)
+		largest := 0
+		for j, p := range payouts {
+			if p.weight.GT(payouts[largest].weight) {
+				largest = j
+			}
+		}
+		payouts[largest].fees = payouts[largest].fees.Add(extraFees...)
+
+		kept[i].fees = r.fees.Add(extraFees...)
+		kept[i].payouts = payouts
+	}
+	return kept, nil
+}
+
+// recordRevenue adds the fees paid to the recipients to the revenue of
+// their contracts, of each recipient and of the block, writing each
+// total once, so that the writes of the withdrawers and the block are
+// bounded by the max payout recipients.
+func (fsd FeeSharePayoutDecorator) recordRevenue(ctx sdk.Context, recipients []recipientPayout, params feeshare.Params) {
+	var contracts []sdk.Address
+	contractFees := make(map[string]sdk.Coins)
+	var totalFees sdk.Coins
+	for _, r := range recipients {
+		for _, p := range r.payouts {
+			key := string(p.contract.Bytes())
+			if _, found := contractFees[key]; !found {
+				contracts = append(contracts, p.contract)
+			}
+			contractFees[key] = contractFees[key].Add(p.fees...)
+		}
+		fsd.feesharekeeper.AddWithdrawerRevenue(ctx, r.withdrawer, r.fees)
+		totalFees = totalFees.Add(r.fees...)
+	}
+	for _, contract := range contracts {
+		fsd.feesharekeeper.AddContractRevenue(ctx, contract, contractFees[string(contract.Bytes())])
+	}
+	fsd.feesharekeeper.AddBlockRevenue(ctx, params, totalFees)
+}
 
-	// pay the fees to the withdrawer addresses
-	for _, withdrawerAddrs := range withdrawerAddrs {
-		err = fsd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawerAddrs, feeToBePaid)
+// payout implements PAYOUT_MODE_DIRECT, sending the fees
+// to each recipient with a single bank send.
+func (fsd FeeSharePayoutDecorator) payout(ctx sdk.Context, recipients []recipientPayout) error {
+	for _, r := range recipients {
+		err := fsd.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, r.withdrawer, r.fees)
 		if err != nil {
 			return err
 		}
-		err := ctx.EventManager().EmitTypedEvent(
+		err = ctx.EventManager().EmitTypedEvent(
 			&feeshare.FeePayoutEvent{
-				WithdrawAddress: withdrawerAddrs.String(),
-				FeesPaid:        feeToBePaid,
+				WithdrawAddress: r.withdrawer.String(),
+				FeesPaid:        r.fees,
 			},
 		)
 		if err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
-	return err
+// accrue implements PAYOUT_MODE_ACCRUE, moving the fees of all recipients
+// to the feeshare module account with a single bank send and crediting
+// them to the pending rewards of each recipient until they are withdrawn.
+func (fsd FeeSharePayoutDecorator) accrue(ctx sdk.Context, recipients []recipientPayout) error {
+	var totalFees sdk.Coins
+	for _, r := range recipients {
+		totalFees = totalFees.Add(r.fees...)
+	}
+	if totalFees.IsZero() {
+		return nil
+	}
+
+	err := fsd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feeshare.ModuleName, totalFees)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range recipients {
+		fsd.feesharekeeper.AccruePendingRewards(ctx, r.withdrawer, r.fees)
+		err = ctx.EventManager().EmitTypedEvent(
+			&feeshare.FeeAccrualEvent{
+				WithdrawAddress: r.withdrawer.String(),
+				FeesAccrued:     r.fees,
+			},
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// escrow implements PAYOUT_MODE_EPOCH, moving the fees of all recipients to
+// the feeshare module account with a single bank send and escrowing the fees
+// of each contract withdrawer until they are distributed at the end of the
+// payout epoch.
+func (fsd FeeSharePayoutDecorator) escrow(ctx sdk.Context, recipients []recipientPayout) error {
+	var totalFees sdk.Coins
+	for _, r := range recipients {
+		totalFees = totalFees.Add(r.fees...)
+	}
+	if totalFees.IsZero() {
+		return nil
+	}
+
+	err := fsd.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feeshare.ModuleName, totalFees)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range recipients {
+		for _, p := range r.payouts {
+			fsd.feesharekeeper.EscrowPayout(ctx, p.contract, p.withdrawer, p.fees)
+		}
+	}
+	return nil
 }
 
-// Iterate the contract addresses and get the
-// withdrawer address from the module store
-func GetWithdrawalAddressFromContract(ctx sdk.Context, contractAddresses []string, fsk FeeShareKeeper) ([]sdk.AccAddress, error) {
-	var withdrawerAddrs []sdk.AccAddress
+// GetFeeSharesGasUsage iterates the executed contracts and returns the
+// FeeShare of each registered contract with at least one withdrawer that
+// is not blocked by governance alongside the gas consumed by that contract
+// during the transaction.
+func GetFeeSharesGasUsage(ctx sdk.Context, executedContracts customwasmtypes.ExecutedContracts, fsk FeeShareKeeper) ([]feeshare.FeeShare, []uint64, error) {
+	var feeShares []feeshare.FeeShare
+	var gasUsed []uint64
 
-	for _, contractAddr := range contractAddresses {
+	for _, contractAddr := range executedContracts.ContractAddresses {
 		parsedContractAddr, err := sdk.AccAddressFromBech32(contractAddr)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		shareData, hasfeeshare := fsk.GetFeeShare(ctx, parsedContractAddr)
-
-		if !hasfeeshare {
+		if !hasfeeshare || len(shareData.GetWithdrawerAddrs()) == 0 {
 			continue
 		}
-
-		withdrawerAddr := shareData.GetWithdrawerAddr()
-		if withdrawerAddr != nil && !withdrawerAddr.Empty() {
-			withdrawerAddrs = append(withdrawerAddrs, withdrawerAddr)
+		if fsk.IsContractBlocked(ctx, parsedContractAddr) {
+			continue
 		}
+
+		feeShares = append(feeShares, shareData)
+		gasUsed = append(gasUsed, executedContracts.GetContractGas(contractAddr))
 	}
 
-	return withdrawerAddrs, nil
+	return feeShares, gasUsed, nil
 }
 
 // CalculateFee takes the total fees paid for a transaction and split
 // these fees equaly between all number of pairs considering allwoedDenoms
 func CalculateFee(fees sdk.Coins, devShares sdk.Dec, numOfdevs int, allowedDenoms []string) sdk.Coins {
-	var allowedFeesDenoms sdk.Coins
-	if len(allowedDenoms) == 0 {
-		allowedFeesDenoms = fees
-	} else {
-		for _, fee := range fees {
-			for _, allowedDenom := range allowedDenoms {
-				if fee.Denom == allowedDenom {
-					allowedFeesDenoms = allowedFeesDenoms.Add(fee)
-					break
-				}
-			}
+	var splitFees sdk.Coins
+	for _, c := range filterAllowedFees(fees, allowedDenoms) {
+		rewardAmount := devShares.MulInt(c.Amount).QuoInt64(int64(numOfdevs)).RoundInt()
+		if !rewardAmount.IsZero() {
+			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
 		}
 	}
+	return splitFees
+}
+
+// CalculateGasWeightedFee takes the total fees paid for a transaction and
+// returns the portion that corresponds to a contract that consumed gasUsed
+// out of the totalGasUsed by all executed contracts considering allowedDenoms
+func CalculateGasWeightedFee(fees sdk.Coins, devShares sdk.Dec, gasUsed uint64, totalGasUsed uint64, allowedDenoms []string) sdk.Coins {
+	if totalGasUsed == 0 {
+		return nil
+	}
+
+	weight := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).
+		QuoInt(sdk.NewIntFromUint64(totalGasUsed))
 
 	var splitFees sdk.Coins
-	for _, c := range allowedFeesDenoms.Sort() {
-		rewardAmount := devShares.MulInt(c.Amount).QuoInt64(int64(numOfdevs)).RoundInt()
+	for _, c := range filterAllowedFees(fees, allowedDenoms) {
+		rewardAmount := devShares.MulInt(c.Amount).Mul(weight).TruncateInt()
 		if !rewardAmount.IsZero() {
 			splitFees = splitFees.Add(sdk.NewCoin(c.Denom, rewardAmount))
 		}
 	}
 	return splitFees
 }
+
+// CalculateWithdrawerFee returns the portion of the contract
+// fees that corresponds to a withdrawer with the given weight
+// in basis points, the remainder stays in the fee collector.
+func CalculateWithdrawerFee(contractFees sdk.Coins, weightBps uint32) sdk.Coins {
+	if weightBps >= feeshare.BasisPointsTotal {
+		return contractFees
+	}
+
+	var withdrawerFees sdk.Coins
+	for _, c := range contractFees {
+		amount := c.Amount.MulRaw(int64(weightBps)).QuoRaw(feeshare.BasisPointsTotal)
+		if !amount.IsZero() {
+			withdrawerFees = withdrawerFees.Add(sdk.NewCoin(c.Denom, amount))
+		}
+	}
+	return withdrawerFees
+}
+
+// filterDisallowedFees returns the sorted fees which denoms are not
+// included in allowedDenoms, when allowedDenoms is empty all fees are allowed.
+func filterDisallowedFees(fees sdk.Coins, allowedDenoms []string) sdk.Coins {
+	if len(allowedDenoms) == 0 {
+		return nil
+	}
+
+	var disallowedFees sdk.Coins
+	for _, fee := range fees {
+		if !slices.Contains(allowedDenoms, fee.Denom) {
+			disallowedFees = disallowedFees.Add(fee)
+		}
+	}
+	return disallowedFees.Sort()
+}
+
+// filterAllowedFees returns the sorted fees which denoms are included
+// in allowedDenoms, when allowedDenoms is empty all fees are allowed.
+func filterAllowedFees(fees sdk.Coins, allowedDenoms []string) sdk.Coins {
+	if len(allowedDenoms) == 0 {
+		return fees.Sort()
+	}
+
+	var allowedFeesDenoms sdk.Coins
+	for _, fee := range fees {
+		for _, allowedDenom := range allowedDenoms {
+			if fee.Denom == allowedDenom {
+				allowedFeesDenoms = allowedFeesDenoms.Add(fee)
+				break
+			}
+		}
+	}
+	return allowedFeesDenoms.Sort()
+}
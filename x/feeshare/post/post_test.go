@@ -9,6 +9,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -27,76 +28,6 @@ func TestAnteSuite(t *testing.T) {
 	suite.Run(t, new(AnteTestSuite))
 }
 
-func (suite *AnteTestSuite) TestGetWithdrawalAddressFromContract() {
-	suite.Setup()
-
-	feeshareKeeper := suite.AppTestSuite.App.Keepers.FeeShareKeeper
-	feeshareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
-		ContractAddress:   "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
-		DeployerAddress:   "",
-		WithdrawerAddress: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
-	})
-	feeshareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
-		ContractAddress:   "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
-		DeployerAddress:   "",
-		WithdrawerAddress: "",
-	})
-
-	testCases := []struct {
-		name                    string
-		contractAddresses       []string
-		expectedWithdrawerAddrs []sdk.AccAddress
-		expectErr               bool
-	}{
-		{
-			"valid contract addresses",
-			[]string{"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s"},
-			[]sdk.AccAddress{
-				sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"),
-			},
-			false,
-		},
-		{
-			"two valid contract addresses with one not registered",
-			[]string{
-				"terra1u3z42fpctuhh8mranz4tatacqhty6a8yk7l5wvj7dshsuytcms2qda4f5x", // not registered address
-				"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
-			},
-			[]sdk.AccAddress{
-				sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"),
-			},
-			false,
-		},
-		{
-			"without withdrawer contract addresses",
-			[]string{"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"},
-			[]sdk.AccAddress(nil),
-			false,
-		},
-		{
-			"invalid contract address",
-			[]string{"invalidAddress"},
-			nil,
-			true,
-		},
-	}
-
-	for _, tc := range testCases {
-		withdrawerAddrs, err := post.GetWithdrawalAddressFromContract(
-			suite.Ctx,
-			tc.contractAddresses,
-			feeshareKeeper,
-		)
-
-		if tc.expectErr {
-			suite.Require().Error(err, tc.name)
-		} else {
-			suite.Require().NoError(err, tc.name)
-			suite.Require().Equal(tc.expectedWithdrawerAddrs, withdrawerAddrs, tc.name)
-		}
-	}
-}
-
 func (suite *AnteTestSuite) TestCalculateFee() {
 	feeCoins := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(250)))
 
@@ -197,6 +128,151 @@ func (suite *AnteTestSuite) TestCalculateFee() {
 	}
 }
 
+func (suite *AnteTestSuite) TestCalculateGasWeightedFee() {
+	feeCoins := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(250)))
+
+	testCases := []struct {
+		name               string
+		incomingFee        sdk.Coins
+		devShares          sdk.Dec
+		gasUsed            uint64
+		totalGasUsed       uint64
+		allowdDenoms       []string
+		expectedFeePayment sdk.Coins
+	}{
+		{
+			"100% fee / all gas",
+			feeCoins,
+			sdk.NewDecWithPrec(100, 2),
+			1000,
+			1000,
+			[]string{},
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(500)), sdk.NewCoin("utoken", sdk.NewInt(250))),
+		},
+		{
+			"50% fee / 95% of the gas",
+			feeCoins,
+			sdk.NewDecWithPrec(50, 2),
+			95_000,
+			100_000,
+			[]string{},
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(237)), sdk.NewCoin("utoken", sdk.NewInt(118))),
+		},
+		{
+			"50% fee / 5% of the gas",
+			feeCoins,
+			sdk.NewDecWithPrec(50, 2),
+			5_000,
+			100_000,
+			[]string{},
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(12)), sdk.NewCoin("utoken", sdk.NewInt(6))),
+		},
+		{
+			"50% fee / 25% of the gas / 1 allowed denom",
+			feeCoins,
+			sdk.NewDecWithPrec(50, 2),
+			250,
+			1000,
+			[]string{"uluna"},
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(62))),
+		},
+		{
+			"50% fee / no gas used",
+			feeCoins,
+			sdk.NewDecWithPrec(50, 2),
+			0,
+			1000,
+			[]string{},
+			sdk.Coins(nil),
+		},
+		{
+			"50% fee / no total gas",
+			feeCoins,
+			sdk.NewDecWithPrec(50, 2),
+			0,
+			0,
+			[]string{},
+			sdk.Coins(nil),
+		},
+	}
+
+	for _, tc := range testCases {
+		feeToBePaid := post.CalculateGasWeightedFee(tc.incomingFee, tc.devShares, tc.gasUsed, tc.totalGasUsed, tc.allowdDenoms)
+
+		suite.Require().Equal(tc.expectedFeePayment, feeToBePaid, tc.name)
+	}
+}
+
+func (suite *AnteTestSuite) TestGetFeeSharesGasUsage() {
+	suite.Setup()
+
+	feeshareKeeper := suite.AppTestSuite.App.Keepers.FeeShareKeeper
+	feeShare := types.FeeShare{
+		ContractAddress:   "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+	}
+	feeshareKeeper.SetFeeShare(suite.Ctx, feeShare)
+	feeshareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+		DeployerAddress:   "",
+		WithdrawerAddress: "",
+	})
+
+	feeShares, gasUsed, err := post.GetFeeSharesGasUsage(
+		suite.Ctx,
+		customwasmtypes.ExecutedContracts{
+			ContractAddresses: []string{
+				"terra1u3z42fpctuhh8mranz4tatacqhty6a8yk7l5wvj7dshsuytcms2qda4f5x", // not registered address
+				"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+				"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa", // without withdrawers
+			},
+			GasUsed: []uint64{100, 300, 500},
+		},
+		feeshareKeeper,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeShare{feeShare}, feeShares)
+	suite.Require().Equal([]uint64{300}, gasUsed)
+}
+
+func (suite *AnteTestSuite) TestCalculateWithdrawerFee() {
+	contractFees := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)), sdk.NewCoin("utoken", sdk.NewInt(3)))
+
+	testCases := []struct {
+		name               string
+		weightBps          uint32
+		expectedFeePayment sdk.Coins
+	}{
+		{
+			"all the fees",
+			types.BasisPointsTotal,
+			contractFees,
+		},
+		{
+			"a quarter of the fees",
+			2500,
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(250))),
+		},
+		{
+			"a third of the fees",
+			3333,
+			sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(333))),
+		},
+		{
+			"fees round down to zero",
+			1,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		feeToBePaid := post.CalculateWithdrawerFee(contractFees, tc.weightBps)
+
+		suite.Require().Equal(tc.expectedFeePayment, feeToBePaid, tc.name)
+	}
+}
+
 func (suite *AnteTestSuite) TestPostHandler() {
 	suite.Setup()
 
@@ -230,6 +306,8 @@ func (suite *AnteTestSuite) TestPostHandler() {
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 	// Remove all events from the context to assert the events being added correctly.
 	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
@@ -317,6 +395,8 @@ func (suite *AnteTestSuite) TestDisabledPostHandle() {
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 
 	// Assert the next handler is called once
@@ -353,6 +433,8 @@ func (suite *AnteTestSuite) TestWithZeroFeesPostHandle() {
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 
 	// Assert the next handler is called once
@@ -403,6 +485,8 @@ func (suite *AnteTestSuite) TestPostHandlerWithEmptySmartContractStore() {
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 
 	// Assert the next handler is called once
@@ -457,6 +541,8 @@ func (suite *AnteTestSuite) TestPostHandlerNoSmartContractExecuted() {
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 
 	// Assert the next handler is called once
@@ -507,6 +593,8 @@ func (suite *AnteTestSuite) TestPostHandlerWithInvalidContractAddrOnExecution()
 		suite.App.Keepers.FeeShareKeeper,
 		suite.App.Keepers.BankKeeper,
 		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
 	)
 
 	// Execute the PostHandle function
@@ -523,3 +611,542 @@ func (suite *AnteTestSuite) TestPostHandlerWithInvalidContractAddrOnExecution()
 		Require().
 		ErrorIs(err, errorsmod.Wrapf(sdkerrors.ErrLogic, err.Error()))
 }
+
+func (suite *AnteTestSuite) TestGasWeightedPostHandler() {
+	suite.Setup()
+
+	// Create a mocked next post handler to assert the function being called.
+	ctrl := gomock.NewController(suite.T())
+	mockedPostDecorator := mocks.NewMockPostDecorator(ctrl)
+
+	// Enable the gas weighted distribution mode...
+	params := types.DefaultParams()
+	params.DistributionMode = types.DistributionModeGasWeighted
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Register two feeshare contracts...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+	})
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+	})
+	// ... append the executed contract addresses with the gas used by each one ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{
+			"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+			"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		},
+		GasUsed: []uint64{95_000, 5_000},
+	})
+
+	// build a tx with a fee amount ...
+	txFee := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)))
+	txBuilder := suite.EncodingConfig.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(txFee)
+	txBuilder.SetMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+		Contract: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+	})
+	// ... create the feeshare post handler ...
+	handler := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	)
+
+	// Assert the next handler is called once
+	mockedPostDecorator.
+		EXPECT().
+		PostHandle(gomock.Any(), gomock.Any(), false, true, gomock.Any()).
+		Times(1)
+
+	// Execute the PostHandle function
+	_, err = handler.PostHandle(
+		suite.Ctx,
+		txBuilder.GetTx(),
+		false,
+		true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool) (sdk.Context, error) {
+			return mockedPostDecorator.PostHandle(ctx, tx, simulate, success, nil)
+		},
+	)
+	suite.Require().NoError(err)
+
+	// The contract that consumed 95% of the gas receives 95% of the developer shares
+	balance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"), "uluna")
+	suite.Require().Equal(sdk.NewInt(475), balance.Amount)
+	balance = suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s"), "uluna")
+	suite.Require().Equal(sdk.NewInt(25), balance.Amount)
+}
+
+// TestGasWeightedUnregisteredContractPostHandler tests that the gas of the
+// unregistered contracts is not reassigned to the registered ones
+func (suite *AnteTestSuite) TestGasWeightedUnregisteredContractPostHandler() {
+	suite.Setup()
+
+	// Create a mocked next post handler to assert the function being called.
+	ctrl := gomock.NewController(suite.T())
+	mockedPostDecorator := mocks.NewMockPostDecorator(ctrl)
+
+	// Enable the gas weighted distribution mode...
+	params := types.DefaultParams()
+	params.DistributionMode = types.DistributionModeGasWeighted
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Register only the proxy contract...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+	})
+	// ... which is executed alongside a heavy unregistered contract ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{
+			"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+			"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		},
+		GasUsed: []uint64{95_000, 5_000},
+	})
+
+	// build a tx with a fee amount ...
+	txFee := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)))
+	txBuilder := suite.EncodingConfig.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(txFee)
+	txBuilder.SetMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+		Contract: "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+	})
+	// ... create the feeshare post handler ...
+	handler := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	)
+
+	// Assert the next handler is called once
+	mockedPostDecorator.
+		EXPECT().
+		PostHandle(gomock.Any(), gomock.Any(), false, true, gomock.Any()).
+		Times(1)
+
+	feeCollector := suite.App.Keepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, feeCollector, "uluna")
+
+	// Execute the PostHandle function
+	_, err = handler.PostHandle(
+		suite.Ctx,
+		txBuilder.GetTx(),
+		false,
+		true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool) (sdk.Context, error) {
+			return mockedPostDecorator.PostHandle(ctx, tx, simulate, success, nil)
+		},
+	)
+	suite.Require().NoError(err)
+
+	// The proxy that consumed 5% of the gas only receives 5% of the developer
+	// shares, the shares of the unregistered contract stay in the fee collector
+	balance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"), "uluna")
+	suite.Require().Equal(sdk.NewInt(25), balance.Amount)
+	suite.Require().Equal(feeCollectorBalance.Amount.SubRaw(25), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, feeCollector, "uluna").Amount)
+}
+
+func (suite *AnteTestSuite) TestWeightedWithdrawersPostHandler() {
+	suite.Setup()
+
+	// Create a mocked next post handler to assert the function being called.
+	ctrl := gomock.NewController(suite.T())
+	mockedPostDecorator := mocks.NewMockPostDecorator(ctrl)
+
+	// Register the feeshare contract with two weighted withdrawers...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+		DeployerAddress: "",
+		Withdrawers: []types.Withdrawer{
+			{Address: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je", WeightBps: 7000},
+			{Address: "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s", WeightBps: 3000},
+		},
+	})
+	// ... append the executed contract addresses in the wasm keeper ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"},
+	})
+
+	// build a tx with a fee amount ...
+	txFee := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)))
+	txBuilder := suite.EncodingConfig.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(txFee)
+	txBuilder.SetMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+		Contract: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+	})
+	// ... create the feeshare post handler ...
+	handler := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	)
+
+	// Assert the next handler is called once
+	mockedPostDecorator.
+		EXPECT().
+		PostHandle(gomock.Any(), gomock.Any(), false, true, gomock.Any()).
+		Times(1)
+
+	// Execute the PostHandle function
+	_, err := handler.PostHandle(
+		suite.Ctx,
+		txBuilder.GetTx(),
+		false,
+		true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool) (sdk.Context, error) {
+			return mockedPostDecorator.PostHandle(ctx, tx, simulate, success, nil)
+		},
+	)
+	suite.Require().NoError(err)
+
+	// The developer shares (50% of the fees) are split 70/30 between the withdrawers
+	balance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"), "uluna")
+	suite.Require().Equal(sdk.NewInt(350), balance.Amount)
+	balance = suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s"), "uluna")
+	suite.Require().Equal(sdk.NewInt(150), balance.Amount)
+
+	// The revenue is recorded for the contract, each withdrawer and the block
+	feeshareKeeper := suite.App.Keepers.FeeShareKeeper
+	contractRevenue := feeshareKeeper.GetContractRevenue(suite.Ctx, sdk.MustAccAddressFromBech32("terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), contractRevenue)
+	withdrawerRevenue := feeshareKeeper.GetWithdrawerRevenue(suite.Ctx, sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 350)), withdrawerRevenue)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), feeshareKeeper.GetBlockRevenue(suite.Ctx, suite.Ctx.BlockHeight()))
+}
+
+func (suite *AnteTestSuite) TestAccruePostHandler() {
+	suite.Setup()
+
+	// Create a mocked next post handler to assert the function being called.
+	ctrl := gomock.NewController(suite.T())
+	mockedPostDecorator := mocks.NewMockPostDecorator(ctrl)
+
+	// Enable the accrue payout mode...
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutModeAccrue
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Register the feeshare contract with two weighted withdrawers...
+	withdrawer1 := sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je")
+	withdrawer2 := sdk.MustAccAddressFromBech32("terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s")
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+		DeployerAddress: "",
+		Withdrawers: []types.Withdrawer{
+			types.NewWithdrawer(withdrawer1, 7000),
+			types.NewWithdrawer(withdrawer2, 3000),
+		},
+	})
+	// ... append the executed contract addresses in the wasm keeper ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"},
+	})
+
+	// build a tx with a fee amount ...
+	txFee := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)))
+	txBuilder := suite.EncodingConfig.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(txFee)
+	txBuilder.SetMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+		Contract: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+	})
+	// ... create the feeshare post handler ...
+	handler := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	)
+	// Remove all events from the context to assert the events being added correctly.
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// Assert the next handler is called once
+	mockedPostDecorator.
+		EXPECT().
+		PostHandle(gomock.Any(), gomock.Any(), false, true, gomock.Any()).
+		Times(1)
+
+	// Execute the PostHandle function
+	_, err = handler.PostHandle(
+		suite.Ctx,
+		txBuilder.GetTx(),
+		false,
+		true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool) (sdk.Context, error) {
+			return mockedPostDecorator.PostHandle(ctx, tx, simulate, success, nil)
+		},
+	)
+	suite.Require().NoError(err)
+
+	// The fees are credited to the withdrawers instead of being sent
+	suite.Require().True(suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, withdrawer1, "uluna").IsZero())
+	suite.Require().True(suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, withdrawer2, "uluna").IsZero())
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(350))),
+		suite.App.Keepers.FeeShareKeeper.GetPendingRewards(suite.Ctx, withdrawer1),
+	)
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(150))),
+		suite.App.Keepers.FeeShareKeeper.GetPendingRewards(suite.Ctx, withdrawer2),
+	)
+
+	// ... and held in the feeshare module account with a single transfer
+	moduleAddr := suite.App.Keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(sdk.NewInt(500), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "uluna").Amount)
+	suite.AssertEventEmitted(suite.Ctx, "transfer", 1)
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeeAccrualEvent", 2)
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeePayoutEvent", 0)
+}
+
+func (suite *AnteTestSuite) TestEpochPostHandler() {
+	suite.Setup()
+	contract := sdk.MustAccAddressFromBech32("terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa")
+	withdrawer := sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je")
+
+	// Enable the epoch payout mode...
+	params := types.DefaultParams()
+	params.PayoutMode = types.PayoutModeEpoch
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// ... register the feeshare contract and append it to the executed contracts ...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   "",
+		WithdrawerAddress: withdrawer.String(),
+	})
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{contract.String()},
+	})
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// ... and distribute the fees
+	err = post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	).FeeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), params)
+	suite.Require().NoError(err)
+
+	// The fees are escrowed in the feeshare module account instead of being sent...
+	suite.Require().True(suite.App.Keepers.BankKeeper.GetAllBalances(suite.Ctx, withdrawer).IsZero())
+	suite.Require().Equal(
+		sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)),
+		suite.App.Keepers.FeeShareKeeper.GetEscrowedPayout(suite.Ctx, contract, withdrawer),
+	)
+	moduleAddr := suite.App.Keepers.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(sdk.NewInt(500), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, moduleAddr, "uluna").Amount)
+	suite.AssertEventEmitted(suite.Ctx, "transfer", 1)
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeePayoutEvent", 0)
+
+	// ... and the revenue is not recorded until they are distributed
+	suite.Require().True(suite.App.Keepers.FeeShareKeeper.GetContractRevenue(suite.Ctx, contract).IsZero())
+}
+
+func (suite *AnteTestSuite) TestShareOverridePostHandler() {
+	suite.Setup()
+
+	// Create a mocked next post handler to assert the function being called.
+	ctrl := gomock.NewController(suite.T())
+	mockedPostDecorator := mocks.NewMockPostDecorator(ctrl)
+
+	// Disable the developer shares except for the contract overridden by governance...
+	params := types.DefaultParams()
+	params.DeveloperShares = sdk.ZeroDec()
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+	suite.App.Keepers.FeeShareKeeper.SetShareOverride(suite.Ctx, types.NewContractShareOverride(
+		sdk.MustAccAddressFromBech32("terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"),
+		sdk.NewDecWithPrec(80, 2),
+	))
+
+	// Register two feeshare contracts...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+	})
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		DeployerAddress:   "",
+		WithdrawerAddress: "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+	})
+	// ... append the executed contract addresses in the wasm keeper ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{
+			"terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+			"terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s",
+		},
+	})
+
+	// build a tx with a fee amount ...
+	txFee := sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000)))
+	txBuilder := suite.EncodingConfig.TxConfig.NewTxBuilder()
+	txBuilder.SetFeeAmount(txFee)
+	txBuilder.SetMsgs(&wasmtypes.MsgExecuteContract{
+		Sender:   "terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je",
+		Contract: "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa",
+	})
+	// ... create the feeshare post handler ...
+	handler := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	)
+
+	// Assert the next handler is called once
+	mockedPostDecorator.
+		EXPECT().
+		PostHandle(gomock.Any(), gomock.Any(), false, true, gomock.Any()).
+		Times(1)
+
+	// Execute the PostHandle function
+	_, err = handler.PostHandle(
+		suite.Ctx,
+		txBuilder.GetTx(),
+		false,
+		true,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool, success bool) (sdk.Context, error) {
+			return mockedPostDecorator.PostHandle(ctx, tx, simulate, success, nil)
+		},
+	)
+	suite.Require().NoError(err)
+
+	// Only the overridden contract receives its share of the fees
+	balance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je"), "uluna")
+	suite.Require().Equal(sdk.NewInt(400), balance.Amount)
+	balance = suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, sdk.MustAccAddressFromBech32("terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s"), "uluna")
+	suite.Require().True(balance.Amount.IsZero())
+}
+
+func (suite *AnteTestSuite) TestDisallowedDenomsPostHandler() {
+	suite.Setup()
+	contract := "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"
+	withdrawer := sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je")
+
+	// Only allow uluna to be paid to the withdrawers, the developer shares of
+	// utoken go to the community pool, uburn are burned and uleave are left
+	// in the fee collector...
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"uluna"}
+	params.DenomPolicies = []types.DenomPolicy{
+		{Denom: "uburn", Policy: types.DisallowedDenomPolicyBurn},
+		{Denom: "uleave", Policy: types.DisallowedDenomPolicyFeeCollector},
+	}
+	err := suite.App.Keepers.FeeShareKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+	err = suite.FundModule(authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin("uburn", 100), sdk.NewInt64Coin("uleave", 100)))
+	suite.Require().NoError(err)
+
+	// ... register the feeshare contract and append it to the executed contracts ...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   contract,
+		DeployerAddress:   "",
+		WithdrawerAddress: withdrawer.String(),
+	})
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{contract},
+	})
+	communityPool := suite.App.Keepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+	burnSupply := suite.App.Keepers.BankKeeper.GetSupply(suite.Ctx, "uburn")
+	feeCollector := suite.App.Keepers.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// ... and distribute the fees
+	txFees := sdk.NewCoins(
+		sdk.NewInt64Coin("uluna", 1000),
+		sdk.NewInt64Coin("utoken", 500),
+		sdk.NewInt64Coin("uburn", 100),
+		sdk.NewInt64Coin("uleave", 100),
+	)
+	err = post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	).FeeSharePayout(suite.Ctx, txFees, params)
+	suite.Require().NoError(err)
+
+	// The withdrawer only receives the allowed denom...
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), suite.App.Keepers.BankKeeper.GetAllBalances(suite.Ctx, withdrawer))
+
+	// ... the developer shares of utoken are sent to the community pool ...
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDecCoin("utoken", sdk.NewInt(250))),
+		suite.App.Keepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx),
+	)
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeeCommunityPoolEvent", 1)
+
+	// ... the developer shares of uburn are burned ...
+	suite.Require().Equal(burnSupply.SubAmount(sdk.NewInt(50)), suite.App.Keepers.BankKeeper.GetSupply(suite.Ctx, "uburn"))
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeeBurnEvent", 1)
+
+	// ... and the developer shares of uleave stay in the fee collector
+	suite.Require().Equal(sdk.NewInt64Coin("uleave", 100), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, feeCollector, "uleave"))
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeeRetainedEvent", 1)
+}
+
+func (suite *AnteTestSuite) TestBlockedContractPostHandler() {
+	suite.Setup()
+	blockedContract := "terra1mdpvgjc8jmv60a4x68nggsh9w8uyv69sqls04a76m9med5hsqmwsse8sxa"
+	contract := "terra1jwyzzsaag4t0evnuukc35ysyrx9arzdde2kg9cld28alhjurtthq0prs2s"
+	blockedWithdrawer := sdk.MustAccAddressFromBech32("terra1zdpgj8am5nqqvht927k3etljyl6a52kwqup0je")
+	withdrawer := sdk.MustAccAddressFromBech32(contract)
+
+	// Register two feeshare contracts and block one of them...
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   blockedContract,
+		DeployerAddress:   "",
+		WithdrawerAddress: blockedWithdrawer.String(),
+	})
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   contract,
+		DeployerAddress:   "",
+		WithdrawerAddress: withdrawer.String(),
+	})
+	suite.App.Keepers.FeeShareKeeper.AddBlocklistEntry(suite.Ctx, types.NewContractBlocklistEntry(sdk.MustAccAddressFromBech32(blockedContract)))
+	// ... append the executed contract addresses in the wasm keeper ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: []string{blockedContract, contract},
+	})
+
+	// ... and distribute the fees
+	err := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	).FeeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), types.DefaultParams())
+	suite.Require().NoError(err)
+
+	// The blocked contract is skipped so the other contract receives all the developer shares
+	suite.Require().True(suite.App.Keepers.BankKeeper.GetAllBalances(suite.Ctx, blockedWithdrawer).IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), suite.App.Keepers.BankKeeper.GetAllBalances(suite.Ctx, withdrawer))
+}
@@ -0,0 +1,208 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	post "github.com/terra-money/core/v2/x/feeshare/post"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+	customwasmtypes "github.com/terra-money/core/v2/x/wasm/types"
+)
+
+func (suite *AnteTestSuite) feeSharePayout(ctx sdk.Context, fees sdk.Coins, params types.Params) error {
+	return post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	).FeeSharePayout(ctx, fees, params)
+}
+
+// newAddresses returns addresses without an account so the
+// payouts to them pay for the creation of the account.
+func newAddresses(n int) []sdk.AccAddress {
+	addresses := make([]sdk.AccAddress, n)
+	for i := range addresses {
+		addresses[i] = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	}
+	return addresses
+}
+
+// registerContracts registers the contracts with one
+// withdrawer each and marks them as executed with the
+// given gas.
+func (suite *AnteTestSuite) registerContracts(contracts []sdk.AccAddress, withdrawers []sdk.AccAddress, gasUsed []uint64) {
+	var contractAddresses []string
+	for i, contract := range contracts {
+		suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+			ContractAddress:   contract.String(),
+			DeployerAddress:   "",
+			WithdrawerAddress: withdrawers[i].String(),
+		})
+		contractAddresses = append(contractAddresses, contract.String())
+	}
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: contractAddresses,
+		GasUsed:           gasUsed,
+	})
+}
+
+func (suite *AnteTestSuite) TestAggregatedWithdrawerPostHandler() {
+	suite.Setup()
+	contracts := newAddresses(2)
+	withdrawer := newAddresses(1)[0]
+	suite.registerContracts(contracts, []sdk.AccAddress{withdrawer, withdrawer}, nil)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	err := suite.feeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), types.DefaultParams())
+	suite.Require().NoError(err)
+
+	// The fees of both contracts are paid to the withdrawer with a single send...
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), suite.App.Keepers.BankKeeper.GetAllBalances(suite.Ctx, withdrawer))
+	suite.AssertEventEmitted(suite.Ctx, "transfer", 1)
+	suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeePayoutEvent", 1)
+
+	// ... while the revenue is still recorded for each contract
+	feeshareKeeper := suite.App.Keepers.FeeShareKeeper
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 250)), feeshareKeeper.GetContractRevenue(suite.Ctx, contracts[0]))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 250)), feeshareKeeper.GetContractRevenue(suite.Ctx, contracts[1]))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("uluna", 500)), feeshareKeeper.GetWithdrawerRevenue(suite.Ctx, withdrawer))
+}
+
+func (suite *AnteTestSuite) TestMaxPayoutRecipientsPostHandler() {
+	testCases := []struct {
+		name             string
+		policy           types.PayoutRemainderPolicy
+		expBalances      []int64
+		expCommunityPool int64
+	}{
+		{
+			// the remainder of 100uluna is split 62/37 between the two
+			// largest contributors and the rounding dust is left in the
+			// fee collector
+			"remainder to the top recipients",
+			types.PayoutRemainderPolicyTopRecipients,
+			[]int64{312, 187, 0},
+			0,
+		},
+		{
+			"remainder to the community pool",
+			types.PayoutRemainderPolicyCommunityPool,
+			[]int64{250, 150, 0},
+			100,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Setup()
+			contracts := newAddresses(3)
+			withdrawers := newAddresses(3)
+			// the executed order does not match the contribution order
+			suite.registerContracts(
+				[]sdk.AccAddress{contracts[2], contracts[0], contracts[1]},
+				[]sdk.AccAddress{withdrawers[2], withdrawers[0], withdrawers[1]},
+				[]uint64{200, 500, 300},
+			)
+			params := types.DefaultParams()
+			params.DistributionMode = types.DistributionModeGasWeighted
+			params.MaxPayoutRecipients = 2
+			params.PayoutRemainderPolicy = tc.policy
+			communityPool := suite.App.Keepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx)
+			suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+			err := suite.feeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)), params)
+			suite.Require().NoError(err)
+
+			// Only the two largest contributors are paid...
+			for i, withdrawer := range withdrawers {
+				balance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, withdrawer, "uluna")
+				suite.Require().Equal(sdk.NewInt(tc.expBalances[i]), balance.Amount)
+				suite.Require().Equal(
+					sdk.NewCoins(sdk.NewInt64Coin("uluna", tc.expBalances[i])),
+					suite.App.Keepers.FeeShareKeeper.GetContractRevenue(suite.Ctx, contracts[i]),
+				)
+			}
+			suite.AssertEventEmitted(suite.Ctx, "juno.feeshare.v1.FeePayoutEvent", 2)
+
+			// ... and the remainder is sent to the community pool depending on the policy
+			suite.Require().Equal(
+				communityPool.Add(sdk.NewDecCoin("uluna", sdk.NewInt(tc.expCommunityPool))),
+				suite.App.Keepers.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx),
+			)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestPayoutGasCostWorstCase() {
+	suite.Setup()
+
+	// Register the contracts with the maximum number of
+	// withdrawers each, none of them shared...
+	numContracts := 100
+	var contractAddresses []string
+	for _, contract := range newAddresses(numContracts) {
+		var withdrawers []types.Withdrawer
+		for _, withdrawer := range newAddresses(types.MaxWithdrawers) {
+			withdrawers = append(withdrawers, types.NewWithdrawer(withdrawer, types.BasisPointsTotal/types.MaxWithdrawers))
+		}
+		suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+			ContractAddress: contract.String(),
+			DeployerAddress: "",
+			Withdrawers:     withdrawers,
+		})
+		contractAddresses = append(contractAddresses, contract.String())
+	}
+	// ... mark them all as executed in the same transaction ...
+	suite.App.Keepers.WasmKeeper.SetExecutedContractAddresses(suite.Ctx, customwasmtypes.ExecutedContracts{
+		ContractAddresses: contractAddresses,
+	})
+	fees := sdk.NewCoins(sdk.NewInt64Coin("uluna", 1_000_000), sdk.NewInt64Coin("utoken", 1_000_000))
+	suite.Require().NoError(suite.FundModule(authtypes.FeeCollectorName, fees))
+
+	// ... and measure the gas consumed by the payout
+	payoutGas := func(maxRecipients uint32) (uint64, sdk.Context) {
+		ctx, _ := suite.Ctx.CacheContext()
+		ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+		params := types.DefaultParams()
+		params.MaxPayoutRecipients = maxRecipients
+		suite.Require().NoError(suite.feeSharePayout(ctx, fees, params))
+		return ctx.GasMeter().GasConsumed(), ctx
+	}
+
+	// Without a limit every withdrawer is paid with its own send...
+	unlimitedGas, ctx := payoutGas(0)
+	suite.AssertEventEmitted(ctx, "transfer", numContracts*types.MaxWithdrawers)
+	suite.AssertEventEmitted(ctx, "juno.feeshare.v1.FeePayoutEvent", numContracts*types.MaxWithdrawers)
+
+	// ... while the default limit bounds the number of sends
+	limitedGas, ctx := payoutGas(types.DefaultMaxPayoutRecipients)
+	suite.AssertEventEmitted(ctx, "transfer", int(types.DefaultMaxPayoutRecipients))
+	suite.AssertEventEmitted(ctx, "juno.feeshare.v1.FeePayoutEvent", int(types.DefaultMaxPayoutRecipients))
+	suite.Require().Less(limitedGas, unlimitedGas/2)
+
+	// The measured gas includes the revenue writes, which are
+	// also bounded by the number of recipients paid
+	suite.Require().Len(suite.App.Keepers.FeeShareKeeper.GetAllWithdrawerRevenues(ctx), int(types.DefaultMaxPayoutRecipients))
+	suite.Require().LessOrEqual(len(suite.App.Keepers.FeeShareKeeper.GetAllContractRevenues(ctx)), int(types.DefaultMaxPayoutRecipients))
+	suite.Require().Len(suite.App.Keepers.FeeShareKeeper.GetAllBlockRevenues(ctx), 1)
+
+	// When every contract shares the same withdrawer, its revenue
+	// and the revenue of the block are only written once
+	withdrawer := newAddresses(1)[0]
+	for _, contract := range contractAddresses {
+		suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+			ContractAddress: contract,
+			DeployerAddress: "",
+			Withdrawers:     []types.Withdrawer{types.NewWithdrawer(withdrawer, types.BasisPointsTotal)},
+		})
+	}
+	sharedGas, ctx := payoutGas(types.DefaultMaxPayoutRecipients)
+	suite.AssertEventEmitted(ctx, "transfer", 1)
+	suite.Require().Len(suite.App.Keepers.FeeShareKeeper.GetAllWithdrawerRevenues(ctx), 1)
+	suite.Require().Len(suite.App.Keepers.FeeShareKeeper.GetAllContractRevenues(ctx), numContracts)
+	suite.Require().Len(suite.App.Keepers.FeeShareKeeper.GetAllBlockRevenues(ctx), 1)
+	suite.Require().Less(sharedGas, limitedGas)
+}
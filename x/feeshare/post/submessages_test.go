@@ -0,0 +1,180 @@
+package ante_test
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/terra-money/core/v2/app/config"
+	post "github.com/terra-money/core/v2/x/feeshare/post"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+func (suite *AnteTestSuite) handleMsg(msg sdk.Msg) *sdk.Result {
+	res, err := suite.App.MsgServiceRouter().Handler(msg)(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	return res
+}
+
+func (suite *AnteTestSuite) storeReflectCode(sender sdk.AccAddress) {
+	wasmCode, err := os.ReadFile("../keeper/testdata/reflect.wasm")
+	suite.Require().NoError(err)
+
+	suite.handleMsg(wasmtypes.MsgStoreCodeFixture(func(m *wasmtypes.MsgStoreCode) {
+		m.WASMByteCode = wasmCode
+		m.Sender = sender.String()
+	}))
+}
+
+func (suite *AnteTestSuite) instantiateReflectContract(sender sdk.AccAddress) string {
+	res := suite.handleMsg(wasmtypes.MsgInstantiateContractFixture(func(m *wasmtypes.MsgInstantiateContract) {
+		m.Sender = sender.String()
+		m.Admin = sender.String()
+		m.Funds = sdk.NewCoins(sdk.NewCoin(config.MicroLuna, sdk.NewInt(1)))
+		m.Msg = []byte(`{}`)
+	}))
+	var result wasmtypes.MsgInstantiateContractResponse
+	suite.Require().NoError(suite.App.AppCodec().Unmarshal(res.Data, &result))
+	return result.Address
+}
+
+// reflectMsg builds the execute message of the reflect contract
+// that dispatches the given wasm message as a submessage.
+func reflectMsg(wasmMsg string) []byte {
+	return []byte(fmt.Sprintf(`{"reflect_msg":{"msgs":[{"wasm":%s}]}}`, wasmMsg))
+}
+
+// reflectBankSendMsg builds the execute message of the reflect
+// contract that sends 1uluna from the contract to the recipient.
+func reflectBankSendMsg(recipient sdk.AccAddress) []byte {
+	return []byte(fmt.Sprintf(`{"reflect_msg":{"msgs":[{"bank":{"send":{"to_address":"%s","amount":[{"denom":"uluna","amount":"1"}]}}}]}}`, recipient))
+}
+
+func (suite *AnteTestSuite) TestExecutedContractsFromSubmessages() {
+	suite.Setup()
+	sender := suite.TestAccs[0]
+	wasmKeeper := suite.App.Keepers.WasmKeeper
+
+	// Instantiating a contract records it as executed
+	suite.storeReflectCode(sender)
+	parent := suite.instantiateReflectContract(sender)
+	executedContracts, found := wasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{parent}, executedContracts.ContractAddresses)
+	wasmKeeper.DeleteExecutedContractAddresses(suite.Ctx)
+
+	// Instantiating a contract through a submessage records both contracts
+	initMsg := base64.StdEncoding.EncodeToString([]byte(`{}`))
+	suite.handleMsg(&wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: parent,
+		Msg:      reflectMsg(fmt.Sprintf(`{"instantiate":{"admin":null,"code_id":1,"msg":"%s","funds":[],"label":"child"}}`, initMsg)),
+	})
+	var child string
+	wasmKeeper.IterateContractsByCode(suite.Ctx, 1, func(address sdk.AccAddress) bool {
+		if address.String() != parent {
+			child = address.String()
+		}
+		return false
+	})
+	suite.Require().NotEmpty(child)
+	executedContracts, _ = wasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+	suite.Require().ElementsMatch([]string{parent, child}, executedContracts.ContractAddresses)
+	wasmKeeper.DeleteExecutedContractAddresses(suite.Ctx)
+
+	// Executing a contract through a submessage records both contracts
+	// and the gas consumed by the child is not attributed to the parent
+	suite.Require().NoError(suite.FundAcc(sdk.MustAccAddressFromBech32(child), sdk.NewCoins(sdk.NewInt64Coin("uluna", 10))))
+	childMsg := base64.StdEncoding.EncodeToString(reflectBankSendMsg(sender))
+	gasBefore := suite.Ctx.GasMeter().GasConsumed()
+	suite.handleMsg(&wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: parent,
+		Msg:      reflectMsg(fmt.Sprintf(`{"execute":{"contract_addr":"%s","msg":"%s","funds":[]}}`, child, childMsg)),
+	})
+	gasUsed := suite.Ctx.GasMeter().GasConsumed() - gasBefore
+	executedContracts, _ = wasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+	suite.Require().ElementsMatch([]string{parent, child}, executedContracts.ContractAddresses)
+	suite.Require().NotZero(executedContracts.GetContractGas(parent))
+	suite.Require().NotZero(executedContracts.GetContractGas(child))
+	suite.Require().LessOrEqual(executedContracts.GetTotalGas(), gasUsed)
+	wasmKeeper.DeleteExecutedContractAddresses(suite.Ctx)
+
+	// Executing a contract through authz records the contract
+	grantee := suite.TestAccs[1]
+	grantMsg, err := authz.NewMsgGrant(sender, grantee, authz.NewGenericAuthorization(sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{})), nil)
+	suite.Require().NoError(err)
+	suite.handleMsg(grantMsg)
+	execMsg := authz.NewMsgExec(grantee, []sdk.Msg{&wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: parent,
+		Msg:      reflectBankSendMsg(sender),
+	}})
+	suite.handleMsg(&execMsg)
+	executedContracts, _ = wasmKeeper.GetExecutedContractAddresses(suite.Ctx)
+	suite.Require().Equal([]string{parent}, executedContracts.ContractAddresses)
+}
+
+func (suite *AnteTestSuite) TestPostHandlerPaysSubmessageContracts() {
+	suite.Setup()
+	sender := suite.TestAccs[0]
+	parentWithdrawer := suite.TestAccs[1]
+	childWithdrawer := suite.TestAccs[2]
+
+	suite.storeReflectCode(sender)
+	parent := suite.instantiateReflectContract(sender)
+	initMsg := base64.StdEncoding.EncodeToString([]byte(`{}`))
+	suite.handleMsg(&wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: parent,
+		Msg:      reflectMsg(fmt.Sprintf(`{"instantiate":{"admin":null,"code_id":1,"msg":"%s","funds":[],"label":"child"}}`, initMsg)),
+	})
+	var child string
+	suite.App.Keepers.WasmKeeper.IterateContractsByCode(suite.Ctx, 1, func(address sdk.AccAddress) bool {
+		if address.String() != parent {
+			child = address.String()
+		}
+		return false
+	})
+	suite.App.Keepers.WasmKeeper.DeleteExecutedContractAddresses(suite.Ctx)
+
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   parent,
+		DeployerAddress:   sender.String(),
+		WithdrawerAddress: parentWithdrawer.String(),
+	})
+	suite.App.Keepers.FeeShareKeeper.SetFeeShare(suite.Ctx, types.FeeShare{
+		ContractAddress:   child,
+		DeployerAddress:   parent,
+		WithdrawerAddress: childWithdrawer.String(),
+	})
+
+	suite.Require().NoError(suite.FundAcc(sdk.MustAccAddressFromBech32(child), sdk.NewCoins(sdk.NewInt64Coin("uluna", 10))))
+	childMsg := base64.StdEncoding.EncodeToString(reflectBankSendMsg(sender))
+	suite.handleMsg(&wasmtypes.MsgExecuteContract{
+		Sender:   sender.String(),
+		Contract: parent,
+		Msg:      reflectMsg(fmt.Sprintf(`{"execute":{"contract_addr":"%s","msg":"%s","funds":[]}}`, child, childMsg)),
+	})
+
+	parentBalance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, parentWithdrawer, "uluna")
+	childBalance := suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, childWithdrawer, "uluna")
+	params := suite.App.Keepers.FeeShareKeeper.GetParams(suite.Ctx)
+	err := post.NewFeeSharePayoutDecorator(
+		suite.App.Keepers.FeeShareKeeper,
+		suite.App.Keepers.BankKeeper,
+		suite.App.Keepers.WasmKeeper,
+		suite.App.Keepers.DistrKeeper,
+		suite.App.Keepers.AccountKeeper,
+	).FeeSharePayout(suite.Ctx, sdk.NewCoins(sdk.NewCoin("uluna", sdk.NewInt(1000))), params)
+	suite.Require().NoError(err)
+
+	// Both contracts split the developer shares equally
+	suite.Require().Equal(parentBalance.AddAmount(sdk.NewInt(250)), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, parentWithdrawer, "uluna"))
+	suite.Require().Equal(childBalance.AddAmount(sdk.NewInt(250)), suite.App.Keepers.BankKeeper.GetBalance(suite.Ctx, childWithdrawer, "uluna"))
+}
@@ -0,0 +1,137 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// Simulation parameter constants
+const (
+	EnableFeeShare        = "enable_fee_share"
+	DeveloperShares       = "developer_shares"
+	DistributionMode      = "distribution_mode"
+	PayoutMode            = "payout_mode"
+	DisallowedDenomPolicy = "disallowed_denom_policy"
+	MaxPayoutRecipients   = "max_payout_recipients"
+	PayoutRemainderPolicy = "payout_remainder_policy"
+	PayoutEpochBlocks     = "payout_epoch_blocks"
+)
+
+// GenEnableFeeShare randomized EnableFeeShare, which is mostly enabled
+// so that the registrations can be simulated.
+func GenEnableFeeShare(r *rand.Rand) bool {
+	return r.Intn(10) != 0
+}
+
+// GenDeveloperShares randomized DeveloperShares
+func GenDeveloperShares(r *rand.Rand) sdk.Dec {
+	return sdk.NewDecWithPrec(int64(r.Intn(101)), 2)
+}
+
+// GenDistributionMode randomized DistributionMode
+func GenDistributionMode(r *rand.Rand) types.DistributionMode {
+	return types.DistributionMode(r.Intn(len(types.DistributionMode_name)))
+}
+
+// GenPayoutMode randomized PayoutMode
+func GenPayoutMode(r *rand.Rand) types.PayoutMode {
+	return types.PayoutMode(r.Intn(len(types.PayoutMode_name)))
+}
+
+// GenDisallowedDenomPolicy randomized DisallowedDenomPolicy
+func GenDisallowedDenomPolicy(r *rand.Rand) types.DisallowedDenomPolicy {
+	return types.DisallowedDenomPolicy(r.Intn(len(types.DisallowedDenomPolicy_name)))
+}
+
+// GenMaxPayoutRecipients randomized MaxPayoutRecipients, where zero
+// means that there is no limit.
+func GenMaxPayoutRecipients(r *rand.Rand) uint32 {
+	return uint32(r.Intn(int(types.DefaultMaxPayoutRecipients) + 1))
+}
+
+// GenPayoutRemainderPolicy randomized PayoutRemainderPolicy
+func GenPayoutRemainderPolicy(r *rand.Rand) types.PayoutRemainderPolicy {
+	return types.PayoutRemainderPolicy(r.Intn(len(types.PayoutRemainderPolicy_name)))
+}
+
+// GenPayoutEpochBlocks randomized PayoutEpochBlocks
+func GenPayoutEpochBlocks(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, int(types.DefaultPayoutEpochBlocks)+1))
+}
+
+// RandomizedGenState generates a random GenesisState for feeshare
+func RandomizedGenState(simState *module.SimulationState) {
+	var enableFeeShare bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableFeeShare, &enableFeeShare, simState.Rand,
+		func(r *rand.Rand) { enableFeeShare = GenEnableFeeShare(r) },
+	)
+
+	var developerShares sdk.Dec
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DeveloperShares, &developerShares, simState.Rand,
+		func(r *rand.Rand) { developerShares = GenDeveloperShares(r) },
+	)
+
+	var distributionMode types.DistributionMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DistributionMode, &distributionMode, simState.Rand,
+		func(r *rand.Rand) { distributionMode = GenDistributionMode(r) },
+	)
+
+	var payoutMode types.PayoutMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PayoutMode, &payoutMode, simState.Rand,
+		func(r *rand.Rand) { payoutMode = GenPayoutMode(r) },
+	)
+
+	var disallowedDenomPolicy types.DisallowedDenomPolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DisallowedDenomPolicy, &disallowedDenomPolicy, simState.Rand,
+		func(r *rand.Rand) { disallowedDenomPolicy = GenDisallowedDenomPolicy(r) },
+	)
+
+	var maxPayoutRecipients uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, MaxPayoutRecipients, &maxPayoutRecipients, simState.Rand,
+		func(r *rand.Rand) { maxPayoutRecipients = GenMaxPayoutRecipients(r) },
+	)
+
+	var payoutRemainderPolicy types.PayoutRemainderPolicy
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PayoutRemainderPolicy, &payoutRemainderPolicy, simState.Rand,
+		func(r *rand.Rand) { payoutRemainderPolicy = GenPayoutRemainderPolicy(r) },
+	)
+
+	var payoutEpochBlocks uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, PayoutEpochBlocks, &payoutEpochBlocks, simState.Rand,
+		func(r *rand.Rand) { payoutEpochBlocks = GenPayoutEpochBlocks(r) },
+	)
+
+	params := types.DefaultParams()
+	params.EnableFeeShare = enableFeeShare
+	params.DeveloperShares = developerShares
+	params.DistributionMode = distributionMode
+	params.PayoutMode = payoutMode
+	params.DisallowedDenomPolicy = disallowedDenomPolicy
+	params.MaxPayoutRecipients = maxPayoutRecipients
+	params.PayoutRemainderPolicy = payoutRemainderPolicy
+	params.PayoutEpochBlocks = payoutEpochBlocks
+
+	genesis := types.NewGenesisState(params, []types.FeeShare{})
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feeshare parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
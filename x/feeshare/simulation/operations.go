@@ -0,0 +1,259 @@
+package simulation
+
+import (
+	"math/rand"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-money/core/v2/x/feeshare/keeper"
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgRegisterFeeShare = "op_weight_msg_register_fee_share"
+	OpWeightMsgUpdateFeeShare   = "op_weight_msg_update_fee_share"
+	OpWeightMsgCancelFeeShare   = "op_weight_msg_cancel_fee_share"
+
+	DefaultWeightMsgRegisterFeeShare = 100
+	DefaultWeightMsgUpdateFeeShare   = 50
+	DefaultWeightMsgCancelFeeShare   = 10
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	wk types.WasmKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgRegisterFeeShare int
+		weightMsgUpdateFeeShare   int
+		weightMsgCancelFeeShare   int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRegisterFeeShare, &weightMsgRegisterFeeShare, nil,
+		func(_ *rand.Rand) { weightMsgRegisterFeeShare = DefaultWeightMsgRegisterFeeShare },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateFeeShare, &weightMsgUpdateFeeShare, nil,
+		func(_ *rand.Rand) { weightMsgUpdateFeeShare = DefaultWeightMsgUpdateFeeShare },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelFeeShare, &weightMsgCancelFeeShare, nil,
+		func(_ *rand.Rand) { weightMsgCancelFeeShare = DefaultWeightMsgCancelFeeShare },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgRegisterFeeShare, SimulateMsgRegisterFeeShare(ak, bk, wk, k)),
+		simulation.NewWeightedOperation(weightMsgUpdateFeeShare, SimulateMsgUpdateFeeShare(ak, bk, wk, k)),
+		simulation.NewWeightedOperation(weightMsgCancelFeeShare, SimulateMsgCancelFeeShare(ak, bk, wk, k)),
+	}
+}
+
+// SimulateMsgRegisterFeeShare generates a MsgRegisterFeeShare for a
+// contract instantiated by the wasm simulation that is controlled by a
+// simulation account, with random withdrawers.
+func SimulateMsgRegisterFeeShare(ak types.AccountKeeper, bk types.BankKeeper, wk types.WasmKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableFeeShare {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterFeeShare, "feeshare is disabled"), nil, nil
+		}
+
+		var (
+			contracts []sdk.AccAddress
+			deployers []simtypes.Account
+		)
+		wk.IterateContractInfo(ctx, func(contract sdk.AccAddress, info wasmtypes.ContractInfo) bool {
+			if k.IsFeeShareRegistered(ctx, contract) || k.IsContractBlocked(ctx, contract) {
+				return false
+			}
+			if deployer, found := findContractController(accs, info); found {
+				contracts = append(contracts, contract)
+				deployers = append(deployers, deployer)
+			}
+			return false
+		})
+		if len(contracts) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRegisterFeeShare, "no contract to register"), nil, nil
+		}
+
+		i := r.Intn(len(contracts))
+		msg := types.NewMsgRegisterFeeShare(contracts[i], deployers[i].Address, nil)
+		msg.Withdrawers = genWithdrawers(r, accs)
+		return deliverTx(r, app, ctx, ak, bk, deployers[i], msg)
+	}
+}
+
+// SimulateMsgUpdateFeeShare generates a MsgUpdateFeeShare replacing the
+// withdrawers of a registered contract with random withdrawers.
+func SimulateMsgUpdateFeeShare(ak types.AccountKeeper, bk types.BankKeeper, wk types.WasmKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableFeeShare {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateFeeShare, "feeshare is disabled"), nil, nil
+		}
+
+		feeshare, deployer, found := randomRegisteredFeeShare(r, ctx, wk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateFeeShare, "no contract to update"), nil, nil
+		}
+
+		withdrawers := genWithdrawers(r, accs)
+		if sameWithdrawers(withdrawers, feeshare.GetWeightedWithdrawers()) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgUpdateFeeShare, "withdrawers are unchanged"), nil, nil
+		}
+
+		msg := types.NewMsgUpdateFeeShare(feeshare.GetContractAddr(), deployer.Address, nil)
+		msg.Withdrawers = withdrawers
+		return deliverTx(r, app, ctx, ak, bk, deployer, msg)
+	}
+}
+
+// SimulateMsgCancelFeeShare generates a MsgCancelFeeShare for a
+// registered contract.
+func SimulateMsgCancelFeeShare(ak types.AccountKeeper, bk types.BankKeeper, wk types.WasmKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.GetParams(ctx).EnableFeeShare {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelFeeShare, "feeshare is disabled"), nil, nil
+		}
+
+		feeshare, deployer, found := randomRegisteredFeeShare(r, ctx, wk, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCancelFeeShare, "no contract to cancel"), nil, nil
+		}
+
+		msg := types.NewMsgCancelFeeShare(feeshare.GetContractAddr(), deployer.Address)
+		return deliverTx(r, app, ctx, ak, bk, deployer, msg)
+	}
+}
+
+// randomRegisteredFeeShare returns a random FeeShare whose contract is
+// controlled by one of the simulation accounts, along with that account.
+func randomRegisteredFeeShare(
+	r *rand.Rand, ctx sdk.Context, wk types.WasmKeeper, k keeper.Keeper, accs []simtypes.Account,
+) (types.FeeShare, simtypes.Account, bool) {
+	var (
+		feeshares []types.FeeShare
+		deployers []simtypes.Account
+	)
+	k.IterateFeeShares(ctx, func(feeshare types.FeeShare) bool {
+		info := wk.GetContractInfo(ctx, sdk.AccAddress(feeshare.GetContractAddr().Bytes()))
+		if info == nil {
+			return false
+		}
+		if deployer, found := findContractController(accs, *info); found {
+			feeshares = append(feeshares, feeshare)
+			deployers = append(deployers, deployer)
+		}
+		return false
+	})
+
+	if len(feeshares) == 0 {
+		return types.FeeShare{}, simtypes.Account{}, false
+	}
+	i := r.Intn(len(feeshares))
+	return feeshares[i], deployers[i], true
+}
+
+// findContractController returns the simulation account allowed to manage
+// the FeeShare of a contract, which is its admin or its creator when the
+// contract has no admin.
+func findContractController(accs []simtypes.Account, info wasmtypes.ContractInfo) (simtypes.Account, bool) {
+	controller := info.Admin
+	if controller == "" {
+		controller = info.Creator
+	}
+	addr, err := sdk.AccAddressFromBech32(controller)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// genWithdrawers returns up to three distinct random withdrawers whose
+// weights add up to the basis points total.
+func genWithdrawers(r *rand.Rand, accs []simtypes.Account) []types.Withdrawer {
+	numWithdrawers := simtypes.RandIntBetween(r, 1, 4)
+	if numWithdrawers > len(accs) {
+		numWithdrawers = len(accs)
+	}
+
+	withdrawers := make([]types.Withdrawer, 0, numWithdrawers)
+	remaining := types.BasisPointsTotal
+	for i, idx := range r.Perm(len(accs))[:numWithdrawers] {
+		weight := remaining
+		if i < numWithdrawers-1 {
+			// leave at least one basis point to each remaining withdrawer
+			weight = simtypes.RandIntBetween(r, 1, remaining-(numWithdrawers-1-i)+1)
+		}
+		remaining -= weight
+		withdrawers = append(withdrawers, types.Withdrawer{
+			Address:   accs[idx].Address.String(),
+			WeightBps: uint32(weight),
+		})
+	}
+	return withdrawers
+}
+
+// sameWithdrawers returns whether both slices hold the same withdrawers
+// with the same weights, regardless of their order.
+func sameWithdrawers(a, b []types.Withdrawer) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	weights := make(map[string]uint32, len(a))
+	for _, w := range a {
+		weights[w.Address] = w.WeightBps
+	}
+	for _, w := range b {
+		if weight, ok := weights[w.Address]; !ok || weight != w.WeightBps {
+			return false
+		}
+	}
+	return true
+}
+
+// deliverTx signs the message with the simulation account and delivers it
+// with random fees.
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg legacytx.LegacyMsg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
@@ -0,0 +1,51 @@
+package simulation
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-money/core/v2/x/feeshare/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params" //nolint:gosec
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs() []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams,
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a random MsgUpdateParams
+func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, _ []simtypes.Account) sdk.Msg {
+	// use the default gov module account address as authority
+	var authority sdk.AccAddress = address.Module("gov")
+
+	params := types.DefaultParams()
+	params.EnableFeeShare = GenEnableFeeShare(r)
+	params.DeveloperShares = GenDeveloperShares(r)
+	params.DistributionMode = GenDistributionMode(r)
+	params.PayoutMode = GenPayoutMode(r)
+	params.DisallowedDenomPolicy = GenDisallowedDenomPolicy(r)
+	params.MaxPayoutRecipients = GenMaxPayoutRecipients(r)
+	params.PayoutRemainderPolicy = GenPayoutRemainderPolicy(r)
+	params.PayoutEpochBlocks = GenPayoutEpochBlocks(r)
+
+	return &types.MsgUpdateParams{
+		Authority: authority.String(),
+		Params:    params,
+	}
+}
@@ -24,10 +24,15 @@ var (
 
 const (
 	// Amino names
-	cancelFeeShareName   = "juno/MsgCancelFeeShare"
-	registerFeeShareName = "juno/MsgRegisterFeeShare"
-	updateFeeShareName   = "juno/MsgUpdateFeeShare"
-	updateFeeShareParams = "juno/MsgUpdateParams"
+	cancelFeeShareName    = "juno/MsgCancelFeeShare"
+	registerFeeShareName  = "juno/MsgRegisterFeeShare"
+	updateFeeShareName    = "juno/MsgUpdateFeeShare"
+	updateFeeShareParams  = "juno/MsgUpdateParams"
+	withdrawRewardsName   = "juno/MsgWithdrawFeeShareRewards"
+	setShareOverrideName  = "juno/MsgSetContractShareOverride"
+	setBlocklistEntryName = "juno/MsgSetBlocklistEntry"
+	proposeOwnerName      = "juno/MsgProposeFeeShareOwner"
+	acceptOwnerName       = "juno/MsgAcceptFeeShareOwner"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -50,6 +55,11 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgCancelFeeShare{},
 		&MsgUpdateFeeShare{},
 		&MsgUpdateParams{},
+		&MsgWithdrawFeeShareRewards{},
+		&MsgSetContractShareOverride{},
+		&MsgSetBlocklistEntry{},
+		&MsgProposeFeeShareOwner{},
+		&MsgAcceptFeeShareOwner{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -63,4 +73,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterFeeShare{}, registerFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgUpdateFeeShare{}, updateFeeShareName, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateFeeShareParams, nil)
+	cdc.RegisterConcrete(&MsgWithdrawFeeShareRewards{}, withdrawRewardsName, nil)
+	cdc.RegisterConcrete(&MsgSetContractShareOverride{}, setShareOverrideName, nil)
+	cdc.RegisterConcrete(&MsgSetBlocklistEntry{}, setBlocklistEntryName, nil)
+	cdc.RegisterConcrete(&MsgProposeFeeShareOwner{}, proposeOwnerName, nil)
+	cdc.RegisterConcrete(&MsgAcceptFeeShareOwner{}, acceptOwnerName, nil)
 }
@@ -23,11 +23,16 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(4, len(impls))
+	suite.Require().Equal(9, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/juno.feeshare.v1.MsgRegisterFeeShare",
 		"/juno.feeshare.v1.MsgCancelFeeShare",
 		"/juno.feeshare.v1.MsgUpdateFeeShare",
+		"/juno.feeshare.v1.MsgWithdrawFeeShareRewards",
 		"/juno.feeshare.v1.MsgUpdateParams",
+		"/juno.feeshare.v1.MsgSetContractShareOverride",
+		"/juno.feeshare.v1.MsgSetBlocklistEntry",
+		"/juno.feeshare.v1.MsgProposeFeeShareOwner",
+		"/juno.feeshare.v1.MsgAcceptFeeShareOwner",
 	}, impls)
 }
@@ -11,4 +11,9 @@ var (
 	ErrFeeShareContractNotRegistered = errorsmod.Register(ModuleName, 4, "no feeshare registered for contract")
 	ErrFeeSharePayment               = errorsmod.Register(ModuleName, 5, "feeshare payment error")
 	ErrFeeShareInvalidWithdrawer     = errorsmod.Register(ModuleName, 6, "invalid withdrawer address")
+	ErrFeeShareNoPendingRewards      = errorsmod.Register(ModuleName, 7, "no pending rewards to withdraw")
+	ErrFeeShareInvalidShareOverride  = errorsmod.Register(ModuleName, 8, "invalid share override")
+	ErrFeeShareContractBlocked       = errorsmod.Register(ModuleName, 9, "contract is blocked from receiving fee shares")
+	ErrFeeShareInvalidBlocklistEntry = errorsmod.Register(ModuleName, 10, "invalid blocklist entry")
+	ErrFeeShareNoPendingOwner        = errorsmod.Register(ModuleName, 11, "no pending owner for feeshare")
 )
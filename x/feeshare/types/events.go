@@ -5,9 +5,22 @@ const (
 	EventTypeCancelFeeShare   = "cancel_feeshare"
 	EventTypeUpdateFeeShare   = "update_feeshare"
 
+	EventTypeProposeFeeShareOwner = "propose_feeshare_owner"
+	EventTypeAcceptFeeShareOwner  = "accept_feeshare_owner"
+
+	EventTypeWithdrawFeeShareRewards = "withdraw_feeshare_rewards"
+
 	EventTypePayoutFeeShare = "payout_feeshare"
 
+	EventTypeSetContractShareOverride = "set_contract_share_override"
+	EventTypeSetBlocklistEntry        = "set_blocklist_entry"
+
 	AttributeKeyContract          = "contract"
 	AttributeKeyWithdrawerAddress = "withdrawer_address"
 	AttributeWithdrawPayouts      = "payouts"
+	AttributeKeyCodeID            = "code_id"
+	AttributeKeyDeveloperShares   = "developer_shares"
+	AttributeKeyRemove            = "remove"
+	AttributeKeyOwner             = "owner"
+	AttributeKeyPreviousOwner     = "previous_owner"
 )
@@ -80,31 +80,307 @@ func (m *FeePayoutEvent) GetFeesPaid() []types.Coin {
 	return nil
 }
 
+// FeeAccrualEvent is emitted when the developer shares of a transaction are
+// credited to the pending rewards of a withdrawer instead of being paid out.
+type FeeAccrualEvent struct {
+	// Address of the account the fees were accrued for
+	WithdrawAddress string `protobuf:"bytes,1,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+	// Amount of the fees accrued
+	FeesAccrued []types.Coin `protobuf:"bytes,2,rep,name=fees_accrued,json=feesAccrued,proto3" json:"fees_accrued"`
+}
+
+func (m *FeeAccrualEvent) Reset()         { *m = FeeAccrualEvent{} }
+func (m *FeeAccrualEvent) String() string { return proto.CompactTextString(m) }
+func (*FeeAccrualEvent) ProtoMessage()    {}
+func (*FeeAccrualEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19637fb89a9eac93, []int{1}
+}
+func (m *FeeAccrualEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeAccrualEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeAccrualEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeAccrualEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeAccrualEvent.Merge(m, src)
+}
+func (m *FeeAccrualEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeAccrualEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeAccrualEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeAccrualEvent proto.InternalMessageInfo
+
+func (m *FeeAccrualEvent) GetWithdrawAddress() string {
+	if m != nil {
+		return m.WithdrawAddress
+	}
+	return ""
+}
+
+func (m *FeeAccrualEvent) GetFeesAccrued() []types.Coin {
+	if m != nil {
+		return m.FeesAccrued
+	}
+	return nil
+}
+
+// FeeCommunityPoolEvent is emitted when the developer shares of the fees paid
+// in disallowed denoms are sent to the community pool.
+type FeeCommunityPoolEvent struct {
+	// Amount of the fees sent to the community pool
+	Fees []types.Coin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *FeeCommunityPoolEvent) Reset()         { *m = FeeCommunityPoolEvent{} }
+func (m *FeeCommunityPoolEvent) String() string { return proto.CompactTextString(m) }
+func (*FeeCommunityPoolEvent) ProtoMessage()    {}
+func (*FeeCommunityPoolEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19637fb89a9eac93, []int{2}
+}
+func (m *FeeCommunityPoolEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCommunityPoolEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCommunityPoolEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCommunityPoolEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCommunityPoolEvent.Merge(m, src)
+}
+func (m *FeeCommunityPoolEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCommunityPoolEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCommunityPoolEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCommunityPoolEvent proto.InternalMessageInfo
+
+func (m *FeeCommunityPoolEvent) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// FeeBurnEvent is emitted when the developer shares of the fees paid
+// in disallowed denoms are burned.
+type FeeBurnEvent struct {
+	// Amount of the fees burned
+	Fees []types.Coin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *FeeBurnEvent) Reset()         { *m = FeeBurnEvent{} }
+func (m *FeeBurnEvent) String() string { return proto.CompactTextString(m) }
+func (*FeeBurnEvent) ProtoMessage()    {}
+func (*FeeBurnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19637fb89a9eac93, []int{3}
+}
+func (m *FeeBurnEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBurnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBurnEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBurnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBurnEvent.Merge(m, src)
+}
+func (m *FeeBurnEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBurnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBurnEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBurnEvent proto.InternalMessageInfo
+
+func (m *FeeBurnEvent) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// FeeRetainedEvent is emitted when the developer shares of the fees paid
+// in disallowed denoms are left in the fee collector.
+type FeeRetainedEvent struct {
+	// Amount of the fees left in the fee collector
+	Fees []types.Coin `protobuf:"bytes,1,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *FeeRetainedEvent) Reset()         { *m = FeeRetainedEvent{} }
+func (m *FeeRetainedEvent) String() string { return proto.CompactTextString(m) }
+func (*FeeRetainedEvent) ProtoMessage()    {}
+func (*FeeRetainedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19637fb89a9eac93, []int{4}
+}
+func (m *FeeRetainedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRetainedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRetainedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRetainedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRetainedEvent.Merge(m, src)
+}
+func (m *FeeRetainedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRetainedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRetainedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRetainedEvent proto.InternalMessageInfo
+
+func (m *FeeRetainedEvent) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// FeePayoutBatch is emitted once per payout epoch when the escrowed
+// developer shares are sent to the withdrawers.
+type FeePayoutBatch struct {
+	// Height of the block the payouts were sent at
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Payouts sent to each withdrawer
+	Payouts []FeePayoutEvent `protobuf:"bytes,2,rep,name=payouts,proto3" json:"payouts"`
+	// Total amount of fees sent to the withdrawers
+	TotalFees []types.Coin `protobuf:"bytes,3,rep,name=total_fees,json=totalFees,proto3" json:"total_fees"`
+}
+
+func (m *FeePayoutBatch) Reset()         { *m = FeePayoutBatch{} }
+func (m *FeePayoutBatch) String() string { return proto.CompactTextString(m) }
+func (*FeePayoutBatch) ProtoMessage()    {}
+func (*FeePayoutBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19637fb89a9eac93, []int{5}
+}
+func (m *FeePayoutBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeePayoutBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeePayoutBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeePayoutBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeePayoutBatch.Merge(m, src)
+}
+func (m *FeePayoutBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeePayoutBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeePayoutBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeePayoutBatch proto.InternalMessageInfo
+
+func (m *FeePayoutBatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *FeePayoutBatch) GetPayouts() []FeePayoutEvent {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+func (m *FeePayoutBatch) GetTotalFees() []types.Coin {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*FeePayoutEvent)(nil), "juno.feeshare.v1.FeePayoutEvent")
+	proto.RegisterType((*FeeAccrualEvent)(nil), "juno.feeshare.v1.FeeAccrualEvent")
+	proto.RegisterType((*FeeCommunityPoolEvent)(nil), "juno.feeshare.v1.FeeCommunityPoolEvent")
+	proto.RegisterType((*FeeBurnEvent)(nil), "juno.feeshare.v1.FeeBurnEvent")
+	proto.RegisterType((*FeeRetainedEvent)(nil), "juno.feeshare.v1.FeeRetainedEvent")
+	proto.RegisterType((*FeePayoutBatch)(nil), "juno.feeshare.v1.FeePayoutBatch")
 }
 
 func init() { proto.RegisterFile("juno/feeshare/v1/events.proto", fileDescriptor_19637fb89a9eac93) }
 
 var fileDescriptor_19637fb89a9eac93 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
-	0x18, 0x84, 0x13, 0x40, 0x88, 0x06, 0x09, 0xaa, 0x88, 0xa1, 0x54, 0xc2, 0x54, 0x4c, 0x65, 0xb1,
-	0x09, 0xac, 0x2c, 0x34, 0x82, 0x81, 0xa9, 0xea, 0xc8, 0x52, 0x39, 0xf1, 0x4f, 0x62, 0xa4, 0xfa,
-	0x8f, 0x6c, 0x27, 0x25, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x22, 0xc8, 0x09,
-	0x55, 0xb7, 0xd3, 0x9d, 0xcf, 0xfe, 0x7c, 0xc1, 0xd5, 0x47, 0xa9, 0x90, 0xbd, 0x03, 0x98, 0x9c,
-	0x6b, 0x60, 0x55, 0xc4, 0xa0, 0x02, 0x65, 0x0d, 0x2d, 0x34, 0x5a, 0x0c, 0x87, 0x2e, 0xa6, 0xbb,
-	0x98, 0x56, 0xd1, 0x98, 0xa4, 0x68, 0x56, 0x68, 0x58, 0xc2, 0x8d, 0x3b, 0x9e, 0x80, 0xe5, 0x11,
-	0x4b, 0x51, 0xaa, 0xbe, 0x31, 0xbe, 0xc8, 0x30, 0xc3, 0x4e, 0x32, 0xa7, 0x7a, 0xf7, 0xa6, 0x0e,
-	0xce, 0x5e, 0x00, 0xe6, 0xbc, 0xc6, 0xd2, 0x3e, 0xbb, 0x07, 0xc2, 0xdb, 0x60, 0xb8, 0x96, 0x36,
-	0x17, 0x9a, 0xaf, 0x97, 0x5c, 0x08, 0x0d, 0xc6, 0x8c, 0xfc, 0x89, 0x3f, 0x1d, 0x2c, 0xce, 0x77,
-	0xfe, 0x53, 0x6f, 0x87, 0x8f, 0xc1, 0xc0, 0x11, 0x2c, 0x0b, 0x2e, 0xc5, 0xe8, 0x60, 0x72, 0x38,
-	0x3d, 0xbd, 0xbf, 0xa4, 0x3d, 0x06, 0x75, 0x18, 0xf4, 0x1f, 0x83, 0xc6, 0x28, 0xd5, 0xec, 0x68,
-	0xf3, 0x73, 0xed, 0x2d, 0x4e, 0x5c, 0x63, 0xce, 0xa5, 0x98, 0xbd, 0x6e, 0x1a, 0xe2, 0x6f, 0x1b,
-	0xe2, 0xff, 0x36, 0xc4, 0xff, 0x6a, 0x89, 0xb7, 0x6d, 0x89, 0xf7, 0xdd, 0x12, 0xef, 0xed, 0x2e,
-	0x93, 0x36, 0x2f, 0x13, 0x9a, 0xe2, 0x8a, 0xc5, 0xdd, 0x75, 0x31, 0x2a, 0xab, 0x79, 0x6a, 0x0d,
-	0xeb, 0x66, 0xf9, 0xdc, 0x0f, 0x63, 0xeb, 0x02, 0x4c, 0x72, 0xdc, 0xfd, 0xe6, 0xe1, 0x2f, 0x00,
-	0x00, 0xff, 0xff, 0xc2, 0xe4, 0xe9, 0x71, 0x36, 0x01, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbd, 0x8e, 0xd3, 0x40,
+	0x10, 0xce, 0x92, 0xd3, 0x41, 0xf6, 0x4e, 0x5c, 0x64, 0x01, 0x0a, 0x27, 0x61, 0xa2, 0x54, 0x47,
+	0xb3, 0x4b, 0xb8, 0x16, 0x21, 0x62, 0x0b, 0x23, 0x21, 0x8a, 0xc8, 0x25, 0x4d, 0xb4, 0xb6, 0x07,
+	0x7b, 0xd1, 0x79, 0xc7, 0xf2, 0xae, 0x73, 0xb8, 0xe3, 0x11, 0x78, 0x0e, 0x9e, 0xe4, 0xca, 0x2b,
+	0xa9, 0x10, 0x4a, 0x5e, 0x04, 0xed, 0xda, 0x11, 0x3f, 0x55, 0x50, 0xba, 0xf5, 0xcc, 0xf7, 0xa7,
+	0xf1, 0x0c, 0x7d, 0xf2, 0xa9, 0x51, 0xc8, 0x3f, 0x02, 0xe8, 0x42, 0xd4, 0xc0, 0xd7, 0x73, 0x0e,
+	0x6b, 0x50, 0x46, 0xb3, 0xaa, 0x46, 0x83, 0xde, 0xd8, 0xb6, 0xd9, 0xae, 0xcd, 0xd6, 0xf3, 0x73,
+	0x3f, 0x45, 0x5d, 0xa2, 0xe6, 0x89, 0xd0, 0x16, 0x9e, 0x80, 0x11, 0x73, 0x9e, 0xa2, 0x54, 0x1d,
+	0xe3, 0xfc, 0x41, 0x8e, 0x39, 0xba, 0x27, 0xb7, 0xaf, 0xae, 0x3a, 0x6b, 0xe9, 0xfd, 0x08, 0x60,
+	0x29, 0x5a, 0x6c, 0xcc, 0x1b, 0x6b, 0xe0, 0x3d, 0xa3, 0xe3, 0x6b, 0x69, 0x8a, 0xac, 0x16, 0xd7,
+	0x2b, 0x91, 0x65, 0x35, 0x68, 0x3d, 0x21, 0x53, 0x72, 0x31, 0x8a, 0xcf, 0x76, 0xf5, 0x45, 0x57,
+	0xf6, 0x5e, 0xd2, 0x91, 0x4d, 0xb0, 0xaa, 0x84, 0xcc, 0x26, 0x77, 0xa6, 0xc3, 0x8b, 0x93, 0x17,
+	0x8f, 0x59, 0x17, 0x83, 0xd9, 0x18, 0xac, 0x8f, 0xc1, 0x42, 0x94, 0x2a, 0x38, 0xba, 0xf9, 0xf1,
+	0x74, 0x10, 0xdf, 0xb3, 0x8c, 0xa5, 0x90, 0xd9, 0xec, 0x0b, 0xa1, 0x67, 0x11, 0xc0, 0x22, 0x4d,
+	0xeb, 0x46, 0x5c, 0xfd, 0xb7, 0x79, 0x40, 0x4f, 0x9d, 0xb9, 0xb0, 0x7c, 0xd8, 0xdb, 0xff, 0xc4,
+	0x92, 0x16, 0x1d, 0x67, 0xf6, 0x9e, 0x3e, 0x8c, 0x00, 0x42, 0x2c, 0xcb, 0x46, 0x49, 0xd3, 0x2e,
+	0x11, 0xfb, 0x1c, 0x97, 0xf4, 0xc8, 0xe2, 0x26, 0x64, 0x3f, 0x51, 0x07, 0x9e, 0x85, 0xf4, 0x34,
+	0x02, 0x08, 0x9a, 0x5a, 0x1d, 0x20, 0xf2, 0x96, 0x8e, 0x23, 0x80, 0x18, 0x8c, 0x90, 0x0a, 0xb2,
+	0x03, 0x84, 0xbe, 0x91, 0x3f, 0x7e, 0x6d, 0x20, 0x4c, 0x5a, 0x78, 0x8f, 0xe8, 0x71, 0x01, 0x32,
+	0x2f, 0x8c, 0x9b, 0xe9, 0x30, 0xee, 0xbf, 0xbc, 0xd7, 0xf4, 0x6e, 0xe5, 0x60, 0xba, 0x9f, 0xe2,
+	0x94, 0xfd, 0xbb, 0x5e, 0xec, 0xef, 0x2d, 0xe9, 0x9d, 0x76, 0x34, 0xef, 0x15, 0xa5, 0x06, 0x8d,
+	0xb8, 0x5a, 0xb9, 0x9c, 0xc3, 0xfd, 0x72, 0x8e, 0x1c, 0x25, 0x02, 0xd0, 0xc1, 0xbb, 0x9b, 0x8d,
+	0x4f, 0x6e, 0x37, 0x3e, 0xf9, 0xb9, 0xf1, 0xc9, 0xd7, 0xad, 0x3f, 0xb8, 0xdd, 0xfa, 0x83, 0xef,
+	0x5b, 0x7f, 0xf0, 0xe1, 0x79, 0x2e, 0x4d, 0xd1, 0x24, 0x2c, 0xc5, 0x92, 0x87, 0x4e, 0x2f, 0x44,
+	0x65, 0x6a, 0x91, 0x1a, 0xcd, 0xdd, 0x89, 0x7c, 0xfe, 0x7d, 0x24, 0xa6, 0xad, 0x40, 0x27, 0xc7,
+	0x6e, 0xb3, 0x2f, 0x7f, 0x0d, 0x00, 0x5c, 0xe6, 0x7b, 0x66, 0x42, 0x03, 0x00, 0x00,
 }
 
 func (m *FeePayoutEvent) Marshal() (dAtA []byte, err error) {
@@ -114,80 +390,863 @@ func (m *FeePayoutEvent) Marshal() (dAtA []byte, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayoutEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayoutEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesPaid) > 0 {
+		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeAccrualEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeAccrualEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeAccrualEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeesAccrued) > 0 {
+		for iNdEx := len(m.FeesAccrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeesAccrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCommunityPoolEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCommunityPoolEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCommunityPoolEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeBurnEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBurnEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBurnEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRetainedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRetainedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRetainedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeePayoutBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeePayoutBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeePayoutBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeePayoutEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FeesPaid) > 0 {
+		for _, e := range m.FeesPaid {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeAccrualEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.FeesAccrued) > 0 {
+		for _, e := range m.FeesAccrued {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeCommunityPoolEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeBurnEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRetainedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeePayoutBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvents(uint64(m.Height))
+	}
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeePayoutEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeePayoutEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeePayoutEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesPaid = append(m.FeesPaid, types.Coin{})
+			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeAccrualEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeAccrualEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeAccrualEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeesAccrued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeesAccrued = append(m.FeesAccrued, types.Coin{})
+			if err := m.FeesAccrued[len(m.FeesAccrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCommunityPoolEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCommunityPoolEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCommunityPoolEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *FeePayoutEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-
-func (m *FeePayoutEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeesPaid) > 0 {
-		for iNdEx := len(m.FeesPaid) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeesPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+func (m *FeeBurnEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBurnEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBurnEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if len(m.WithdrawAddress) > 0 {
-		i -= len(m.WithdrawAddress)
-		copy(dAtA[i:], m.WithdrawAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *FeePayoutEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.WithdrawAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.FeesPaid) > 0 {
-		for _, e := range m.FeesPaid {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+func (m *FeeRetainedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRetainedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRetainedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *FeePayoutEvent) Unmarshal(dAtA []byte) error {
+func (m *FeePayoutBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -210,17 +1269,36 @@ func (m *FeePayoutEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeePayoutEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeePayoutBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeePayoutEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeePayoutBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -230,27 +1308,29 @@ func (m *FeePayoutEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			m.Payouts = append(m.Payouts, FeePayoutEvent{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeesPaid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -277,8 +1357,8 @@ func (m *FeePayoutEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeesPaid = append(m.FeesPaid, types.Coin{})
-			if err := m.FeesPaid[len(m.FeesPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11,6 +11,7 @@ import (
 type AccountKeeper interface {
 	GetModuleAddress(moduleName string) sdk.AccAddress
 	GetModuleAccount(ctx sdk.Context, name string) acctypes.ModuleAccountI
+	SetModuleAccount(ctx sdk.Context, macc acctypes.ModuleAccountI)
 
 	HasAccount(ctx sdk.Context, addr sdk.AccAddress) bool
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) (account acctypes.AccountI)
@@ -23,9 +24,11 @@ type BankKeeper interface {
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 }
 
 // WasmKeeper defines the expected interface needed to retrieve cosmwasm contracts.
 type WasmKeeper interface {
-	GetContractInfo(ctx sdk.Context, contractAddr sdk.AccAddress) (wasmtypes.ContractInfo, error)
+	GetContractInfo(ctx sdk.Context, contractAddr sdk.AccAddress) *wasmtypes.ContractInfo
+	IterateContractInfo(ctx sdk.Context, cb func(sdk.AccAddress, wasmtypes.ContractInfo) bool)
 }
@@ -7,15 +7,50 @@ import (
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
+const (
+	// BasisPointsTotal is the sum of the weights of
+	// all withdrawers registered for a contract.
+	BasisPointsTotal = 10000
+	// MaxWithdrawers is the maximum number of withdrawers
+	// that can be registered for a single contract.
+	MaxWithdrawers = 10
+)
+
 // NewFeeShare returns an instance of FeeShare.
-func NewFeeShare(contract sdk.Address, deployer, withdrawer sdk.AccAddress) FeeShare {
+func NewFeeShare(contract sdk.Address, deployer sdk.AccAddress, withdrawers []Withdrawer) FeeShare {
 	return FeeShare{
-		ContractAddress:   contract.String(),
-		DeployerAddress:   deployer.String(),
-		WithdrawerAddress: withdrawer.String(),
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		Withdrawers:     withdrawers,
+	}
+}
+
+// NewWithdrawer returns an instance of Withdrawer.
+func NewWithdrawer(withdrawer sdk.AccAddress, weightBps uint32) Withdrawer {
+	return Withdrawer{
+		Address:   withdrawer.String(),
+		WeightBps: weightBps,
 	}
 }
 
+// GetWeightedWithdrawers returns the withdrawers of the FeeShare. Records
+// stored with the legacy withdrawer address are returned as a single
+// withdrawer receiving all the fees.
+func (fs FeeShare) GetWeightedWithdrawers() []Withdrawer {
+	return weightedWithdrawers(fs.WithdrawerAddress, fs.Withdrawers)
+}
+
+// GetWithdrawerAddrs returns the account addresses of all withdrawers.
+func (fs FeeShare) GetWithdrawerAddrs() []sdk.AccAddress {
+	var withdrawers []sdk.AccAddress
+	for _, w := range fs.GetWeightedWithdrawers() {
+		if addr := w.GetAddr(); addr != nil {
+			withdrawers = append(withdrawers, addr)
+		}
+	}
+	return withdrawers
+}
+
 // GetContractAddr returns the contract address
 func (fs FeeShare) GetContractAddr() sdk.Address {
 	contract, err := sdk.AccAddressFromBech32(fs.ContractAddress)
@@ -34,8 +69,10 @@ func (fs FeeShare) GetDeployerAddr() sdk.AccAddress {
 	return contract
 }
 
-// GetWithdrawerAddr returns the account address to where the funds proceeding
-// from the fees will be received.
+// GetWithdrawerAddr returns the legacy account address to where the funds
+// proceeding from the fees will be received.
+//
+// Deprecated: use GetWithdrawerAddrs to get all the withdrawers.
 func (fs FeeShare) GetWithdrawerAddr() sdk.AccAddress {
 	contract, err := sdk.AccAddressFromBech32(fs.WithdrawerAddress)
 	if err != nil {
@@ -54,13 +91,191 @@ func (fs FeeShare) Validate() error {
 		return err
 	}
 
-	if fs.WithdrawerAddress == "" {
+	if fs.WithdrawerAddress != "" && len(fs.Withdrawers) != 0 {
+		return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawer address and withdrawers cannot be set at the same time")
+	}
+
+	if fs.WithdrawerAddress == "" && len(fs.Withdrawers) == 0 {
 		return errorsmod.Wrap(sdkerror.ErrInvalidAddress, "withdrawer address cannot be empty")
 	}
 
-	if _, err := sdk.AccAddressFromBech32(fs.WithdrawerAddress); err != nil {
+	return ValidateWithdrawers(fs.GetWeightedWithdrawers())
+}
+
+// GetAddr returns the account address of the withdrawer.
+func (w Withdrawer) GetAddr() sdk.AccAddress {
+	withdrawer, err := sdk.AccAddressFromBech32(w.Address)
+	if err != nil {
+		return nil
+	}
+	return withdrawer
+}
+
+// ValidateWithdrawers performs a stateless validation of a list of withdrawers
+// ensuring the addresses are valid and unique, and that the weights add up
+// to BasisPointsTotal.
+func ValidateWithdrawers(withdrawers []Withdrawer) error {
+	if len(withdrawers) == 0 {
+		return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawers cannot be empty")
+	}
+
+	if len(withdrawers) > MaxWithdrawers {
+		return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "too many withdrawers %d, maximum is %d", len(withdrawers), MaxWithdrawers)
+	}
+
+	var totalWeight uint32
+	seen := make(map[string]bool)
+	for _, w := range withdrawers {
+		if _, err := sdk.AccAddressFromBech32(w.Address); err != nil {
+			return errorsmod.Wrapf(err, "invalid withdraw address %s", w.Address)
+		}
+
+		if seen[w.Address] {
+			return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "duplicated withdrawer %s", w.Address)
+		}
+		seen[w.Address] = true
+
+		if w.WeightBps == 0 || w.WeightBps > BasisPointsTotal {
+			return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "invalid weight %d for withdrawer %s", w.WeightBps, w.Address)
+		}
+		totalWeight += w.WeightBps
+	}
+
+	if totalWeight != BasisPointsTotal {
+		return errorsmod.Wrapf(ErrFeeShareInvalidWithdrawer, "withdrawers weights must add up to %d, got %d", BasisPointsTotal, totalWeight)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of the PendingRewards
+func (pr PendingRewards) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(pr.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	return pr.Rewards.Validate()
+}
+
+// Validate performs a stateless validation of an EscrowedPayout
+func (ep EscrowedPayout) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(ep.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", ep.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(ep.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdrawer address %s", ep.WithdrawerAddress)
+	}
+
+	if !ep.Fees.IsValid() {
+		return errorsmod.Wrapf(sdkerror.ErrInvalidCoins, "invalid escrowed fees %s", ep.Fees)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a PendingOwner
+func (po PendingOwner) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(po.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", po.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(po.OwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid owner address %s", po.OwnerAddress)
+	}
+
+	return nil
+}
+
+// Validate performs a stateless validation of a ContractRevenue
+func (cr ContractRevenue) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(cr.ContractAddress); err != nil {
+		return err
+	}
+
+	return cr.Revenue.Validate()
+}
+
+// Validate performs a stateless validation of a WithdrawerRevenue
+func (wr WithdrawerRevenue) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(wr.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	return wr.Revenue.Validate()
+}
+
+// Validate performs a stateless validation of a BlockRevenue
+func (br BlockRevenue) Validate() error {
+	if br.Height <= 0 {
+		return errorsmod.Wrapf(sdkerror.ErrInvalidHeight, "invalid block revenue height %d", br.Height)
+	}
+
+	return br.Revenue.Validate()
+}
+
+// NewContractShareOverride returns an override of the developer shares of a contract
+func NewContractShareOverride(contract sdk.Address, shares sdk.Dec) ShareOverride {
+	return ShareOverride{
+		ContractAddress: contract.String(),
+		DeveloperShares: shares,
+	}
+}
+
+// NewCodeShareOverride returns an override of the developer shares of a code id
+func NewCodeShareOverride(codeID uint64, shares sdk.Dec) ShareOverride {
+	return ShareOverride{
+		CodeId:          codeID,
+		DeveloperShares: shares,
+	}
+}
+
+// Validate performs a stateless validation of a ShareOverride
+func (so ShareOverride) Validate() error {
+	if err := validateTarget(so.ContractAddress, so.CodeId, ErrFeeShareInvalidShareOverride); err != nil {
 		return err
 	}
 
+	if err := validateShares(so.DeveloperShares); err != nil {
+		return errorsmod.Wrap(ErrFeeShareInvalidShareOverride, err.Error())
+	}
+
+	return nil
+}
+
+// NewContractBlocklistEntry returns a blocklist entry for a contract
+func NewContractBlocklistEntry(contract sdk.Address) BlocklistEntry {
+	return BlocklistEntry{
+		ContractAddress: contract.String(),
+	}
+}
+
+// NewCodeBlocklistEntry returns a blocklist entry for a code id
+func NewCodeBlocklistEntry(codeID uint64) BlocklistEntry {
+	return BlocklistEntry{
+		CodeId: codeID,
+	}
+}
+
+// Validate performs a stateless validation of a BlocklistEntry
+func (e BlocklistEntry) Validate() error {
+	return validateTarget(e.ContractAddress, e.CodeId, ErrFeeShareInvalidBlocklistEntry)
+}
+
+// validateTarget checks that a share override or a blocklist entry
+// applies either to a valid contract address or to a code id,
+// wrapping the errors of the invalid combinations with errType.
+func validateTarget(contractAddress string, codeID uint64, errType *errorsmod.Error) error {
+	switch {
+	case contractAddress != "" && codeID != 0:
+		return errorsmod.Wrap(errType, "contract address and code id cannot be set at the same time")
+	case contractAddress != "":
+		if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+			return errorsmod.Wrapf(err, "invalid contract address %s", contractAddress)
+		}
+	case codeID == 0:
+		return errorsmod.Wrap(errType, "contract address or code id must be set")
+	}
+
 	return nil
 }
@@ -58,7 +58,7 @@ func (suite *FeeShareTestSuite) TestFeeNew() {
 	}
 
 	for _, tc := range testCases {
-		i := NewFeeShare(tc.contract, tc.deployer, tc.withdraw)
+		i := NewFeeShare(tc.contract, tc.deployer, []Withdrawer{NewWithdrawer(tc.withdraw, BasisPointsTotal)})
 		err := i.Validate()
 
 		if tc.expectPass {
@@ -78,36 +78,78 @@ func (suite *FeeShareTestSuite) TestFee() {
 		{
 			"Create feeshare- pass",
 			FeeShare{
-				suite.contract.String(),
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			true,
 		},
 		{
 			"Create feeshare- invalid contract address (invalid length 2)",
 			FeeShare{
-				"juno15u3dt79t6sxxa3x3kpkhzsy56edaa5a66kxmukqjz2sx0hes5sn38g",
-				suite.address1.String(),
-				suite.address2.String(),
+				ContractAddress:   "juno15u3dt79t6sxxa3x3kpkhzsy56edaa5a66kxmukqjz2sx0hes5sn38g",
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create feeshare- invalid deployer address",
 			FeeShare{
-				suite.contract.String(),
-				"juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
-				suite.address2.String(),
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   "juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+				WithdrawerAddress: suite.address2.String(),
 			},
 			false,
 		},
 		{
 			"Create feeshare- invalid withdraw address",
 			FeeShare{
-				suite.contract.String(),
-				suite.address1.String(),
-				"juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: "juno1hj5fveer5cjtn4wd6wstzugjfdxzl0xps73ftl",
+			},
+			false,
+		},
+		{
+			"Create feeshare- pass weighted withdrawers",
+			FeeShare{
+				ContractAddress: suite.contract.String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []Withdrawer{
+					NewWithdrawer(suite.address1, 2500),
+					NewWithdrawer(suite.address2, 7500),
+				},
+			},
+			true,
+		},
+		{
+			"Create feeshare- withdrawer address and withdrawers",
+			FeeShare{
+				ContractAddress:   suite.contract.String(),
+				DeployerAddress:   suite.address1.String(),
+				WithdrawerAddress: suite.address2.String(),
+				Withdrawers:       []Withdrawer{NewWithdrawer(suite.address1, BasisPointsTotal)},
+			},
+			false,
+		},
+		{
+			"Create feeshare- withdrawers weights don't add up",
+			FeeShare{
+				ContractAddress: suite.contract.String(),
+				DeployerAddress: suite.address1.String(),
+				Withdrawers: []Withdrawer{
+					NewWithdrawer(suite.address1, 2500),
+					NewWithdrawer(suite.address2, 2500),
+				},
+			},
+			false,
+		},
+		{
+			"Create feeshare- empty withdrawers",
+			FeeShare{
+				ContractAddress: suite.contract.String(),
+				DeployerAddress: suite.address1.String(),
 			},
 			false,
 		},
@@ -127,20 +169,113 @@ func (suite *FeeShareTestSuite) TestFee() {
 func (suite *FeeShareTestSuite) TestFeeShareGetters() {
 	contract := sdk.AccAddress([]byte("cosmos1contract"))
 	fs := FeeShare{
-		contract.String(),
-		suite.address1.String(),
-		suite.address2.String(),
+		ContractAddress:   contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: suite.address2.String(),
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(fs.GetWithdrawerAddr(), suite.address2)
 
 	fs = FeeShare{
-		contract.String(),
-		suite.address1.String(),
-		"",
+		ContractAddress:   contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: "",
 	}
 	suite.Equal(fs.GetContractAddr(), contract)
 	suite.Equal(fs.GetDeployerAddr(), suite.address1)
 	suite.Equal(len(fs.GetWithdrawerAddr()), 0)
 }
+
+func (suite *FeeShareTestSuite) TestFeeShareGetWeightedWithdrawers() {
+	fs := FeeShare{
+		ContractAddress:   suite.contract.String(),
+		DeployerAddress:   suite.address1.String(),
+		WithdrawerAddress: suite.address2.String(),
+	}
+	suite.Equal([]Withdrawer{NewWithdrawer(suite.address2, BasisPointsTotal)}, fs.GetWeightedWithdrawers())
+	suite.Equal([]sdk.AccAddress{suite.address2}, fs.GetWithdrawerAddrs())
+
+	fs = FeeShare{
+		ContractAddress: suite.contract.String(),
+		DeployerAddress: suite.address1.String(),
+		Withdrawers: []Withdrawer{
+			NewWithdrawer(suite.address1, 4000),
+			NewWithdrawer(suite.address2, 6000),
+		},
+	}
+	suite.Equal(fs.Withdrawers, fs.GetWeightedWithdrawers())
+	suite.Equal([]sdk.AccAddress{suite.address1, suite.address2}, fs.GetWithdrawerAddrs())
+
+	fs = FeeShare{
+		ContractAddress: suite.contract.String(),
+		DeployerAddress: suite.address1.String(),
+	}
+	suite.Empty(fs.GetWeightedWithdrawers())
+	suite.Empty(fs.GetWithdrawerAddrs())
+}
+
+func (suite *FeeShareTestSuite) TestValidateWithdrawers() {
+	tooMany := make([]Withdrawer, MaxWithdrawers+1)
+	for i := range tooMany {
+		tooMany[i] = NewWithdrawer(sdk.AccAddress([]byte{byte(i + 1)}), 1)
+	}
+
+	testCases := []struct {
+		msg         string
+		withdrawers []Withdrawer
+		expectPass  bool
+	}{
+		{
+			"pass - single withdrawer",
+			[]Withdrawer{NewWithdrawer(suite.address1, BasisPointsTotal)},
+			true,
+		},
+		{
+			"pass - weighted withdrawers",
+			[]Withdrawer{NewWithdrawer(suite.address1, 1), NewWithdrawer(suite.address2, 9999)},
+			true,
+		},
+		{
+			"withdrawers cannot be empty",
+			nil,
+			false,
+		},
+		{
+			"too many withdrawers",
+			tooMany,
+			false,
+		},
+		{
+			"invalid withdraw address",
+			[]Withdrawer{{Address: "withdraw", WeightBps: BasisPointsTotal}},
+			false,
+		},
+		{
+			"duplicated withdrawer",
+			[]Withdrawer{NewWithdrawer(suite.address1, 5000), NewWithdrawer(suite.address1, 5000)},
+			false,
+		},
+		{
+			"invalid weight",
+			[]Withdrawer{NewWithdrawer(suite.address1, 0), NewWithdrawer(suite.address2, BasisPointsTotal)},
+			false,
+		},
+		{
+			"withdrawers weights must add up to 10000",
+			[]Withdrawer{NewWithdrawer(suite.address1, 5000), NewWithdrawer(suite.address2, 4999)},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := ValidateWithdrawers(tc.withdrawers)
+
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
@@ -35,5 +35,114 @@ func (gs GenesisState) Validate() error {
 		seenContract[fs.ContractAddress] = true
 	}
 
+	seenWithdrawer := make(map[string]bool)
+	for _, pr := range gs.PendingRewards {
+		// only one pending balance per withdrawer
+		if seenWithdrawer[pr.WithdrawerAddress] {
+			return fmt.Errorf("withdrawer pending rewards duplicated on genesis '%s'", pr.WithdrawerAddress)
+		}
+
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawer[pr.WithdrawerAddress] = true
+	}
+
+	seenContractRevenue := make(map[string]bool)
+	for _, cr := range gs.ContractRevenues {
+		if seenContractRevenue[cr.ContractAddress] {
+			return fmt.Errorf("contract revenue duplicated on genesis '%s'", cr.ContractAddress)
+		}
+
+		if err := cr.Validate(); err != nil {
+			return err
+		}
+
+		seenContractRevenue[cr.ContractAddress] = true
+	}
+
+	seenWithdrawerRevenue := make(map[string]bool)
+	for _, wr := range gs.WithdrawerRevenues {
+		if seenWithdrawerRevenue[wr.WithdrawerAddress] {
+			return fmt.Errorf("withdrawer revenue duplicated on genesis '%s'", wr.WithdrawerAddress)
+		}
+
+		if err := wr.Validate(); err != nil {
+			return err
+		}
+
+		seenWithdrawerRevenue[wr.WithdrawerAddress] = true
+	}
+
+	seenHeight := make(map[int64]bool)
+	for _, br := range gs.BlockRevenues {
+		if seenHeight[br.Height] {
+			return fmt.Errorf("block revenue duplicated on genesis '%d'", br.Height)
+		}
+
+		if err := br.Validate(); err != nil {
+			return err
+		}
+
+		seenHeight[br.Height] = true
+	}
+
+	seenOverride := make(map[string]bool)
+	for _, so := range gs.ShareOverrides {
+		if err := so.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetKeyShareOverride(so))
+		if seenOverride[key] {
+			return fmt.Errorf("share override duplicated on genesis '%s'", so.String())
+		}
+		seenOverride[key] = true
+	}
+
+	seenBlocked := make(map[string]bool)
+	for _, entry := range gs.Blocklist {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+
+		key := string(GetKeyBlocklistEntry(entry))
+		if seenBlocked[key] {
+			return fmt.Errorf("blocklist entry duplicated on genesis '%s'", entry.String())
+		}
+		seenBlocked[key] = true
+	}
+
+	seenPendingOwner := make(map[string]bool)
+	for _, po := range gs.PendingOwners {
+		if seenPendingOwner[po.ContractAddress] {
+			return fmt.Errorf("pending owner duplicated on genesis '%s'", po.ContractAddress)
+		}
+
+		if !seenContract[po.ContractAddress] {
+			return fmt.Errorf("pending owner of an unregistered contract on genesis '%s'", po.ContractAddress)
+		}
+
+		if err := po.Validate(); err != nil {
+			return err
+		}
+
+		seenPendingOwner[po.ContractAddress] = true
+	}
+
+	seenEscrowedPayout := make(map[string]bool)
+	for _, ep := range gs.EscrowedPayouts {
+		if err := ep.Validate(); err != nil {
+			return err
+		}
+
+		key := ep.ContractAddress + "/" + ep.WithdrawerAddress
+		if seenEscrowedPayout[key] {
+			return fmt.Errorf("escrowed payout duplicated on genesis '%s'", key)
+		}
+		seenEscrowedPayout[key] = true
+	}
+
 	return gs.Params.Validate()
 }
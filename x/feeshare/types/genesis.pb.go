@@ -24,12 +24,173 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DistributionMode defines how the developer shares of a transaction
+// are split between the registered contracts executed in the transaction.
+type DistributionMode int32
+
+const (
+	// DISTRIBUTION_MODE_EQUAL splits the developer shares equally between
+	// all the registered contracts executed in the transaction.
+	DistributionModeEqual DistributionMode = 0
+	// DISTRIBUTION_MODE_GAS_WEIGHTED pays each registered contract the
+	// developer shares weighted by the gas it consumed out of the gas consumed
+	// by all the executed contracts. The shares of the unregistered contracts
+	// stay in the fee collector.
+	DistributionModeGasWeighted DistributionMode = 1
+)
+
+var DistributionMode_name = map[int32]string{
+	0: "DISTRIBUTION_MODE_EQUAL",
+	1: "DISTRIBUTION_MODE_GAS_WEIGHTED",
+}
+
+var DistributionMode_value = map[string]int32{
+	"DISTRIBUTION_MODE_EQUAL":        0,
+	"DISTRIBUTION_MODE_GAS_WEIGHTED": 1,
+}
+
+func (x DistributionMode) String() string {
+	return proto.EnumName(DistributionMode_name, int32(x))
+}
+
+func (DistributionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{0}
+}
+
+// PayoutMode defines how the developer shares are delivered
+// to the withdrawers of the registered contracts.
+type PayoutMode int32
+
+const (
+	// PAYOUT_MODE_DIRECT sends the developer shares to the withdrawers
+	// at the end of every transaction.
+	PayoutModeDirect PayoutMode = 0
+	// PAYOUT_MODE_ACCRUE credits the developer shares to a balance held in the
+	// feeshare module account that the withdrawers claim with
+	// MsgWithdrawFeeShareRewards.
+	PayoutModeAccrue PayoutMode = 1
+	// PAYOUT_MODE_EPOCH escrows the developer shares in the feeshare module
+	// account and sends them to the withdrawers at the end of every
+	// payout_epoch_blocks blocks.
+	PayoutModeEpoch PayoutMode = 2
+)
+
+var PayoutMode_name = map[int32]string{
+	0: "PAYOUT_MODE_DIRECT",
+	1: "PAYOUT_MODE_ACCRUE",
+	2: "PAYOUT_MODE_EPOCH",
+}
+
+var PayoutMode_value = map[string]int32{
+	"PAYOUT_MODE_DIRECT": 0,
+	"PAYOUT_MODE_ACCRUE": 1,
+	"PAYOUT_MODE_EPOCH":  2,
+}
+
+func (x PayoutMode) String() string {
+	return proto.EnumName(PayoutMode_name, int32(x))
+}
+
+func (PayoutMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{1}
+}
+
+// DisallowedDenomPolicy defines what happens with the developer shares of the
+// fees paid in denoms that are not allowed to be paid to the withdrawers.
+type DisallowedDenomPolicy int32
+
+const (
+	// DISALLOWED_DENOM_POLICY_COMMUNITY_POOL sends the developer shares to the
+	// community pool.
+	DisallowedDenomPolicyCommunityPool DisallowedDenomPolicy = 0
+	// DISALLOWED_DENOM_POLICY_BURN burns the developer shares.
+	DisallowedDenomPolicyBurn DisallowedDenomPolicy = 1
+	// DISALLOWED_DENOM_POLICY_FEE_COLLECTOR leaves the developer shares in the
+	// fee collector to be distributed with the rest of the fees.
+	DisallowedDenomPolicyFeeCollector DisallowedDenomPolicy = 2
+)
+
+var DisallowedDenomPolicy_name = map[int32]string{
+	0: "DISALLOWED_DENOM_POLICY_COMMUNITY_POOL",
+	1: "DISALLOWED_DENOM_POLICY_BURN",
+	2: "DISALLOWED_DENOM_POLICY_FEE_COLLECTOR",
+}
+
+var DisallowedDenomPolicy_value = map[string]int32{
+	"DISALLOWED_DENOM_POLICY_COMMUNITY_POOL": 0,
+	"DISALLOWED_DENOM_POLICY_BURN":           1,
+	"DISALLOWED_DENOM_POLICY_FEE_COLLECTOR":  2,
+}
+
+func (x DisallowedDenomPolicy) String() string {
+	return proto.EnumName(DisallowedDenomPolicy_name, int32(x))
+}
+
+func (DisallowedDenomPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{2}
+}
+
+// PayoutRemainderPolicy defines what happens with the fees owed to the
+// withdrawers that are not paid because a transaction exceeds the
+// max_payout_recipients param.
+type PayoutRemainderPolicy int32
+
+const (
+	// PAYOUT_REMAINDER_POLICY_TOP_RECIPIENTS splits the remainder between the
+	// paid withdrawers proportionally to their contribution.
+	PayoutRemainderPolicyTopRecipients PayoutRemainderPolicy = 0
+	// PAYOUT_REMAINDER_POLICY_COMMUNITY_POOL sends the remainder to the
+	// community pool.
+	PayoutRemainderPolicyCommunityPool PayoutRemainderPolicy = 1
+)
+
+var PayoutRemainderPolicy_name = map[int32]string{
+	0: "PAYOUT_REMAINDER_POLICY_TOP_RECIPIENTS",
+	1: "PAYOUT_REMAINDER_POLICY_COMMUNITY_POOL",
+}
+
+var PayoutRemainderPolicy_value = map[string]int32{
+	"PAYOUT_REMAINDER_POLICY_TOP_RECIPIENTS": 0,
+	"PAYOUT_REMAINDER_POLICY_COMMUNITY_POOL": 1,
+}
+
+func (x PayoutRemainderPolicy) String() string {
+	return proto.EnumName(PayoutRemainderPolicy_name, int32(x))
+}
+
+func (PayoutRemainderPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{3}
+}
+
 // GenesisState defines the module's genesis state.
 type GenesisState struct {
 	// params are the feeshare module parameters
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// FeeShare is a slice of active registered contracts for fee distribution
 	FeeShare []FeeShare `protobuf:"bytes,2,rep,name=fee_share,json=feeShare,proto3" json:"fee_share"`
+	// pending_rewards is a slice of the fees accrued by the withdrawers that
+	// have not been withdrawn yet
+	PendingRewards []PendingRewards `protobuf:"bytes,3,rep,name=pending_rewards,json=pendingRewards,proto3" json:"pending_rewards"`
+	// contract_revenues is a slice of the cumulative fees distributed for each
+	// registered contract
+	ContractRevenues []ContractRevenue `protobuf:"bytes,4,rep,name=contract_revenues,json=contractRevenues,proto3" json:"contract_revenues"`
+	// withdrawer_revenues is a slice of the cumulative fees distributed to each
+	// withdrawer
+	WithdrawerRevenues []WithdrawerRevenue `protobuf:"bytes,5,rep,name=withdrawer_revenues,json=withdrawerRevenues,proto3" json:"withdrawer_revenues"`
+	// block_revenues is a slice of the fees distributed in each block
+	BlockRevenues []BlockRevenue `protobuf:"bytes,6,rep,name=block_revenues,json=blockRevenues,proto3" json:"block_revenues"`
+	// share_overrides is a slice of the developer shares set by governance for
+	// contracts and code ids
+	ShareOverrides []ShareOverride `protobuf:"bytes,7,rep,name=share_overrides,json=shareOverrides,proto3" json:"share_overrides"`
+	// blocklist is a slice of the contracts and code ids blocked by governance
+	// from receiving fee shares
+	Blocklist []BlocklistEntry `protobuf:"bytes,8,rep,name=blocklist,proto3" json:"blocklist"`
+	// pending_owners is a slice of the ownership transfers that have not been
+	// accepted yet
+	PendingOwners []PendingOwner `protobuf:"bytes,9,rep,name=pending_owners,json=pendingOwners,proto3" json:"pending_owners"`
+	// escrowed_payouts is a slice of the developer shares held in the module
+	// account until the end of the current payout epoch
+	EscrowedPayouts []EscrowedPayout `protobuf:"bytes,10,rep,name=escrowed_payouts,json=escrowedPayouts,proto3" json:"escrowed_payouts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +240,62 @@ func (m *GenesisState) GetFeeShare() []FeeShare {
 	return nil
 }
 
+func (m *GenesisState) GetPendingRewards() []PendingRewards {
+	if m != nil {
+		return m.PendingRewards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractRevenues() []ContractRevenue {
+	if m != nil {
+		return m.ContractRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawerRevenues() []WithdrawerRevenue {
+	if m != nil {
+		return m.WithdrawerRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockRevenues() []BlockRevenue {
+	if m != nil {
+		return m.BlockRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetShareOverrides() []ShareOverride {
+	if m != nil {
+		return m.ShareOverrides
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlocklist() []BlocklistEntry {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwners() []PendingOwner {
+	if m != nil {
+		return m.PendingOwners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowedPayouts() []EscrowedPayout {
+	if m != nil {
+		return m.EscrowedPayouts
+	}
+	return nil
+}
+
 // Params defines the feeshare module params
 type Params struct {
 	// enable_feeshare defines a parameter to enable the feeshare module
@@ -87,10 +304,36 @@ type Params struct {
 	// distributed to the registered contract owner
 	DeveloperShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=developer_shares,json=developerShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_shares"`
 	// allowed_denoms defines the list of denoms that are allowed to be paid to
-	// the contract withdraw addresses. If said denom is not in the list, the fees
-	// will ONLY be sent to the community pool.
+	// the contract withdraw addresses. If said denom is not in the list, the
+	// developer shares of the fees are handled according to the
+	// disallowed_denom_policy and denom_policies params.
 	// If this list is empty, all denoms are allowed.
 	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// distribution_mode defines how the developer shares are split between
+	// the registered contracts that participated in a transaction.
+	DistributionMode DistributionMode `protobuf:"varint,4,opt,name=distribution_mode,json=distributionMode,proto3,enum=juno.feeshare.v1.DistributionMode" json:"distribution_mode,omitempty"`
+	// payout_mode defines how the developer shares are delivered to the
+	// withdrawers of the registered contracts.
+	PayoutMode PayoutMode `protobuf:"varint,5,opt,name=payout_mode,json=payoutMode,proto3,enum=juno.feeshare.v1.PayoutMode" json:"payout_mode,omitempty"`
+	// block_revenue_retention_blocks defines the number of most recent blocks
+	// whose distributed fees are kept. Zero means no block revenues are kept.
+	BlockRevenueRetentionBlocks uint64 `protobuf:"varint,6,opt,name=block_revenue_retention_blocks,json=blockRevenueRetentionBlocks,proto3" json:"block_revenue_retention_blocks,omitempty"`
+	// disallowed_denom_policy defines what happens with the developer shares of
+	// the fees paid in denoms that are not included in allowed_denoms.
+	DisallowedDenomPolicy DisallowedDenomPolicy `protobuf:"varint,7,opt,name=disallowed_denom_policy,json=disallowedDenomPolicy,proto3,enum=juno.feeshare.v1.DisallowedDenomPolicy" json:"disallowed_denom_policy,omitempty"`
+	// denom_policies overrides the disallowed_denom_policy for specific denoms.
+	DenomPolicies []DenomPolicy `protobuf:"bytes,8,rep,name=denom_policies,json=denomPolicies,proto3" json:"denom_policies"`
+	// max_payout_recipients defines the maximum number of withdrawers paid by a
+	// single transaction after aggregating the fees owed to the same withdrawer.
+	// Zero means there is no limit.
+	MaxPayoutRecipients uint32 `protobuf:"varint,9,opt,name=max_payout_recipients,json=maxPayoutRecipients,proto3" json:"max_payout_recipients,omitempty"`
+	// payout_remainder_policy defines what happens with the fees owed to the
+	// withdrawers that exceed max_payout_recipients.
+	PayoutRemainderPolicy PayoutRemainderPolicy `protobuf:"varint,10,opt,name=payout_remainder_policy,json=payoutRemainderPolicy,proto3,enum=juno.feeshare.v1.PayoutRemainderPolicy" json:"payout_remainder_policy,omitempty"`
+	// payout_epoch_blocks defines the number of blocks between the distributions
+	// of the escrowed developer shares when the payout_mode is
+	// PAYOUT_MODE_EPOCH.
+	PayoutEpochBlocks uint64 `protobuf:"varint,11,opt,name=payout_epoch_blocks,json=payoutEpochBlocks,proto3" json:"payout_epoch_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,37 +383,204 @@ func (m *Params) GetAllowedDenoms() []string {
 	return nil
 }
 
+func (m *Params) GetDistributionMode() DistributionMode {
+	if m != nil {
+		return m.DistributionMode
+	}
+	return DistributionModeEqual
+}
+
+func (m *Params) GetPayoutMode() PayoutMode {
+	if m != nil {
+		return m.PayoutMode
+	}
+	return PayoutModeDirect
+}
+
+func (m *Params) GetBlockRevenueRetentionBlocks() uint64 {
+	if m != nil {
+		return m.BlockRevenueRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetDisallowedDenomPolicy() DisallowedDenomPolicy {
+	if m != nil {
+		return m.DisallowedDenomPolicy
+	}
+	return DisallowedDenomPolicyCommunityPool
+}
+
+func (m *Params) GetDenomPolicies() []DenomPolicy {
+	if m != nil {
+		return m.DenomPolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMaxPayoutRecipients() uint32 {
+	if m != nil {
+		return m.MaxPayoutRecipients
+	}
+	return 0
+}
+
+func (m *Params) GetPayoutRemainderPolicy() PayoutRemainderPolicy {
+	if m != nil {
+		return m.PayoutRemainderPolicy
+	}
+	return PayoutRemainderPolicyTopRecipients
+}
+
+func (m *Params) GetPayoutEpochBlocks() uint64 {
+	if m != nil {
+		return m.PayoutEpochBlocks
+	}
+	return 0
+}
+
+// DenomPolicy defines how the developer shares of
+// the fees paid in a disallowed denom are handled.
+type DenomPolicy struct {
+	// denom is the denom the policy applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// policy is the policy applied to the developer shares of the denom.
+	Policy DisallowedDenomPolicy `protobuf:"varint,2,opt,name=policy,proto3,enum=juno.feeshare.v1.DisallowedDenomPolicy" json:"policy,omitempty"`
+}
+
+func (m *DenomPolicy) Reset()         { *m = DenomPolicy{} }
+func (m *DenomPolicy) String() string { return proto.CompactTextString(m) }
+func (*DenomPolicy) ProtoMessage()    {}
+func (*DenomPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c69943430ab88f7, []int{2}
+}
+func (m *DenomPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomPolicy.Merge(m, src)
+}
+func (m *DenomPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomPolicy proto.InternalMessageInfo
+
+func (m *DenomPolicy) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomPolicy) GetPolicy() DisallowedDenomPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return DisallowedDenomPolicyCommunityPool
+}
+
 func init() {
+	proto.RegisterEnum("juno.feeshare.v1.DistributionMode", DistributionMode_name, DistributionMode_value)
+	proto.RegisterEnum("juno.feeshare.v1.PayoutMode", PayoutMode_name, PayoutMode_value)
+	proto.RegisterEnum("juno.feeshare.v1.DisallowedDenomPolicy", DisallowedDenomPolicy_name, DisallowedDenomPolicy_value)
+	proto.RegisterEnum("juno.feeshare.v1.PayoutRemainderPolicy", PayoutRemainderPolicy_name, PayoutRemainderPolicy_value)
 	proto.RegisterType((*GenesisState)(nil), "juno.feeshare.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "juno.feeshare.v1.Params")
+	proto.RegisterType((*DenomPolicy)(nil), "juno.feeshare.v1.DenomPolicy")
 }
 
 func init() { proto.RegisterFile("juno/feeshare/v1/genesis.proto", fileDescriptor_9c69943430ab88f7) }
 
 var fileDescriptor_9c69943430ab88f7 = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x86, 0x3b, 0x70, 0x43, 0x60, 0xb8, 0x97, 0x4b, 0x1a, 0x17, 0x0d, 0x8b, 0xd2, 0x90, 0x68,
-	0xba, 0x71, 0x46, 0x30, 0x71, 0xe7, 0x06, 0x88, 0x26, 0xae, 0x4c, 0x59, 0xe9, 0x86, 0x0c, 0xed,
-	0xa1, 0xa0, 0x6d, 0xa7, 0xe9, 0x0c, 0xa8, 0x0f, 0xe0, 0xde, 0x87, 0xf1, 0x21, 0x58, 0xb2, 0x34,
-	0x2e, 0x88, 0x81, 0x17, 0x31, 0x9d, 0x16, 0x30, 0xb2, 0x9a, 0x33, 0xff, 0x7f, 0xfe, 0xef, 0x9c,
-	0xe4, 0x60, 0xf3, 0x61, 0x16, 0x71, 0x3a, 0x06, 0x10, 0x13, 0x96, 0x00, 0x9d, 0xb7, 0xa9, 0x0f,
-	0x11, 0x88, 0xa9, 0x20, 0x71, 0xc2, 0x25, 0xd7, 0xeb, 0xa9, 0x4f, 0xb6, 0x3e, 0x99, 0xb7, 0x1b,
-	0xcd, 0x83, 0xc4, 0xce, 0x55, 0x91, 0xc6, 0x91, 0xcf, 0x7d, 0xae, 0x4a, 0x9a, 0x56, 0x99, 0xda,
-	0x7a, 0x45, 0xf8, 0xef, 0x75, 0x86, 0x1e, 0x48, 0x26, 0x41, 0xbf, 0xc0, 0xa5, 0x98, 0x25, 0x2c,
-	0x14, 0x06, 0xb2, 0x90, 0x5d, 0xed, 0x18, 0xe4, 0xf7, 0x28, 0x72, 0xab, 0xfc, 0xee, 0x9f, 0xc5,
-	0xaa, 0xa9, 0x39, 0x79, 0xb7, 0x7e, 0x89, 0x2b, 0x63, 0x80, 0xa1, 0x6a, 0x32, 0x0a, 0x56, 0xd1,
-	0xae, 0x76, 0x1a, 0x87, 0xd1, 0x2b, 0x80, 0x41, 0x5a, 0xe7, 0xe1, 0xf2, 0x38, 0xff, 0xb7, 0xde,
-	0x11, 0x2e, 0x65, 0x5c, 0xdd, 0xc6, 0x75, 0x88, 0xd8, 0x28, 0x80, 0xe1, 0x1e, 0x98, 0xee, 0x52,
-	0x76, 0x6a, 0x99, 0xbe, 0x85, 0xe8, 0x77, 0xb8, 0xee, 0xc1, 0x1c, 0x02, 0x1e, 0x43, 0x92, 0x35,
-	0x0a, 0xa3, 0x60, 0x21, 0xbb, 0xd2, 0x25, 0x29, 0xfe, 0x73, 0xd5, 0x3c, 0xf1, 0xa7, 0x72, 0x32,
-	0x1b, 0x11, 0x97, 0x87, 0xd4, 0xe5, 0x22, 0xe4, 0x22, 0x7f, 0x4e, 0x85, 0xf7, 0x48, 0xe5, 0x4b,
-	0x0c, 0x82, 0xf4, 0xc1, 0x75, 0xfe, 0xef, 0x38, 0x8a, 0x2c, 0xf4, 0x63, 0x5c, 0x63, 0x41, 0xc0,
-	0x9f, 0xc0, 0x1b, 0x7a, 0x10, 0xf1, 0x50, 0x18, 0x45, 0xab, 0x68, 0x57, 0x9c, 0x7f, 0xb9, 0xda,
-	0x57, 0x62, 0xf7, 0x66, 0xb1, 0x36, 0xd1, 0x72, 0x6d, 0xa2, 0xaf, 0xb5, 0x89, 0xde, 0x36, 0xa6,
-	0xb6, 0xdc, 0x98, 0xda, 0xc7, 0xc6, 0xd4, 0xee, 0xcf, 0x7e, 0x4c, 0xee, 0xa9, 0x91, 0x3d, 0x1e,
-	0xc9, 0x84, 0xb9, 0x52, 0x50, 0x75, 0xaa, 0xe7, 0xfd, 0xb1, 0xd4, 0x1e, 0xa3, 0x92, 0xba, 0xc8,
-	0xf9, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x4b, 0xe6, 0xac, 0xfc, 0x01, 0x00, 0x00,
+	// 1150 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x96, 0xcf, 0x8f, 0xd3, 0x46,
+	0x14, 0xc7, 0xe3, 0xb0, 0x1b, 0xc8, 0x6c, 0xc9, 0x7a, 0x67, 0x59, 0x61, 0x02, 0x64, 0xcd, 0x56,
+	0xd0, 0x08, 0xb5, 0x49, 0xd9, 0x4a, 0xdc, 0x10, 0x4a, 0x6c, 0xb3, 0x84, 0x26, 0x71, 0x70, 0x12,
+	0xad, 0x96, 0x8b, 0xe5, 0xd8, 0x8f, 0xac, 0x4b, 0xe2, 0x71, 0x3d, 0xce, 0x86, 0xbd, 0xf7, 0x50,
+	0xa5, 0x97, 0x5e, 0x7a, 0xa9, 0x94, 0x53, 0xff, 0x96, 0x4a, 0x1c, 0x39, 0x56, 0x3d, 0xa0, 0x0a,
+	0xfe, 0x8c, 0x5e, 0x2a, 0x8f, 0xed, 0xfc, 0x74, 0x90, 0x7a, 0x5a, 0xe7, 0xbd, 0xef, 0xfb, 0xcc,
+	0xbc, 0xef, 0x3c, 0xaf, 0x07, 0x15, 0x7e, 0x18, 0x39, 0xa4, 0xfc, 0x1a, 0x80, 0x9e, 0x1b, 0x1e,
+	0x94, 0x2f, 0x1e, 0x95, 0xfb, 0xe0, 0x00, 0xb5, 0x69, 0xc9, 0xf5, 0x88, 0x4f, 0x30, 0x1f, 0xe4,
+	0x4b, 0x71, 0xbe, 0x74, 0xf1, 0x28, 0x7f, 0xb8, 0x56, 0x31, 0xcb, 0xb2, 0x92, 0xfc, 0x8d, 0x3e,
+	0xe9, 0x13, 0xf6, 0x58, 0x0e, 0x9e, 0xc2, 0xe8, 0xd1, 0x2f, 0x19, 0xf4, 0xc5, 0x49, 0x88, 0x6e,
+	0xfb, 0x86, 0x0f, 0xf8, 0x31, 0xca, 0xb8, 0x86, 0x67, 0x0c, 0xa9, 0xc0, 0x89, 0x5c, 0x71, 0xe7,
+	0x58, 0x28, 0xad, 0x2e, 0x55, 0x6a, 0xb1, 0x7c, 0x75, 0xeb, 0xdd, 0x87, 0xc3, 0x94, 0x16, 0xa9,
+	0xf1, 0x13, 0x94, 0x7d, 0x0d, 0xa0, 0x33, 0x91, 0x90, 0x16, 0xaf, 0x14, 0x77, 0x8e, 0xf3, 0xeb,
+	0xa5, 0xcf, 0x00, 0xda, 0xc1, 0x73, 0x54, 0x7c, 0xed, 0x75, 0xf4, 0x1b, 0xab, 0x68, 0xd7, 0x05,
+	0xc7, 0xb2, 0x9d, 0xbe, 0xee, 0xc1, 0xd8, 0xf0, 0x2c, 0x2a, 0x5c, 0x61, 0x10, 0x31, 0x61, 0xfd,
+	0x50, 0xa8, 0x85, 0xba, 0x08, 0x95, 0x73, 0x97, 0xa2, 0xb8, 0x83, 0xf6, 0x4c, 0xe2, 0xf8, 0x9e,
+	0x61, 0xfa, 0xba, 0x07, 0x17, 0xe0, 0x8c, 0x80, 0x0a, 0x5b, 0x0c, 0x79, 0x6f, 0x1d, 0x29, 0x45,
+	0x52, 0x2d, 0x54, 0x46, 0x4c, 0xde, 0x5c, 0x0e, 0x53, 0xfc, 0x0a, 0xed, 0x8f, 0x6d, 0xff, 0xdc,
+	0xf2, 0x8c, 0x31, 0x78, 0x73, 0xee, 0x36, 0xe3, 0x7e, 0xb9, 0xce, 0x3d, 0x9d, 0x89, 0x97, 0xc9,
+	0x78, 0xbc, 0x9a, 0xa0, 0xf8, 0x7b, 0x94, 0xeb, 0x0d, 0x88, 0xf9, 0x66, 0x8e, 0xcd, 0x30, 0x6c,
+	0x61, 0x1d, 0x5b, 0x0d, 0x74, 0xcb, 0xc4, 0xeb, 0xbd, 0x85, 0x18, 0xc5, 0x4d, 0xb4, 0xcb, 0xd4,
+	0x3a, 0xb9, 0x00, 0xcf, 0xb3, 0x2d, 0xa0, 0xc2, 0x55, 0x46, 0x3b, 0x5c, 0xa7, 0xb1, 0x13, 0x50,
+	0x23, 0x5d, 0x6c, 0x27, 0x5d, 0x0c, 0x52, 0x2c, 0xa3, 0x2c, 0x5b, 0x60, 0x60, 0x53, 0x5f, 0xb8,
+	0xb6, 0xe9, 0x64, 0xaa, 0xb1, 0x44, 0x71, 0x7c, 0xef, 0x32, 0x42, 0xcd, 0x0b, 0x83, 0x16, 0xe3,
+	0x53, 0x26, 0x63, 0x07, 0x3c, 0x2a, 0x64, 0x37, 0xb5, 0x18, 0x1d, 0xb2, 0x1a, 0xc8, 0xe2, 0x16,
+	0xdd, 0x85, 0x18, 0xc5, 0x2f, 0x11, 0x0f, 0xd4, 0xf4, 0xc8, 0x18, 0x2c, 0xdd, 0x35, 0x2e, 0xc9,
+	0xc8, 0xa7, 0x02, 0xda, 0xb4, 0x33, 0x25, 0x52, 0xb6, 0x98, 0x30, 0x02, 0xee, 0xc2, 0x52, 0x94,
+	0x1e, 0xfd, 0xbb, 0x8d, 0x32, 0xe1, 0x74, 0xe3, 0x22, 0xe2, 0xc1, 0x31, 0x7a, 0x03, 0xd0, 0xe7,
+	0x63, 0x1d, 0xbc, 0x11, 0xd7, 0xb4, 0x5c, 0x18, 0x8f, 0x47, 0x19, 0x9f, 0x21, 0xde, 0x82, 0x0b,
+	0x18, 0x10, 0x17, 0xbc, 0x50, 0x48, 0x85, 0xb4, 0xc8, 0x15, 0xb3, 0xd5, 0x52, 0xb0, 0xca, 0xdf,
+	0x1f, 0x0e, 0x1f, 0xf4, 0x6d, 0xff, 0x7c, 0xd4, 0x2b, 0x99, 0x64, 0x58, 0x36, 0x09, 0x1d, 0x12,
+	0x1a, 0xfd, 0xf9, 0x86, 0x5a, 0x6f, 0xca, 0xfe, 0xa5, 0x0b, 0xb4, 0x24, 0x83, 0xa9, 0xed, 0xce,
+	0x38, 0x8c, 0x4c, 0xf1, 0x7d, 0x94, 0x33, 0x06, 0x03, 0xd6, 0xa1, 0x05, 0x0e, 0x19, 0x86, 0x2f,
+	0x45, 0x56, 0xbb, 0x1e, 0x45, 0x65, 0x16, 0xc4, 0x2a, 0xda, 0xb3, 0x6c, 0xea, 0x7b, 0x76, 0x6f,
+	0xe4, 0xdb, 0xc4, 0xd1, 0x87, 0xc4, 0x02, 0x61, 0x4b, 0xe4, 0x8a, 0xb9, 0xe3, 0xa3, 0x75, 0x2b,
+	0xe4, 0x05, 0x69, 0x83, 0x58, 0xa0, 0xf1, 0xd6, 0x4a, 0x04, 0x3f, 0x41, 0x3b, 0xa1, 0xa3, 0x21,
+	0x6a, 0x9b, 0xa1, 0xee, 0x24, 0xfd, 0x27, 0x08, 0x44, 0x0c, 0x82, 0xdc, 0xd9, 0x33, 0x96, 0x50,
+	0x61, 0x69, 0x92, 0x75, 0x0f, 0x7c, 0x70, 0xd8, 0xd6, 0x58, 0x3c, 0x98, 0x6c, 0xae, 0xb8, 0xa5,
+	0xdd, 0x5e, 0x9c, 0x59, 0x2d, 0xd6, 0xb0, 0x21, 0xa2, 0x58, 0x47, 0x37, 0x2d, 0x9b, 0x2e, 0xb5,
+	0xaf, 0xbb, 0x64, 0x60, 0x9b, 0x97, 0xc2, 0x55, 0xb6, 0x9f, 0xaf, 0x12, 0x5b, 0x5b, 0x74, 0xa6,
+	0xc5, 0xe4, 0xda, 0x81, 0x95, 0x14, 0xc6, 0x2f, 0x50, 0x6e, 0x81, 0x6a, 0x03, 0x8d, 0xe6, 0xfa,
+	0x6e, 0x02, 0x77, 0x5e, 0x16, 0xcf, 0xa2, 0x35, 0x0b, 0xd9, 0x40, 0xf1, 0x31, 0x3a, 0x18, 0x1a,
+	0x6f, 0xa3, 0x31, 0xd4, 0x3d, 0x30, 0x6d, 0xd7, 0x06, 0xc7, 0x0f, 0xe6, 0x9b, 0x2b, 0x5e, 0xd7,
+	0xf6, 0x87, 0xc6, 0xdb, 0xd0, 0x2b, 0x6d, 0x96, 0x0a, 0x1a, 0x9c, 0xe9, 0x87, 0x86, 0xed, 0x58,
+	0xe0, 0xc5, 0x0d, 0xa2, 0x4d, 0x0d, 0xc6, 0x90, 0x48, 0x1f, 0x37, 0xe8, 0x26, 0x85, 0x71, 0x09,
+	0xed, 0x47, 0x0b, 0x80, 0x4b, 0xcc, 0xf3, 0xd8, 0xfb, 0x1d, 0xe6, 0xfd, 0x5e, 0x98, 0x52, 0x82,
+	0x4c, 0xe8, 0xf8, 0x91, 0x85, 0x76, 0x16, 0xfd, 0xb9, 0x81, 0xb6, 0x59, 0x93, 0x6c, 0xec, 0xb3,
+	0x5a, 0xf8, 0x03, 0x3f, 0x45, 0x99, 0x68, 0x93, 0xe9, 0xff, 0x77, 0x0a, 0x51, 0xd9, 0xc3, 0xdf,
+	0x38, 0xc4, 0xaf, 0x8e, 0x20, 0x7e, 0x8c, 0x6e, 0xca, 0xb5, 0x76, 0x47, 0xab, 0x55, 0xbb, 0x9d,
+	0x9a, 0xda, 0xd4, 0x1b, 0xaa, 0xac, 0xe8, 0xca, 0xcb, 0x6e, 0xa5, 0xce, 0xa7, 0xf2, 0xb7, 0x26,
+	0x53, 0xf1, 0x60, 0xb5, 0x44, 0xf9, 0x71, 0x64, 0x0c, 0x82, 0x49, 0x5b, 0xaf, 0x3b, 0xa9, 0xb4,
+	0xf5, 0x53, 0xa5, 0x76, 0xf2, 0xbc, 0xa3, 0xc8, 0x3c, 0x97, 0x3f, 0x9c, 0x4c, 0xc5, 0xdb, 0xab,
+	0xe5, 0x27, 0x06, 0x3d, 0x05, 0xbb, 0x7f, 0xee, 0x83, 0x95, 0xdf, 0xfa, 0xf9, 0x8f, 0x42, 0xea,
+	0xe1, 0xef, 0x1c, 0x42, 0xf3, 0x79, 0xc6, 0x5f, 0x23, 0xdc, 0xaa, 0x9c, 0xa9, 0xdd, 0x4e, 0xc8,
+	0x94, 0x6b, 0x9a, 0x22, 0x75, 0xf8, 0x54, 0xfe, 0xc6, 0x64, 0x2a, 0xf2, 0x73, 0x9d, 0x6c, 0x7b,
+	0x60, 0xfa, 0xab, 0xea, 0x8a, 0x24, 0x69, 0x5d, 0x85, 0xe7, 0x56, 0xd5, 0x15, 0xd3, 0xf4, 0x46,
+	0x80, 0x1f, 0xa2, 0xbd, 0x45, 0xb5, 0xd2, 0x52, 0xa5, 0xe7, 0x7c, 0x3a, 0xbf, 0x3f, 0x99, 0x8a,
+	0xbb, 0x73, 0x31, 0x3b, 0x9a, 0x68, 0x73, 0x3f, 0xa5, 0xd1, 0x41, 0xa2, 0xad, 0x58, 0x43, 0x0f,
+	0xe4, 0x5a, 0xbb, 0x52, 0xaf, 0xab, 0xa7, 0x8a, 0xac, 0xcb, 0x4a, 0x53, 0x6d, 0xe8, 0x2d, 0xb5,
+	0x5e, 0x93, 0xce, 0x74, 0x49, 0x6d, 0x34, 0xba, 0xcd, 0x5a, 0xe7, 0x4c, 0x6f, 0xa9, 0x6a, 0x60,
+	0xe4, 0x83, 0xc9, 0x54, 0x3c, 0x4a, 0xc4, 0x48, 0x64, 0x38, 0x1c, 0x39, 0xb6, 0x7f, 0xd9, 0x22,
+	0x64, 0x80, 0x9f, 0xa2, 0x3b, 0x9b, 0x98, 0xd5, 0xae, 0xd6, 0xe4, 0xb9, 0xfc, 0xdd, 0xc9, 0x54,
+	0xbc, 0x95, 0x48, 0xaa, 0x8e, 0x3c, 0x07, 0xb7, 0xd0, 0xfd, 0x4d, 0x80, 0x67, 0x8a, 0xa2, 0x4b,
+	0x6a, 0xbd, 0xae, 0x48, 0x1d, 0x55, 0xe3, 0xd3, 0xf9, 0xfb, 0x93, 0xa9, 0x78, 0x2f, 0x91, 0xf4,
+	0x0c, 0x40, 0x22, 0x83, 0x01, 0x98, 0x3e, 0xf1, 0x22, 0x1b, 0xfe, 0xe4, 0xd0, 0x41, 0xe2, 0x2b,
+	0x10, 0xd8, 0x10, 0x59, 0xaa, 0x29, 0x8d, 0x4a, 0xad, 0x29, 0x2b, 0x5a, 0xbc, 0x62, 0x47, 0x6d,
+	0xe9, 0x9a, 0x22, 0xd5, 0x5a, 0x35, 0xa5, 0xd9, 0x69, 0xc7, 0x36, 0x24, 0x62, 0x3a, 0xc4, 0x5d,
+	0x78, 0x41, 0x3f, 0xc3, 0x5c, 0xb1, 0x96, 0xfb, 0x0c, 0x73, 0xc9, 0xda, 0xb0, 0x8f, 0xea, 0x8b,
+	0x77, 0x1f, 0x0b, 0xdc, 0xfb, 0x8f, 0x05, 0xee, 0x9f, 0x8f, 0x05, 0xee, 0xd7, 0x4f, 0x85, 0xd4,
+	0xfb, 0x4f, 0x85, 0xd4, 0x5f, 0x9f, 0x0a, 0xa9, 0x57, 0xdf, 0x2e, 0x7c, 0x2a, 0x24, 0xf6, 0x8d,
+	0x88, 0xaf, 0x26, 0xb4, 0xcc, 0x6e, 0x78, 0x6f, 0xe7, 0x77, 0x3c, 0xf6, 0xe1, 0xe8, 0x65, 0xd8,
+	0x45, 0xee, 0xbb, 0xff, 0x06, 0x00, 0x2d, 0xd5, 0x20, 0xb9, 0x33, 0x0a, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,6 +603,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowedPayouts) > 0 {
+		for iNdEx := len(m.EscrowedPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingOwners) > 0 {
+		for iNdEx := len(m.PendingOwners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOwners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blocklist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ShareOverrides) > 0 {
+		for iNdEx := len(m.ShareOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShareOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.BlockRevenues) > 0 {
+		for iNdEx := len(m.BlockRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.WithdrawerRevenues) > 0 {
+		for iNdEx := len(m.WithdrawerRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawerRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ContractRevenues) > 0 {
+		for iNdEx := len(m.ContractRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PendingRewards) > 0 {
+		for iNdEx := len(m.PendingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.FeeShare) > 0 {
 		for iNdEx := len(m.FeeShare) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -240,6 +762,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PayoutEpochBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PayoutEpochBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PayoutRemainderPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PayoutRemainderPolicy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxPayoutRecipients != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPayoutRecipients))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.DenomPolicies) > 0 {
+		for iNdEx := len(m.DenomPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.DisallowedDenomPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DisallowedDenomPolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockRevenueRetentionBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockRevenueRetentionBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PayoutMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PayoutMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DistributionMode != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.DistributionMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AllowedDenoms) > 0 {
 		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedDenoms[iNdEx])
@@ -272,9 +843,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
+func (m *DenomPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -297,6 +903,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PendingRewards) > 0 {
+		for _, e := range m.PendingRewards {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractRevenues) > 0 {
+		for _, e := range m.ContractRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawerRevenues) > 0 {
+		for _, e := range m.WithdrawerRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockRevenues) > 0 {
+		for _, e := range m.BlockRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ShareOverrides) > 0 {
+		for _, e := range m.ShareOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for _, e := range m.Blocklist {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOwners) > 0 {
+		for _, e := range m.PendingOwners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowedPayouts) > 0 {
+		for _, e := range m.EscrowedPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -317,6 +971,49 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.DistributionMode != 0 {
+		n += 1 + sovGenesis(uint64(m.DistributionMode))
+	}
+	if m.PayoutMode != 0 {
+		n += 1 + sovGenesis(uint64(m.PayoutMode))
+	}
+	if m.BlockRevenueRetentionBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.BlockRevenueRetentionBlocks))
+	}
+	if m.DisallowedDenomPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.DisallowedDenomPolicy))
+	}
+	if len(m.DenomPolicies) > 0 {
+		for _, e := range m.DenomPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxPayoutRecipients != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPayoutRecipients))
+	}
+	if m.PayoutRemainderPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.PayoutRemainderPolicy))
+	}
+	if m.PayoutEpochBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.PayoutEpochBlocks))
+	}
+	return n
+}
+
+func (m *DenomPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovGenesis(uint64(m.Policy))
+	}
 	return n
 }
 
@@ -422,61 +1119,113 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRewards = append(m.PendingRewards, PendingRewards{})
+			if err := m.PendingRewards[len(m.PendingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.ContractRevenues = append(m.ContractRevenues, ContractRevenue{})
+			if err := m.ContractRevenues[len(m.ContractRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.WithdrawerRevenues = append(m.WithdrawerRevenues, WithdrawerRevenue{})
+			if err := m.WithdrawerRevenues[len(m.WithdrawerRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableFeeShare", wireType)
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRevenues", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -486,17 +1235,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.EnableFeeShare = bool(v != 0)
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRevenues = append(m.BlockRevenues, BlockRevenue{})
+			if err := m.BlockRevenues[len(m.BlockRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOverrides", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -506,31 +1269,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShareOverrides = append(m.ShareOverrides, ShareOverride{})
+			if err := m.ShareOverrides[len(m.ShareOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -540,24 +1303,498 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			m.Blocklist = append(m.Blocklist, BlocklistEntry{})
+			if err := m.Blocklist[len(m.Blocklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwners = append(m.PendingOwners, PendingOwner{})
+			if err := m.PendingOwners[len(m.PendingOwners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedPayouts = append(m.EscrowedPayouts, EscrowedPayout{})
+			if err := m.EscrowedPayouts[len(m.EscrowedPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableFeeShare", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableFeeShare = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMode", wireType)
+			}
+			m.DistributionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionMode |= DistributionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutMode", wireType)
+			}
+			m.PayoutMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutMode |= PayoutMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRevenueRetentionBlocks", wireType)
+			}
+			m.BlockRevenueRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockRevenueRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisallowedDenomPolicy", wireType)
+			}
+			m.DisallowedDenomPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisallowedDenomPolicy |= DisallowedDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomPolicies = append(m.DenomPolicies, DenomPolicy{})
+			if err := m.DenomPolicies[len(m.DenomPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPayoutRecipients", wireType)
+			}
+			m.MaxPayoutRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPayoutRecipients |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutRemainderPolicy", wireType)
+			}
+			m.PayoutRemainderPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutRemainderPolicy |= PayoutRemainderPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutEpochBlocks", wireType)
+			}
+			m.PayoutEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= DisallowedDenomPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
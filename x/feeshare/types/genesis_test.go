@@ -125,6 +125,281 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid genesis - with pending rewards",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingRewards: []PendingRewards{
+					{
+						WithdrawerAddress: suite.address1,
+						Rewards:           sdk.NewCoins(sdk.NewInt64Coin("uluna", 100)),
+					},
+					{
+						WithdrawerAddress: suite.address2,
+						Rewards:           sdk.NewCoins(sdk.NewInt64Coin("uluna", 50)),
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated pending rewards",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingRewards: []PendingRewards{
+					{
+						WithdrawerAddress: suite.address1,
+						Rewards:           sdk.NewCoins(sdk.NewInt64Coin("uluna", 100)),
+					},
+					{
+						WithdrawerAddress: suite.address1,
+						Rewards:           sdk.NewCoins(sdk.NewInt64Coin("uluna", 50)),
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid pending rewards",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingRewards: []PendingRewards{
+					{
+						WithdrawerAddress: suite.address1,
+						Rewards:           sdk.Coins{sdk.Coin{Denom: "uluna", Amount: sdk.NewInt(-1)}},
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with revenues",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractRevenues: []ContractRevenue{
+					{ContractAddress: suite.contractA, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+				WithdrawerRevenues: []WithdrawerRevenue{
+					{WithdrawerAddress: suite.address1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+				BlockRevenues: []BlockRevenue{
+					{Height: 1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 60))},
+					{Height: 2, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 40))},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated contract revenue",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ContractRevenues: []ContractRevenue{
+					{ContractAddress: suite.contractA, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+					{ContractAddress: suite.contractA, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated withdrawer revenue",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				WithdrawerRevenues: []WithdrawerRevenue{
+					{WithdrawerAddress: suite.address1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+					{WithdrawerAddress: suite.address1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid withdrawer revenue address",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				WithdrawerRevenues: []WithdrawerRevenue{
+					{WithdrawerAddress: "invalid", Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - duplicated block revenue",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				BlockRevenues: []BlockRevenue{
+					{Height: 1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 60))},
+					{Height: 1, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 40))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid block revenue height",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				BlockRevenues: []BlockRevenue{
+					{Height: 0, Revenue: sdk.NewCoins(sdk.NewInt64Coin("uluna", 60))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with share overrides",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ShareOverrides: []ShareOverride{
+					{ContractAddress: suite.contractA, DeveloperShares: sdk.NewDecWithPrec(75, 2)},
+					{CodeId: 1, DeveloperShares: sdk.ZeroDec()},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated share override",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ShareOverrides: []ShareOverride{
+					{CodeId: 1, DeveloperShares: sdk.NewDecWithPrec(75, 2)},
+					{CodeId: 1, DeveloperShares: sdk.NewDecWithPrec(25, 2)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - share override with contract and code id",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ShareOverrides: []ShareOverride{
+					{ContractAddress: suite.contractA, CodeId: 1, DeveloperShares: sdk.NewDecWithPrec(75, 2)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - share override above 100%",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				ShareOverrides: []ShareOverride{
+					{CodeId: 1, DeveloperShares: sdk.NewDec(2)},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with blocklist",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Blocklist: []BlocklistEntry{
+					{ContractAddress: suite.contractA},
+					{CodeId: 1},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated blocklist entry",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Blocklist: []BlocklistEntry{
+					{ContractAddress: suite.contractA},
+					{ContractAddress: suite.contractA},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with pending owner",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeShare: []FeeShare{
+					{
+						ContractAddress:   suite.contractA,
+						DeployerAddress:   suite.address1,
+						WithdrawerAddress: suite.address1,
+					},
+				},
+				PendingOwners: []PendingOwner{
+					{ContractAddress: suite.contractA, OwnerAddress: suite.address2},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - pending owner of an unregistered contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				PendingOwners: []PendingOwner{
+					{ContractAddress: suite.contractA, OwnerAddress: suite.address2},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid pending owner address",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				FeeShare: []FeeShare{
+					{
+						ContractAddress:   suite.contractA,
+						DeployerAddress:   suite.address1,
+						WithdrawerAddress: suite.address1,
+					},
+				},
+				PendingOwners: []PendingOwner{
+					{ContractAddress: suite.contractA, OwnerAddress: "owner"},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid genesis - with escrowed payouts",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				EscrowedPayouts: []EscrowedPayout{
+					{ContractAddress: suite.contractA, WithdrawerAddress: suite.address1, Fees: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+					{ContractAddress: suite.contractA, WithdrawerAddress: suite.address2, Fees: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid genesis - duplicated escrowed payout",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				EscrowedPayouts: []EscrowedPayout{
+					{ContractAddress: suite.contractA, WithdrawerAddress: suite.address1, Fees: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+					{ContractAddress: suite.contractA, WithdrawerAddress: suite.address1, Fees: sdk.NewCoins(sdk.NewInt64Coin("uluna", 50))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid escrowed payout withdrawer",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				EscrowedPayouts: []EscrowedPayout{
+					{ContractAddress: suite.contractA, WithdrawerAddress: "withdrawer", Fees: sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - invalid escrowed payout fees",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				EscrowedPayouts: []EscrowedPayout{
+					{ContractAddress: suite.contractA, WithdrawerAddress: suite.address1, Fees: sdk.Coins{{Denom: "uluna", Amount: sdk.NewInt(-1)}}},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid genesis - empty blocklist entry",
+			genState: &GenesisState{
+				Params:    DefaultParams(),
+				Blocklist: []BlocklistEntry{{}},
+			},
+			expPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
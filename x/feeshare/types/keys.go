@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// module name
@@ -13,12 +16,25 @@ const (
 	RouterKey = ModuleName
 )
 
+// TopEarnerAmountLength is the length of the amounts in the keys of the
+// top earners, which fits the 256 bits of the largest sdk.Int.
+const TopEarnerAmountLength = 32
+
 // prefix bytes for the fees persistent store
 const (
 	prefixFeeShare = iota + 1
 	prefixDeployer
 	prefixWithdrawer
 	prefixParams
+	prefixPendingRewards
+	prefixContractRevenue
+	prefixWithdrawerRevenue
+	prefixBlockRevenue
+	prefixTopEarners
+	prefixShareOverride
+	prefixBlocklist
+	prefixPendingOwner
+	prefixEscrowedPayout
 )
 
 // KVStore key prefixes
@@ -27,6 +43,24 @@ var (
 	KeyPrefixDeployer   = []byte{prefixDeployer}
 	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
 	ParamsKey           = []byte{prefixParams}
+
+	KeyPrefixPendingRewards    = []byte{prefixPendingRewards}
+	KeyPrefixContractRevenue   = []byte{prefixContractRevenue}
+	KeyPrefixWithdrawerRevenue = []byte{prefixWithdrawerRevenue}
+	KeyPrefixBlockRevenue      = []byte{prefixBlockRevenue}
+	KeyPrefixTopEarners        = []byte{prefixTopEarners}
+
+	KeyPrefixShareOverride         = []byte{prefixShareOverride}
+	KeyPrefixContractShareOverride = []byte{prefixShareOverride, 0x01}
+	KeyPrefixCodeShareOverride     = []byte{prefixShareOverride, 0x02}
+
+	KeyPrefixBlocklist         = []byte{prefixBlocklist}
+	KeyPrefixContractBlocklist = []byte{prefixBlocklist, 0x01}
+	KeyPrefixCodeBlocklist     = []byte{prefixBlocklist, 0x02}
+
+	KeyPrefixPendingOwner = []byte{prefixPendingOwner}
+
+	KeyPrefixEscrowedPayout = []byte{prefixEscrowedPayout}
 )
 
 // GetKeyPrefixDeployer returns the KVStore key prefix for storing
@@ -40,3 +74,81 @@ func GetKeyPrefixDeployer(deployerAddress sdk.AccAddress) []byte {
 func GetKeyPrefixWithdrawer(withdrawerAddress sdk.AccAddress) []byte {
 	return append(KeyPrefixWithdrawer, withdrawerAddress.Bytes()...)
 }
+
+// GetKeyBlockRevenue returns the KVStore key for storing
+// the fees distributed at a block height
+func GetKeyBlockRevenue(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+// GetKeyPrefixTopEarners returns the KVStore key prefix for storing
+// the withdrawers ranked by the fees received in a denom
+func GetKeyPrefixTopEarners(denom string) []byte {
+	return append(KeyPrefixTopEarners, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetKeyTopEarner returns the KVStore key for storing a withdrawer ranked
+// by the fees received in a denom, relative to the denom prefix. The amount
+// is stored with its bits inverted so that the withdrawers that received the
+// most fees come first, breaking ties by address.
+func GetKeyTopEarner(amount sdk.Int, withdrawer sdk.AccAddress) []byte {
+	key := amount.BigInt().FillBytes(make([]byte, TopEarnerAmountLength))
+	for i := range key {
+		key[i] = ^key[i]
+	}
+	return append(key, withdrawer.Bytes()...)
+}
+
+// ParseTopEarnerKey returns the withdrawer of a KVStore key returned by
+// GetKeyTopEarner.
+func ParseTopEarnerKey(key []byte) sdk.AccAddress {
+	return sdk.AccAddress(key[TopEarnerAmountLength:])
+}
+
+// GetKeyContractShareOverride returns the KVStore key for storing
+// the developer shares override of a contract
+func GetKeyContractShareOverride(contract sdk.Address) []byte {
+	return append(append([]byte{}, KeyPrefixContractShareOverride...), contract.Bytes()...)
+}
+
+// GetKeyCodeShareOverride returns the KVStore key for storing
+// the developer shares override of a code id
+func GetKeyCodeShareOverride(codeID uint64) []byte {
+	return append(append([]byte{}, KeyPrefixCodeShareOverride...), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetKeyShareOverride returns the KVStore key for storing a
+// developer shares override, the override must be valid
+func GetKeyShareOverride(so ShareOverride) []byte {
+	if so.ContractAddress != "" {
+		return GetKeyContractShareOverride(sdk.MustAccAddressFromBech32(so.ContractAddress))
+	}
+	return GetKeyCodeShareOverride(so.CodeId)
+}
+
+// GetKeyContractBlocklist returns the KVStore key for storing
+// the blocklist entry of a contract
+func GetKeyContractBlocklist(contract sdk.Address) []byte {
+	return append(append([]byte{}, KeyPrefixContractBlocklist...), contract.Bytes()...)
+}
+
+// GetKeyCodeBlocklist returns the KVStore key for storing
+// the blocklist entry of a code id
+func GetKeyCodeBlocklist(codeID uint64) []byte {
+	return append(append([]byte{}, KeyPrefixCodeBlocklist...), sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetKeyBlocklistEntry returns the KVStore key for storing
+// a blocklist entry, the entry must be valid
+func GetKeyBlocklistEntry(entry BlocklistEntry) []byte {
+	if entry.ContractAddress != "" {
+		return GetKeyContractBlocklist(sdk.MustAccAddressFromBech32(entry.ContractAddress))
+	}
+	return GetKeyCodeBlocklist(entry.CodeId)
+}
+
+// GetKeyEscrowedPayout returns the KVStore key for storing the
+// developer shares escrowed for a withdrawer of a contract
+func GetKeyEscrowedPayout(contract sdk.Address, withdrawer sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix(contract.Bytes()), withdrawer.Bytes()...)
+}
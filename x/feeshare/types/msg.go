@@ -10,12 +10,20 @@ var (
 	_ sdk.Msg = &MsgRegisterFeeShare{}
 	_ sdk.Msg = &MsgCancelFeeShare{}
 	_ sdk.Msg = &MsgUpdateFeeShare{}
+	_ sdk.Msg = &MsgWithdrawFeeShareRewards{}
+	_ sdk.Msg = &MsgProposeFeeShareOwner{}
+	_ sdk.Msg = &MsgAcceptFeeShareOwner{}
 )
 
 const (
 	TypeMsgRegisterFeeShare = "register_feeshare"
 	TypeMsgCancelFeeShare   = "cancel_feeshare"
 	TypeMsgUpdateFeeShare   = "update_feeshare"
+
+	TypeMsgProposeFeeShareOwner = "propose_feeshare_owner"
+	TypeMsgAcceptFeeShareOwner  = "accept_feeshare_owner"
+
+	TypeMsgWithdrawFeeShareRewards = "withdraw_feeshare_rewards"
 )
 
 // NewMsgRegisterFeeShare creates new instance of MsgRegisterFeeShare
@@ -52,15 +60,29 @@ func (msg MsgRegisterFeeShare) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
+	if msg.WithdrawerAddress != "" && len(msg.Withdrawers) != 0 {
+		return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawer address and withdrawers cannot be set at the same time")
+	}
+
 	if msg.WithdrawerAddress != "" {
 		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
 			return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 		}
 	}
 
+	if len(msg.Withdrawers) != 0 {
+		return ValidateWithdrawers(msg.Withdrawers)
+	}
+
 	return nil
 }
 
+// GetWeightedWithdrawers returns the withdrawers of the message, the
+// withdrawer address is returned as a single withdrawer receiving all the fees.
+func (msg MsgRegisterFeeShare) GetWeightedWithdrawers() []Withdrawer {
+	return weightedWithdrawers(msg.WithdrawerAddress, msg.Withdrawers)
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgRegisterFeeShare) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
@@ -142,6 +164,14 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
 	}
 
+	if msg.WithdrawerAddress != "" && len(msg.Withdrawers) != 0 {
+		return errorsmod.Wrap(ErrFeeShareInvalidWithdrawer, "withdrawer address and withdrawers cannot be set at the same time")
+	}
+
+	if len(msg.Withdrawers) != 0 {
+		return ValidateWithdrawers(msg.Withdrawers)
+	}
+
 	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
 		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
 	}
@@ -149,6 +179,12 @@ func (msg MsgUpdateFeeShare) ValidateBasic() error {
 	return nil
 }
 
+// GetWeightedWithdrawers returns the withdrawers of the message, the
+// withdrawer address is returned as a single withdrawer receiving all the fees.
+func (msg MsgUpdateFeeShare) GetWeightedWithdrawers() []Withdrawer {
+	return weightedWithdrawers(msg.WithdrawerAddress, msg.Withdrawers)
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgUpdateFeeShare) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
@@ -160,6 +196,137 @@ func (msg MsgUpdateFeeShare) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgProposeFeeShareOwner creates new instance of MsgProposeFeeShareOwner
+func NewMsgProposeFeeShareOwner(
+	contract sdk.Address,
+	deployer,
+	newOwner sdk.AccAddress,
+) *MsgProposeFeeShareOwner {
+	return &MsgProposeFeeShareOwner{
+		ContractAddress: contract.String(),
+		DeployerAddress: deployer.String(),
+		NewOwnerAddress: newOwner.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgProposeFeeShareOwner) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgProposeFeeShareOwner) Type() string { return TypeMsgProposeFeeShareOwner }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgProposeFeeShareOwner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", msg.DeployerAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid new owner address %s", msg.NewOwnerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgProposeFeeShareOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgProposeFeeShareOwner) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgAcceptFeeShareOwner creates new instance of MsgAcceptFeeShareOwner
+func NewMsgAcceptFeeShareOwner(
+	contract sdk.Address,
+	newOwner sdk.AccAddress,
+) *MsgAcceptFeeShareOwner {
+	return &MsgAcceptFeeShareOwner{
+		ContractAddress: contract.String(),
+		NewOwnerAddress: newOwner.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgAcceptFeeShareOwner) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgAcceptFeeShareOwner) Type() string { return TypeMsgAcceptFeeShareOwner }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptFeeShareOwner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwnerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid new owner address %s", msg.NewOwnerAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", msg.ContractAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgAcceptFeeShareOwner) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptFeeShareOwner) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.NewOwnerAddress)
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgWithdrawFeeShareRewards creates new instance of MsgWithdrawFeeShareRewards
+func NewMsgWithdrawFeeShareRewards(withdrawer sdk.AccAddress) *MsgWithdrawFeeShareRewards {
+	return &MsgWithdrawFeeShareRewards{
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgWithdrawFeeShareRewards) Route() string { return RouterKey }
+
+// Type returns the the action
+func (msg MsgWithdrawFeeShareRewards) Type() string { return TypeMsgWithdrawFeeShareRewards }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawFeeShareRewards) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", msg.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgWithdrawFeeShareRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawFeeShareRewards) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	return []sdk.AccAddress{from}
+}
+
+func weightedWithdrawers(withdrawerAddress string, withdrawers []Withdrawer) []Withdrawer {
+	if len(withdrawers) != 0 {
+		return withdrawers
+	}
+	if withdrawerAddress != "" {
+		return []Withdrawer{{Address: withdrawerAddress, WeightBps: BasisPointsTotal}}
+	}
+	return nil
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
@@ -181,3 +348,68 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return m.Params.Validate()
 }
+
+var _ sdk.Msg = &MsgSetContractShareOverride{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetContractShareOverride) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetContractShareOverride message.
+func (m *MsgSetContractShareOverride) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetContractShareOverride) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.Remove {
+		return validateTarget(m.ContractAddress, m.CodeId, ErrFeeShareInvalidShareOverride)
+	}
+
+	return m.GetShareOverride().Validate()
+}
+
+// GetShareOverride returns the override set by the message
+func (m MsgSetContractShareOverride) GetShareOverride() ShareOverride {
+	return ShareOverride{
+		ContractAddress: m.ContractAddress,
+		CodeId:          m.CodeId,
+		DeveloperShares: m.DeveloperShares,
+	}
+}
+
+var _ sdk.Msg = &MsgSetBlocklistEntry{}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetBlocklistEntry) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgSetBlocklistEntry message.
+func (m *MsgSetBlocklistEntry) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSetBlocklistEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.GetBlocklistEntry().Validate()
+}
+
+// GetBlocklistEntry returns the blocklist entry set by the message
+func (m MsgSetBlocklistEntry) GetBlocklistEntry() BlocklistEntry {
+	return BlocklistEntry{
+		ContractAddress: m.ContractAddress,
+		CodeId:          m.CodeId,
+	}
+}
@@ -221,3 +221,272 @@ func (suite *MsgsTestSuite) TestMsgUpdateFeeShareNew() {
 		}
 	}
 }
+
+func (suite *MsgsTestSuite) TestMsgFeeShareWithdrawers() {
+	withdrawer := sdk.MustAccAddressFromBech32(suite.withdrawerStr)
+	weighted := []Withdrawer{
+		NewWithdrawer(suite.deployer, 3000),
+		NewWithdrawer(withdrawer, 7000),
+	}
+
+	testCases := []struct {
+		msg         string
+		withdraw    string
+		withdrawers []Withdrawer
+		expectPass  bool
+	}{
+		{
+			"pass - weighted withdrawers",
+			"",
+			weighted,
+			true,
+		},
+		{
+			"withdrawer address and withdrawers cannot be set at the same time",
+			suite.withdrawerStr,
+			weighted,
+			false,
+		},
+		{
+			"withdrawers weights must add up to 10000",
+			"",
+			[]Withdrawer{NewWithdrawer(suite.deployer, 3000)},
+			false,
+		},
+		{
+			"duplicated withdrawer",
+			"",
+			[]Withdrawer{NewWithdrawer(withdrawer, 3000), NewWithdrawer(withdrawer, 7000)},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		register := MsgRegisterFeeShare{
+			ContractAddress:   suite.contract.String(),
+			DeployerAddress:   suite.deployerStr,
+			WithdrawerAddress: tc.withdraw,
+			Withdrawers:       tc.withdrawers,
+		}
+		update := MsgUpdateFeeShare{
+			ContractAddress:   suite.contract.String(),
+			DeployerAddress:   suite.deployerStr,
+			WithdrawerAddress: tc.withdraw,
+			Withdrawers:       tc.withdrawers,
+		}
+
+		for _, err := range []error{register.ValidateBasic(), update.ValidateBasic()} {
+			if tc.expectPass {
+				suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+			} else {
+				suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+				suite.Require().Contains(err.Error(), tc.msg)
+			}
+		}
+	}
+
+	msg := NewMsgRegisterFeeShare(suite.contract, suite.deployer, withdrawer)
+	suite.Require().Equal([]Withdrawer{NewWithdrawer(withdrawer, BasisPointsTotal)}, msg.GetWeightedWithdrawers())
+	msg.WithdrawerAddress = ""
+	msg.Withdrawers = weighted
+	suite.Require().Equal(weighted, msg.GetWeightedWithdrawers())
+}
+
+func (suite *MsgsTestSuite) TestMsgProposeFeeShareOwner() {
+	newOwner := sdk.AccAddress([]byte("cosmos3"))
+	msg := NewMsgProposeFeeShareOwner(suite.contract, suite.deployer, newOwner)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgProposeFeeShareOwner, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{suite.deployer}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	testCases := []struct {
+		msg      string
+		malleate func(*MsgProposeFeeShareOwner)
+	}{
+		{
+			"invalid deployer address",
+			func(m *MsgProposeFeeShareOwner) { m.DeployerAddress = "deployer" },
+		},
+		{
+			"invalid contract address",
+			func(m *MsgProposeFeeShareOwner) { m.ContractAddress = "contract" },
+		},
+		{
+			"invalid new owner address",
+			func(m *MsgProposeFeeShareOwner) { m.NewOwnerAddress = "" },
+		},
+	}
+
+	for i, tc := range testCases {
+		m := *msg
+		tc.malleate(&m)
+		err := m.ValidateBasic()
+		suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+		suite.Require().Contains(err.Error(), tc.msg)
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgAcceptFeeShareOwner() {
+	newOwner := sdk.AccAddress([]byte("cosmos3"))
+	msg := NewMsgAcceptFeeShareOwner(suite.contract, newOwner)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgAcceptFeeShareOwner, msg.Type())
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{newOwner}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	m := *msg
+	m.NewOwnerAddress = "owner"
+	suite.Require().ErrorContains(m.ValidateBasic(), "invalid new owner address")
+
+	m = *msg
+	m.ContractAddress = "contract"
+	suite.Require().ErrorContains(m.ValidateBasic(), "invalid contract address")
+}
+
+func (suite *MsgsTestSuite) TestMsgWithdrawFeeShareRewards() {
+	msgInvalid := MsgWithdrawFeeShareRewards{}
+	msg := NewMsgWithdrawFeeShareRewards(suite.deployer)
+	suite.Require().Equal(RouterKey, msg.Route())
+	suite.Require().Equal(TypeMsgWithdrawFeeShareRewards, msg.Type())
+	suite.Require().NotNil(msgInvalid.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{suite.deployer}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+
+	msg.WithdrawerAddress = "withdraw"
+	err := msg.ValidateBasic()
+	suite.Require().Error(err)
+	suite.Require().Contains(err.Error(), "invalid withdraw address")
+}
+
+func (suite *MsgsTestSuite) TestMsgSetContractShareOverride() {
+	authority := sdk.AccAddress([]byte("authority"))
+	msg := MsgSetContractShareOverride{
+		Authority:       authority.String(),
+		ContractAddress: suite.contract.String(),
+		DeveloperShares: sdk.NewDecWithPrec(75, 2),
+	}
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{authority}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(NewContractShareOverride(suite.contract, sdk.NewDecWithPrec(75, 2)), msg.GetShareOverride())
+
+	testCases := []struct {
+		msg        string
+		malleate   func(*MsgSetContractShareOverride)
+		expectPass bool
+	}{
+		{
+			"pass - code id override",
+			func(m *MsgSetContractShareOverride) { m.ContractAddress = ""; m.CodeId = 1 },
+			true,
+		},
+		{
+			"pass - remove without developer shares",
+			func(m *MsgSetContractShareOverride) { m.DeveloperShares = sdk.Dec{}; m.Remove = true },
+			true,
+		},
+		{
+			"invalid authority address",
+			func(m *MsgSetContractShareOverride) { m.Authority = "authority" },
+			false,
+		},
+		{
+			"invalid contract address",
+			func(m *MsgSetContractShareOverride) { m.ContractAddress = "contract" },
+			false,
+		},
+		{
+			"contract address and code id cannot be set at the same time",
+			func(m *MsgSetContractShareOverride) { m.CodeId = 1 },
+			false,
+		},
+		{
+			"contract address or code id must be set",
+			func(m *MsgSetContractShareOverride) { m.ContractAddress = ""; m.Remove = true },
+			false,
+		},
+		{
+			"value cannot be greater than 1",
+			func(m *MsgSetContractShareOverride) { m.DeveloperShares = sdk.NewDec(2) },
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		m := msg
+		tc.malleate(&m)
+		err := m.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgSetBlocklistEntry() {
+	authority := sdk.AccAddress([]byte("authority"))
+	msg := MsgSetBlocklistEntry{
+		Authority:       authority.String(),
+		ContractAddress: suite.contract.String(),
+	}
+	suite.Require().NotNil(msg.GetSignBytes())
+	suite.Require().Equal([]sdk.AccAddress{authority}, msg.GetSigners())
+	suite.Require().NoError(msg.ValidateBasic())
+	suite.Require().Equal(NewContractBlocklistEntry(suite.contract), msg.GetBlocklistEntry())
+
+	testCases := []struct {
+		msg        string
+		malleate   func(*MsgSetBlocklistEntry)
+		expectPass bool
+	}{
+		{
+			"pass - code id entry",
+			func(m *MsgSetBlocklistEntry) { m.ContractAddress = ""; m.CodeId = 1 },
+			true,
+		},
+		{
+			"pass - remove entry",
+			func(m *MsgSetBlocklistEntry) { m.Remove = true },
+			true,
+		},
+		{
+			"invalid authority address",
+			func(m *MsgSetBlocklistEntry) { m.Authority = "authority" },
+			false,
+		},
+		{
+			"invalid contract address",
+			func(m *MsgSetBlocklistEntry) { m.ContractAddress = "contract" },
+			false,
+		},
+		{
+			"contract address and code id cannot be set at the same time",
+			func(m *MsgSetBlocklistEntry) { m.CodeId = 1 },
+			false,
+		},
+		{
+			"contract address or code id must be set",
+			func(m *MsgSetBlocklistEntry) { m.ContractAddress = ""; m.Remove = true },
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		m := msg
+		tc.malleate(&m)
+		err := m.ValidateBasic()
+
+		if tc.expectPass {
+			suite.Require().NoError(err, "valid test %d failed: %s", i, tc.msg)
+		} else {
+			suite.Require().Error(err, "invalid test %d passed: %s", i, tc.msg)
+			suite.Require().Contains(err.Error(), tc.msg)
+		}
+	}
+}
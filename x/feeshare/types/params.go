@@ -21,9 +21,16 @@ func NewParams(
 
 func DefaultParams() Params {
 	return Params{
-		EnableFeeShare:  DefaultEnableFeeShare,
-		DeveloperShares: DefaultDeveloperShares,
-		AllowedDenoms:   DefaultAllowedDenoms,
+		EnableFeeShare:              DefaultEnableFeeShare,
+		DeveloperShares:             DefaultDeveloperShares,
+		AllowedDenoms:               DefaultAllowedDenoms,
+		DistributionMode:            DefaultDistributionMode,
+		PayoutMode:                  DefaultPayoutMode,
+		BlockRevenueRetentionBlocks: DefaultBlockRevenueRetentionBlocks,
+		DisallowedDenomPolicy:       DefaultDisallowedPolicy,
+		MaxPayoutRecipients:         DefaultMaxPayoutRecipients,
+		PayoutRemainderPolicy:       DefaultPayoutRemainderPolicy,
+		PayoutEpochBlocks:           DefaultPayoutEpochBlocks,
 	}
 }
 
@@ -73,6 +80,111 @@ func validateArray(i interface{}) error {
 	return nil
 }
 
+func validateDistributionMode(i interface{}) error {
+	v, ok := i.(DistributionMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DistributionMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid distribution mode: %d", v)
+	}
+
+	return nil
+}
+
+func validatePayoutMode(i interface{}) error {
+	v, ok := i.(PayoutMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PayoutMode_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid payout mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateDisallowedDenomPolicy(i interface{}) error {
+	v, ok := i.(DisallowedDenomPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := DisallowedDenomPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid disallowed denom policy: %d", v)
+	}
+
+	return nil
+}
+
+func validateMaxPayoutRecipients(i interface{}) error {
+	_, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePayoutRemainderPolicy(i interface{}) error {
+	v, ok := i.(PayoutRemainderPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, ok := PayoutRemainderPolicy_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid payout remainder policy: %d", v)
+	}
+
+	return nil
+}
+
+func validatePayoutEpochBlocks(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateDenomPolicies(i interface{}) error {
+	v, ok := i.([]DenomPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, dp := range v {
+		if err := sdk.ValidateDenom(dp.Denom); err != nil {
+			return fmt.Errorf("invalid denom policy: %w", err)
+		}
+		if seenDenoms[dp.Denom] {
+			return fmt.Errorf("duplicated denom policy: %s", dp.Denom)
+		}
+		seenDenoms[dp.Denom] = true
+
+		if err := validateDisallowedDenomPolicy(dp.Policy); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetDenomPolicy returns the policy applied to the developer
+// shares of the fees paid in a denom that is not allowed.
+func (p Params) GetDenomPolicy(denom string) DisallowedDenomPolicy {
+	for _, dp := range p.DenomPolicies {
+		if dp.Denom == denom {
+			return dp.Policy
+		}
+	}
+	return p.DisallowedDenomPolicy
+}
+
 func (p Params) Validate() error {
 	if err := validateBool(p.EnableFeeShare); err != nil {
 		return err
@@ -80,6 +192,32 @@ func (p Params) Validate() error {
 	if err := validateShares(p.DeveloperShares); err != nil {
 		return err
 	}
-	err := validateArray(p.AllowedDenoms)
-	return err
+	if err := validateArray(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateDistributionMode(p.DistributionMode); err != nil {
+		return err
+	}
+	if err := validatePayoutMode(p.PayoutMode); err != nil {
+		return err
+	}
+	if err := validateDisallowedDenomPolicy(p.DisallowedDenomPolicy); err != nil {
+		return err
+	}
+	if err := validateDenomPolicies(p.DenomPolicies); err != nil {
+		return err
+	}
+	if err := validateMaxPayoutRecipients(p.MaxPayoutRecipients); err != nil {
+		return err
+	}
+	if err := validatePayoutRemainderPolicy(p.PayoutRemainderPolicy); err != nil {
+		return err
+	}
+	if err := validatePayoutEpochBlocks(p.PayoutEpochBlocks); err != nil {
+		return err
+	}
+	if p.PayoutMode == PayoutModeEpoch && p.PayoutEpochBlocks == 0 {
+		return fmt.Errorf("payout epoch blocks must be positive in the epoch payout mode")
+	}
+	return nil
 }
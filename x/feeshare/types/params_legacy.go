@@ -9,9 +9,16 @@ import (
 
 // Parameter store key
 var (
-	DefaultEnableFeeShare  = true
-	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2) // 50%
-	DefaultAllowedDenoms   = []string(nil)             // all allowed
+	DefaultEnableFeeShare              = true
+	DefaultDeveloperShares             = sdk.NewDecWithPrec(50, 2) // 50%
+	DefaultAllowedDenoms               = []string(nil)             // all allowed
+	DefaultDistributionMode            = DistributionModeEqual
+	DefaultPayoutMode                  = PayoutModeDirect
+	DefaultBlockRevenueRetentionBlocks = uint64(100_800) // about a week of blocks
+	DefaultDisallowedPolicy            = DisallowedDenomPolicyCommunityPool
+	DefaultMaxPayoutRecipients         = uint32(20)
+	DefaultPayoutRemainderPolicy       = PayoutRemainderPolicyTopRecipients
+	DefaultPayoutEpochBlocks           = uint64(100)
 
 	ParamStoreKeyEnableFeeShare  = []byte("EnableFeeShare")
 	ParamStoreKeyDeveloperShares = []byte("DeveloperShares")
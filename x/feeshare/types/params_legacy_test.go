@@ -41,7 +41,7 @@ func TestLegacyParamsValidate(t *testing.T) {
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(1)), AllowedDenoms: acceptedDenoms},
 			false,
 		},
 		{
@@ -51,17 +51,17 @@ func TestLegacyParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(2)), AllowedDenoms: acceptedDenoms},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(-1)), AllowedDenoms: acceptedDenoms},
 			true,
 		},
 		{
 			"valid: all denoms allowed",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(-1)), AllowedDenoms: []string{}},
 			true,
 		},
 	}
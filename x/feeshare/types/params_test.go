@@ -40,7 +40,7 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"valid: 100% devs",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(1)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(1)), AllowedDenoms: acceptedDenoms},
 			false,
 		},
 		{
@@ -50,17 +50,102 @@ func TestParamsValidate(t *testing.T) {
 		},
 		{
 			"invalid: share > 1",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(2)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(2)), AllowedDenoms: acceptedDenoms},
 			true,
 		},
 		{
 			"invalid: share < 0",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), acceptedDenoms},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(-1)), AllowedDenoms: acceptedDenoms},
 			true,
 		},
 		{
 			"valid: all denoms allowed",
-			Params{true, sdk.NewDecFromInt(sdk.NewInt(-1)), []string{}},
+			Params{EnableFeeShare: true, DeveloperShares: sdk.NewDecFromInt(sdk.NewInt(-1)), AllowedDenoms: []string{}},
+			true,
+		},
+		{
+			"valid: gas weighted distribution",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DistributionMode: DistributionModeGasWeighted},
+			false,
+		},
+		{
+			"invalid: unknown distribution mode",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DistributionMode: DistributionMode(99)},
+			true,
+		},
+		{
+			"valid: accrue payout mode",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, PayoutMode: PayoutModeAccrue},
+			false,
+		},
+		{
+			"invalid: unknown payout mode",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, PayoutMode: PayoutMode(99)},
+			true,
+		},
+		{
+			"valid: burn disallowed denoms",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DisallowedDenomPolicy: DisallowedDenomPolicyBurn},
+			false,
+		},
+		{
+			"invalid: unknown disallowed denom policy",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DisallowedDenomPolicy: DisallowedDenomPolicy(99)},
+			true,
+		},
+		{
+			"valid: denom policies",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DenomPolicies: []DenomPolicy{
+				{Denom: "uatom", Policy: DisallowedDenomPolicyBurn},
+				{Denom: "uosmo", Policy: DisallowedDenomPolicyFeeCollector},
+			}},
+			false,
+		},
+		{
+			"invalid: duplicated denom policy",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DenomPolicies: []DenomPolicy{
+				{Denom: "uatom", Policy: DisallowedDenomPolicyBurn},
+				{Denom: "uatom", Policy: DisallowedDenomPolicyFeeCollector},
+			}},
+			true,
+		},
+		{
+			"invalid: denom policy with invalid denom",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DenomPolicies: []DenomPolicy{
+				{Denom: "", Policy: DisallowedDenomPolicyBurn},
+			}},
+			true,
+		},
+		{
+			"invalid: denom policy with unknown policy",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, DenomPolicies: []DenomPolicy{
+				{Denom: "uatom", Policy: DisallowedDenomPolicy(99)},
+			}},
+			true,
+		},
+		{
+			"valid: unlimited payout recipients",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, MaxPayoutRecipients: 0},
+			false,
+		},
+		{
+			"valid: community pool payout remainder policy",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, MaxPayoutRecipients: 5, PayoutRemainderPolicy: PayoutRemainderPolicyCommunityPool},
+			false,
+		},
+		{
+			"valid: epoch payout mode",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, PayoutMode: PayoutModeEpoch, PayoutEpochBlocks: 10},
+			false,
+		},
+		{
+			"invalid: epoch payout mode without epoch blocks",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, PayoutMode: PayoutModeEpoch},
+			true,
+		},
+		{
+			"invalid: unknown payout remainder policy",
+			Params{EnableFeeShare: true, DeveloperShares: devShares, AllowedDenoms: acceptedDenoms, PayoutRemainderPolicy: PayoutRemainderPolicy(99)},
 			true,
 		},
 	}
@@ -102,6 +187,64 @@ func TestParamsValidateShares(t *testing.T) {
 	}
 }
 
+func TestParamsValidateDistributionMode(t *testing.T) {
+	err := validateDistributionMode(DefaultDistributionMode)
+	require.NoError(t, err)
+	err = validateDistributionMode(DistributionModeGasWeighted)
+	require.NoError(t, err)
+	err = validateDistributionMode(DistributionMode(2))
+	require.Error(t, err)
+	err = validateDistributionMode(int32(1))
+	require.Error(t, err)
+}
+
+func TestParamsValidatePayoutMode(t *testing.T) {
+	err := validatePayoutMode(DefaultPayoutMode)
+	require.NoError(t, err)
+	err = validatePayoutMode(PayoutModeAccrue)
+	require.NoError(t, err)
+	err = validatePayoutMode(PayoutModeEpoch)
+	require.NoError(t, err)
+	err = validatePayoutMode(PayoutMode(3))
+	require.Error(t, err)
+	err = validatePayoutMode(int32(1))
+	require.Error(t, err)
+}
+
+func TestParamsValidateDisallowedDenomPolicy(t *testing.T) {
+	err := validateDisallowedDenomPolicy(DefaultDisallowedPolicy)
+	require.NoError(t, err)
+	err = validateDisallowedDenomPolicy(DisallowedDenomPolicyBurn)
+	require.NoError(t, err)
+	err = validateDisallowedDenomPolicy(DisallowedDenomPolicyFeeCollector)
+	require.NoError(t, err)
+	err = validateDisallowedDenomPolicy(DisallowedDenomPolicy(3))
+	require.Error(t, err)
+	err = validateDisallowedDenomPolicy(int32(1))
+	require.Error(t, err)
+}
+
+func TestParamsValidatePayoutRemainderPolicy(t *testing.T) {
+	err := validatePayoutRemainderPolicy(DefaultPayoutRemainderPolicy)
+	require.NoError(t, err)
+	err = validatePayoutRemainderPolicy(PayoutRemainderPolicyCommunityPool)
+	require.NoError(t, err)
+	err = validatePayoutRemainderPolicy(PayoutRemainderPolicy(2))
+	require.Error(t, err)
+	err = validatePayoutRemainderPolicy(int32(1))
+	require.Error(t, err)
+}
+
+func TestParamsGetDenomPolicy(t *testing.T) {
+	params := DefaultParams()
+	params.DenomPolicies = []DenomPolicy{{Denom: "uatom", Policy: DisallowedDenomPolicyBurn}}
+	require.Equal(t, DisallowedDenomPolicyBurn, params.GetDenomPolicy("uatom"))
+	require.Equal(t, DisallowedDenomPolicyCommunityPool, params.GetDenomPolicy("uosmo"))
+
+	params.DisallowedDenomPolicy = DisallowedDenomPolicyFeeCollector
+	require.Equal(t, DisallowedDenomPolicyFeeCollector, params.GetDenomPolicy("uosmo"))
+}
+
 func TestParamsValidateBool(t *testing.T) {
 	err := validateBool(DefaultEnableFeeShare)
 	require.NoError(t, err)
@@ -32,3 +32,57 @@ func (q QueryWithdrawerFeeSharesRequest) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryPendingRewardsRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", q.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryPendingFeeShareOwnerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", q.ContractAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryContractRevenueRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", q.ContractAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryWithdrawerRevenueRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdraw address %s", q.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryTopEarnersRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return errorsmod.Wrapf(err, "invalid denom %s", q.Denom)
+	}
+
+	return nil
+}
+
+// ValidateBasic runs stateless checks on the query requests
+func (q QueryContractBlockedRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(q.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", q.ContractAddress)
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -39,8 +41,12 @@ type MsgRegisterFeeShare struct {
 	// same the contract's admin address
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the
-	// transaction fees
+	// transaction fees. It cannot be combined with withdrawers.
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is the list of accounts receiving the transaction fees with
+	// their weights in basis points. It cannot be combined with
+	// withdrawer_address.
+	Withdrawers []Withdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgRegisterFeeShare) Reset()         { *m = MsgRegisterFeeShare{} }
@@ -97,6 +103,13 @@ func (m *MsgRegisterFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgRegisterFeeShare) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgRegisterFeeShareResponse defines the MsgRegisterFeeShare response type
 type MsgRegisterFeeShareResponse struct {
 }
@@ -143,8 +156,12 @@ type MsgUpdateFeeShare struct {
 	// same the contract's admin address
 	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
 	// withdrawer_address is the bech32 address of account receiving the
-	// transaction fees
+	// transaction fees. It cannot be combined with withdrawers.
 	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+	// withdrawers is the list of accounts receiving the transaction fees with
+	// their weights in basis points. It cannot be combined with
+	// withdrawer_address.
+	Withdrawers []Withdrawer `protobuf:"bytes,4,rep,name=withdrawers,proto3" json:"withdrawers"`
 }
 
 func (m *MsgUpdateFeeShare) Reset()         { *m = MsgUpdateFeeShare{} }
@@ -201,6 +218,13 @@ func (m *MsgUpdateFeeShare) GetWithdrawerAddress() string {
 	return ""
 }
 
+func (m *MsgUpdateFeeShare) GetWithdrawers() []Withdrawer {
+	if m != nil {
+		return m.Withdrawers
+	}
+	return nil
+}
+
 // MsgUpdateFeeShareResponse defines the MsgUpdateFeeShare response type
 type MsgUpdateFeeShareResponse struct {
 }
@@ -331,6 +355,302 @@ func (m *MsgCancelFeeShareResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelFeeShareResponse proto.InternalMessageInfo
 
+// MsgProposeFeeShareOwner defines a message that proposes a new owner for a
+// registered FeeShare
+type MsgProposeFeeShareOwner struct {
+	// contract_address in bech32 format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// deployer_address is the bech32 address of message sender. It must be the
+	// current owner of the FeeShare
+	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// new_owner_address is the bech32 address of the account that has to
+	// accept the ownership. Proposing the current owner cancels the pending
+	// transfer.
+	NewOwnerAddress string `protobuf:"bytes,3,opt,name=new_owner_address,json=newOwnerAddress,proto3" json:"new_owner_address,omitempty"`
+}
+
+func (m *MsgProposeFeeShareOwner) Reset()         { *m = MsgProposeFeeShareOwner{} }
+func (m *MsgProposeFeeShareOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeFeeShareOwner) ProtoMessage()    {}
+func (*MsgProposeFeeShareOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{6}
+}
+func (m *MsgProposeFeeShareOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeFeeShareOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeFeeShareOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeFeeShareOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeFeeShareOwner.Merge(m, src)
+}
+func (m *MsgProposeFeeShareOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeFeeShareOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeFeeShareOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeFeeShareOwner proto.InternalMessageInfo
+
+func (m *MsgProposeFeeShareOwner) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgProposeFeeShareOwner) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *MsgProposeFeeShareOwner) GetNewOwnerAddress() string {
+	if m != nil {
+		return m.NewOwnerAddress
+	}
+	return ""
+}
+
+// MsgProposeFeeShareOwnerResponse defines the MsgProposeFeeShareOwner
+// response type
+type MsgProposeFeeShareOwnerResponse struct {
+}
+
+func (m *MsgProposeFeeShareOwnerResponse) Reset()         { *m = MsgProposeFeeShareOwnerResponse{} }
+func (m *MsgProposeFeeShareOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeFeeShareOwnerResponse) ProtoMessage()    {}
+func (*MsgProposeFeeShareOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{7}
+}
+func (m *MsgProposeFeeShareOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeFeeShareOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeFeeShareOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeFeeShareOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeFeeShareOwnerResponse.Merge(m, src)
+}
+func (m *MsgProposeFeeShareOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeFeeShareOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeFeeShareOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeFeeShareOwnerResponse proto.InternalMessageInfo
+
+// MsgAcceptFeeShareOwner defines a message that accepts the ownership of a
+// registered FeeShare
+type MsgAcceptFeeShareOwner struct {
+	// contract_address in bech32 format
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// new_owner_address is the bech32 address of message sender. It must be
+	// the owner proposed by the current owner of the FeeShare
+	NewOwnerAddress string `protobuf:"bytes,2,opt,name=new_owner_address,json=newOwnerAddress,proto3" json:"new_owner_address,omitempty"`
+}
+
+func (m *MsgAcceptFeeShareOwner) Reset()         { *m = MsgAcceptFeeShareOwner{} }
+func (m *MsgAcceptFeeShareOwner) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptFeeShareOwner) ProtoMessage()    {}
+func (*MsgAcceptFeeShareOwner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{8}
+}
+func (m *MsgAcceptFeeShareOwner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptFeeShareOwner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptFeeShareOwner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptFeeShareOwner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptFeeShareOwner.Merge(m, src)
+}
+func (m *MsgAcceptFeeShareOwner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptFeeShareOwner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptFeeShareOwner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptFeeShareOwner proto.InternalMessageInfo
+
+func (m *MsgAcceptFeeShareOwner) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgAcceptFeeShareOwner) GetNewOwnerAddress() string {
+	if m != nil {
+		return m.NewOwnerAddress
+	}
+	return ""
+}
+
+// MsgAcceptFeeShareOwnerResponse defines the MsgAcceptFeeShareOwner response
+// type
+type MsgAcceptFeeShareOwnerResponse struct {
+}
+
+func (m *MsgAcceptFeeShareOwnerResponse) Reset()         { *m = MsgAcceptFeeShareOwnerResponse{} }
+func (m *MsgAcceptFeeShareOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptFeeShareOwnerResponse) ProtoMessage()    {}
+func (*MsgAcceptFeeShareOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{9}
+}
+func (m *MsgAcceptFeeShareOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptFeeShareOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptFeeShareOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptFeeShareOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptFeeShareOwnerResponse.Merge(m, src)
+}
+func (m *MsgAcceptFeeShareOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptFeeShareOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptFeeShareOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptFeeShareOwnerResponse proto.InternalMessageInfo
+
+// MsgWithdrawFeeShareRewards defines a message that pays out the fees accrued
+// by a withdrawer
+type MsgWithdrawFeeShareRewards struct {
+	// withdrawer_address is the bech32 address of the account that accrued the
+	// fees and receives the payout
+	WithdrawerAddress string `protobuf:"bytes,1,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *MsgWithdrawFeeShareRewards) Reset()         { *m = MsgWithdrawFeeShareRewards{} }
+func (m *MsgWithdrawFeeShareRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeShareRewards) ProtoMessage()    {}
+func (*MsgWithdrawFeeShareRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{10}
+}
+func (m *MsgWithdrawFeeShareRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeShareRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeShareRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeShareRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeShareRewards.Merge(m, src)
+}
+func (m *MsgWithdrawFeeShareRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeShareRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeShareRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeShareRewards proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeeShareRewards) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+// MsgWithdrawFeeShareRewardsResponse defines the MsgWithdrawFeeShareRewards
+// response type
+type MsgWithdrawFeeShareRewardsResponse struct {
+	// amount is the amount of fees paid out to the withdrawer
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawFeeShareRewardsResponse) Reset()         { *m = MsgWithdrawFeeShareRewardsResponse{} }
+func (m *MsgWithdrawFeeShareRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawFeeShareRewardsResponse) ProtoMessage()    {}
+func (*MsgWithdrawFeeShareRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{11}
+}
+func (m *MsgWithdrawFeeShareRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawFeeShareRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawFeeShareRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawFeeShareRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawFeeShareRewardsResponse.Merge(m, src)
+}
+func (m *MsgWithdrawFeeShareRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawFeeShareRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawFeeShareRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawFeeShareRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawFeeShareRewardsResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -347,7 +667,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db5ab2575863a062, []int{6}
+	return fileDescriptor_db5ab2575863a062, []int{12}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,7 +721,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_db5ab2575863a062, []int{7}
+	return fileDescriptor_db5ab2575863a062, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,96 +750,381 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterFeeShare)(nil), "juno.feeshare.v1.MsgRegisterFeeShare")
-	proto.RegisterType((*MsgRegisterFeeShareResponse)(nil), "juno.feeshare.v1.MsgRegisterFeeShareResponse")
-	proto.RegisterType((*MsgUpdateFeeShare)(nil), "juno.feeshare.v1.MsgUpdateFeeShare")
-	proto.RegisterType((*MsgUpdateFeeShareResponse)(nil), "juno.feeshare.v1.MsgUpdateFeeShareResponse")
-	proto.RegisterType((*MsgCancelFeeShare)(nil), "juno.feeshare.v1.MsgCancelFeeShare")
-	proto.RegisterType((*MsgCancelFeeShareResponse)(nil), "juno.feeshare.v1.MsgCancelFeeShareResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "juno.feeshare.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.feeshare.v1.MsgUpdateParamsResponse")
+// MsgSetContractShareOverride is the Msg/SetContractShareOverride request
+// type.
+type MsgSetContractShareOverride struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the bech32 address of the contract to override. It
+	// cannot be combined with code_id.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// code_id is the code id of the contracts to override. It cannot be
+	// combined with contract_address.
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// developer_shares is the proportion of the transaction fees distributed to
+	// the matching contracts.
+	DeveloperShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=developer_shares,json=developerShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_shares"`
+	// remove deletes the override so the developer_shares module param applies
+	// again. developer_shares is ignored when it is set.
+	Remove bool `protobuf:"varint,5,opt,name=remove,proto3" json:"remove,omitempty"`
 }
 
-func init() { proto.RegisterFile("juno/feeshare/v1/tx.proto", fileDescriptor_db5ab2575863a062) }
-
-var fileDescriptor_db5ab2575863a062 = []byte{
-	// 559 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xc1, 0x6a, 0x13, 0x41,
-	0x1c, 0xc6, 0x33, 0x6d, 0x29, 0x74, 0x94, 0x36, 0x5d, 0x0b, 0x4d, 0x52, 0xdd, 0xea, 0xaa, 0xa5,
-	0x55, 0xb3, 0x63, 0x2b, 0xf4, 0xd0, 0x9b, 0x09, 0x78, 0x10, 0x02, 0x92, 0xe2, 0x45, 0x84, 0x30,
-	0xdd, 0x8c, 0x93, 0x95, 0x64, 0x67, 0x99, 0x99, 0xb4, 0xcd, 0xb5, 0x4f, 0x50, 0xf1, 0xe2, 0x49,
-	0x7a, 0xf0, 0x01, 0x3c, 0xf8, 0x10, 0x3d, 0x16, 0xbd, 0x78, 0x12, 0x49, 0x04, 0x7d, 0x0c, 0xd9,
-	0x99, 0xd9, 0x5d, 0x37, 0xd9, 0xd6, 0x5e, 0x04, 0x6f, 0xc9, 0x7c, 0xbf, 0xf9, 0xcf, 0x6f, 0x97,
-	0x6f, 0x16, 0x96, 0x5f, 0xf7, 0x03, 0x86, 0x5e, 0x11, 0x22, 0x3a, 0x98, 0x13, 0xb4, 0xbf, 0x89,
-	0xe4, 0xa1, 0x1b, 0x72, 0x26, 0x99, 0x55, 0x8c, 0x22, 0x37, 0x8e, 0xdc, 0xfd, 0xcd, 0xca, 0x12,
-	0x65, 0x94, 0xa9, 0x10, 0x45, 0xbf, 0x34, 0x57, 0xb9, 0x4e, 0x19, 0xa3, 0x5d, 0x82, 0x70, 0xe8,
-	0x23, 0x1c, 0x04, 0x4c, 0x62, 0xe9, 0xb3, 0x40, 0x98, 0x74, 0xd9, 0x63, 0xa2, 0xc7, 0x04, 0xea,
-	0x09, 0x1a, 0x4d, 0xef, 0x09, 0x6a, 0x82, 0xb2, 0x0e, 0x5a, 0x7a, 0x9e, 0xfe, 0x63, 0x22, 0x7b,
-	0x42, 0x8a, 0x92, 0x80, 0x08, 0xdf, 0xe4, 0xce, 0x09, 0x80, 0xd7, 0x1a, 0x82, 0x36, 0x09, 0xf5,
-	0x85, 0x24, 0xfc, 0x09, 0x21, 0xbb, 0x11, 0x68, 0x6d, 0xc0, 0xa2, 0xc7, 0x02, 0xc9, 0xb1, 0x27,
-	0x5b, 0xb8, 0xdd, 0xe6, 0x44, 0x88, 0x12, 0xb8, 0x09, 0xd6, 0xe7, 0x9a, 0x0b, 0xf1, 0xfa, 0x63,
-	0xbd, 0x1c, 0xa1, 0x6d, 0x12, 0x76, 0xd9, 0x80, 0xf0, 0x04, 0x9d, 0xd2, 0x68, 0xbc, 0x1e, 0xa3,
-	0x55, 0x68, 0x1d, 0xf8, 0xb2, 0xd3, 0xe6, 0xf8, 0xe0, 0x0f, 0x78, 0x5a, 0xc1, 0x8b, 0x69, 0x62,
-	0xf0, 0x9d, 0x99, 0x5f, 0x27, 0xab, 0x05, 0xe7, 0x06, 0x5c, 0xc9, 0x31, 0x6c, 0x12, 0x11, 0xb2,
-	0x40, 0x10, 0xe7, 0x3d, 0x80, 0x8b, 0x0d, 0x41, 0x9f, 0x87, 0x6d, 0x2c, 0xc9, 0xff, 0xe8, 0xbf,
-	0x02, 0xcb, 0x13, 0x7e, 0x89, 0x3d, 0x53, 0xf2, 0x75, 0x1c, 0x78, 0xa4, 0xfb, 0x6f, 0xe5, 0x33,
-	0x36, 0xd9, 0x03, 0x13, 0x9b, 0x37, 0x00, 0x2e, 0x24, 0xae, 0xcf, 0x30, 0xc7, 0x3d, 0x61, 0x6d,
-	0xc3, 0x39, 0xdc, 0x97, 0x1d, 0xc6, 0x7d, 0x39, 0xd0, 0x16, 0xb5, 0xd2, 0xe7, 0x4f, 0xd5, 0x25,
-	0x53, 0x33, 0x33, 0x7d, 0x57, 0x72, 0x3f, 0xa0, 0xcd, 0x14, 0xb5, 0xb6, 0xe1, 0x6c, 0xa8, 0x26,
-	0x28, 0x9f, 0x2b, 0x5b, 0x25, 0x77, 0xfc, 0x12, 0xb8, 0xfa, 0x84, 0xda, 0xcc, 0xe9, 0xb7, 0xd5,
-	0x42, 0xd3, 0xd0, 0x3b, 0xf3, 0x47, 0x3f, 0x3f, 0xde, 0x4b, 0xe7, 0x38, 0x65, 0xb8, 0x3c, 0xa6,
-	0x14, 0xeb, 0x6e, 0x7d, 0x98, 0x81, 0xd3, 0x0d, 0x41, 0xad, 0x77, 0x00, 0x16, 0x27, 0x1a, 0x7c,
-	0x77, 0xf2, 0xbc, 0x9c, 0x1a, 0x55, 0xaa, 0x97, 0xc2, 0x92, 0x37, 0xe4, 0x1e, 0x7d, 0xf9, 0xf1,
-	0x76, 0x6a, 0xdd, 0x59, 0x43, 0x39, 0xb7, 0x1d, 0x71, 0xb3, 0xad, 0x95, 0x58, 0x1c, 0x03, 0x38,
-	0x3f, 0x56, 0xcd, 0xdb, 0xb9, 0x27, 0x66, 0xa1, 0xca, 0xfd, 0x4b, 0x40, 0x89, 0xd4, 0x03, 0x25,
-	0xb5, 0xe6, 0xdc, 0xc9, 0x95, 0xea, 0xab, 0x4d, 0x59, 0xa5, 0xb1, 0xc2, 0xe5, 0x2b, 0x65, 0xa1,
-	0x73, 0x94, 0xce, 0x69, 0xd2, 0xc5, 0x4a, 0x9e, 0xda, 0x94, 0x2a, 0xbd, 0x84, 0x57, 0x33, 0x9d,
-	0xbb, 0x75, 0xc1, 0xd3, 0x6b, 0xa4, 0xb2, 0xf1, 0x57, 0x24, 0x76, 0xa9, 0x3d, 0x3d, 0x1d, 0xda,
-	0xe0, 0x6c, 0x68, 0x83, 0xef, 0x43, 0x1b, 0x1c, 0x8f, 0xec, 0xc2, 0xd9, 0xc8, 0x2e, 0x7c, 0x1d,
-	0xd9, 0x85, 0x17, 0x0f, 0xa9, 0x2f, 0x3b, 0xfd, 0x3d, 0xd7, 0x63, 0x3d, 0x54, 0x57, 0x7d, 0xae,
-	0x9b, 0xfb, 0x25, 0xb4, 0xf7, 0x61, 0x6a, 0x2e, 0x07, 0x21, 0x11, 0x7b, 0xb3, 0xea, 0xb3, 0xf9,
-	0xe8, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x85, 0x8e, 0x48, 0x79, 0xed, 0x05, 0x00, 0x00,
+func (m *MsgSetContractShareOverride) Reset()         { *m = MsgSetContractShareOverride{} }
+func (m *MsgSetContractShareOverride) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractShareOverride) ProtoMessage()    {}
+func (*MsgSetContractShareOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{14}
+}
+func (m *MsgSetContractShareOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractShareOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractShareOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractShareOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractShareOverride.Merge(m, src)
+}
+func (m *MsgSetContractShareOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractShareOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractShareOverride.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetContractShareOverride proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterFeeShare registers a new contract for receiving transaction fees
-	RegisterFeeShare(ctx context.Context, in *MsgRegisterFeeShare, opts ...grpc.CallOption) (*MsgRegisterFeeShareResponse, error)
-	// UpdateFeeShare updates the withdrawer address of a FeeShare
-	UpdateFeeShare(ctx context.Context, in *MsgUpdateFeeShare, opts ...grpc.CallOption) (*MsgUpdateFeeShareResponse, error)
-	// CancelFeeShare cancels a contract's fee registration and further receival
-	// of transaction fees
-	CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, opts ...grpc.CallOption) (*MsgCancelFeeShareResponse, error)
-	// Update the params of the module through gov v1 type.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSetContractShareOverride) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetContractShareOverride) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetContractShareOverride) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
 }
 
-func (c *msgClient) RegisterFeeShare(ctx context.Context, in *MsgRegisterFeeShare, opts ...grpc.CallOption) (*MsgRegisterFeeShareResponse, error) {
-	out := new(MsgRegisterFeeShareResponse)
-	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/RegisterFeeShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetContractShareOverride) GetRemove() bool {
+	if m != nil {
+		return m.Remove
 	}
-	return out, nil
+	return false
+}
+
+// MsgSetContractShareOverrideResponse defines the response structure for
+// executing a MsgSetContractShareOverride message.
+type MsgSetContractShareOverrideResponse struct {
+}
+
+func (m *MsgSetContractShareOverrideResponse) Reset()         { *m = MsgSetContractShareOverrideResponse{} }
+func (m *MsgSetContractShareOverrideResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetContractShareOverrideResponse) ProtoMessage()    {}
+func (*MsgSetContractShareOverrideResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{15}
+}
+func (m *MsgSetContractShareOverrideResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetContractShareOverrideResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetContractShareOverrideResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetContractShareOverrideResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetContractShareOverrideResponse.Merge(m, src)
+}
+func (m *MsgSetContractShareOverrideResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetContractShareOverrideResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetContractShareOverrideResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetContractShareOverrideResponse proto.InternalMessageInfo
+
+// MsgSetBlocklistEntry is the Msg/SetBlocklistEntry request type.
+type MsgSetBlocklistEntry struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract_address is the bech32 address of the contract to block. It
+	// cannot be combined with code_id.
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// code_id is the code id of the contracts to block. It cannot be combined
+	// with contract_address.
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// remove deletes the entry from the blocklist so the contracts receive fee
+	// shares again.
+	Remove bool `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgSetBlocklistEntry) Reset()         { *m = MsgSetBlocklistEntry{} }
+func (m *MsgSetBlocklistEntry) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlocklistEntry) ProtoMessage()    {}
+func (*MsgSetBlocklistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{16}
+}
+func (m *MsgSetBlocklistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlocklistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlocklistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlocklistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlocklistEntry.Merge(m, src)
+}
+func (m *MsgSetBlocklistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlocklistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlocklistEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlocklistEntry proto.InternalMessageInfo
+
+func (m *MsgSetBlocklistEntry) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetBlocklistEntry) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgSetBlocklistEntry) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
+func (m *MsgSetBlocklistEntry) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+// MsgSetBlocklistEntryResponse defines the response structure for executing a
+// MsgSetBlocklistEntry message.
+type MsgSetBlocklistEntryResponse struct {
+}
+
+func (m *MsgSetBlocklistEntryResponse) Reset()         { *m = MsgSetBlocklistEntryResponse{} }
+func (m *MsgSetBlocklistEntryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBlocklistEntryResponse) ProtoMessage()    {}
+func (*MsgSetBlocklistEntryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_db5ab2575863a062, []int{17}
+}
+func (m *MsgSetBlocklistEntryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBlocklistEntryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBlocklistEntryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBlocklistEntryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBlocklistEntryResponse.Merge(m, src)
+}
+func (m *MsgSetBlocklistEntryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBlocklistEntryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBlocklistEntryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBlocklistEntryResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterFeeShare)(nil), "juno.feeshare.v1.MsgRegisterFeeShare")
+	proto.RegisterType((*MsgRegisterFeeShareResponse)(nil), "juno.feeshare.v1.MsgRegisterFeeShareResponse")
+	proto.RegisterType((*MsgUpdateFeeShare)(nil), "juno.feeshare.v1.MsgUpdateFeeShare")
+	proto.RegisterType((*MsgUpdateFeeShareResponse)(nil), "juno.feeshare.v1.MsgUpdateFeeShareResponse")
+	proto.RegisterType((*MsgCancelFeeShare)(nil), "juno.feeshare.v1.MsgCancelFeeShare")
+	proto.RegisterType((*MsgCancelFeeShareResponse)(nil), "juno.feeshare.v1.MsgCancelFeeShareResponse")
+	proto.RegisterType((*MsgProposeFeeShareOwner)(nil), "juno.feeshare.v1.MsgProposeFeeShareOwner")
+	proto.RegisterType((*MsgProposeFeeShareOwnerResponse)(nil), "juno.feeshare.v1.MsgProposeFeeShareOwnerResponse")
+	proto.RegisterType((*MsgAcceptFeeShareOwner)(nil), "juno.feeshare.v1.MsgAcceptFeeShareOwner")
+	proto.RegisterType((*MsgAcceptFeeShareOwnerResponse)(nil), "juno.feeshare.v1.MsgAcceptFeeShareOwnerResponse")
+	proto.RegisterType((*MsgWithdrawFeeShareRewards)(nil), "juno.feeshare.v1.MsgWithdrawFeeShareRewards")
+	proto.RegisterType((*MsgWithdrawFeeShareRewardsResponse)(nil), "juno.feeshare.v1.MsgWithdrawFeeShareRewardsResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "juno.feeshare.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "juno.feeshare.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetContractShareOverride)(nil), "juno.feeshare.v1.MsgSetContractShareOverride")
+	proto.RegisterType((*MsgSetContractShareOverrideResponse)(nil), "juno.feeshare.v1.MsgSetContractShareOverrideResponse")
+	proto.RegisterType((*MsgSetBlocklistEntry)(nil), "juno.feeshare.v1.MsgSetBlocklistEntry")
+	proto.RegisterType((*MsgSetBlocklistEntryResponse)(nil), "juno.feeshare.v1.MsgSetBlocklistEntryResponse")
+}
+
+func init() { proto.RegisterFile("juno/feeshare/v1/tx.proto", fileDescriptor_db5ab2575863a062) }
+
+var fileDescriptor_db5ab2575863a062 = []byte{
+	// 1033 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x8e, 0x31, 0x74, 0x82, 0x9a, 0x64, 0x1b, 0xd5, 0xf6, 0x26, 0xd8, 0xc9, 0xa6,
+	0x09, 0x4e, 0x5a, 0xef, 0x26, 0x01, 0x7a, 0xc8, 0xad, 0x4e, 0x41, 0x02, 0xc9, 0xa2, 0xda, 0x08,
+	0x21, 0x10, 0x92, 0xb5, 0xd9, 0x1d, 0xd6, 0x4b, 0xec, 0x9d, 0xd5, 0xcc, 0xd8, 0x6e, 0x6e, 0xa8,
+	0x12, 0x07, 0x6e, 0x45, 0x5c, 0x38, 0xf4, 0x50, 0xae, 0x88, 0x03, 0x07, 0x4e, 0xfc, 0x05, 0x3d,
+	0x56, 0x70, 0x41, 0x1c, 0x0a, 0x4a, 0x2a, 0xe0, 0xcf, 0x40, 0x3b, 0x3b, 0x3b, 0x8e, 0xe3, 0xd9,
+	0xe0, 0x56, 0xaa, 0x90, 0x38, 0x25, 0x3b, 0xef, 0x3b, 0xf3, 0x3e, 0xef, 0xc7, 0xcc, 0x4b, 0x60,
+	0xe5, 0xb3, 0x7e, 0x88, 0xad, 0x4f, 0x11, 0xa2, 0x1d, 0x87, 0x20, 0x6b, 0xb0, 0x63, 0xb1, 0xbb,
+	0x66, 0x44, 0x30, 0xc3, 0xda, 0x7c, 0x6c, 0x32, 0x53, 0x93, 0x39, 0xd8, 0xd1, 0x17, 0x7d, 0xec,
+	0x63, 0x6e, 0xb4, 0xe2, 0xdf, 0x12, 0x9d, 0x5e, 0x75, 0x31, 0xed, 0x61, 0x6a, 0x1d, 0x3a, 0x34,
+	0x3e, 0xe0, 0x10, 0x31, 0x67, 0xc7, 0x72, 0x71, 0x10, 0x0a, 0xfb, 0xb2, 0x8f, 0xb1, 0xdf, 0x45,
+	0x96, 0x13, 0x05, 0x96, 0x13, 0x86, 0x98, 0x39, 0x2c, 0xc0, 0x21, 0x15, 0xd6, 0x92, 0xd8, 0xdd,
+	0xa3, 0x7e, 0xec, 0xbd, 0x47, 0x7d, 0x61, 0xa8, 0x24, 0x86, 0x76, 0xe2, 0x2f, 0xf9, 0x48, 0x3d,
+	0x4e, 0x40, 0xfb, 0x28, 0x44, 0x34, 0x48, 0xed, 0xb5, 0x09, 0xbb, 0x8c, 0x82, 0x0b, 0x8c, 0x3f,
+	0x01, 0xbc, 0xd2, 0xa2, 0xbe, 0x8d, 0xfc, 0x80, 0x32, 0x44, 0xde, 0x41, 0xe8, 0x20, 0xb6, 0x6a,
+	0x9b, 0x70, 0xde, 0xc5, 0x21, 0x23, 0x8e, 0xcb, 0xda, 0x8e, 0xe7, 0x11, 0x44, 0x69, 0x19, 0xac,
+	0x80, 0xfa, 0x25, 0x7b, 0x2e, 0x5d, 0xbf, 0x95, 0x2c, 0xc7, 0x52, 0x0f, 0x45, 0x5d, 0x7c, 0x8c,
+	0x88, 0x94, 0xe6, 0x13, 0x69, 0xba, 0x9e, 0x4a, 0x1b, 0x50, 0x1b, 0x06, 0xac, 0xe3, 0x11, 0x67,
+	0x78, 0x46, 0x3c, 0xc3, 0xc5, 0x0b, 0x23, 0x4b, 0x2a, 0xbf, 0x0d, 0x67, 0x47, 0x8b, 0xb4, 0x5c,
+	0x58, 0x99, 0xa9, 0xcf, 0xee, 0x2e, 0x9b, 0xe7, 0xab, 0x61, 0x7e, 0x28, 0x45, 0xcd, 0xc2, 0xa3,
+	0x27, 0xb5, 0x9c, 0x7d, 0x76, 0xdb, 0x5e, 0xe1, 0xef, 0x87, 0xb5, 0x9c, 0xf1, 0x1a, 0x5c, 0x52,
+	0xc4, 0x69, 0x23, 0x1a, 0xe1, 0x90, 0x22, 0xe3, 0x29, 0x80, 0x0b, 0x2d, 0xea, 0x7f, 0x10, 0x79,
+	0x0e, 0x43, 0xff, 0xdf, 0x2c, 0x2c, 0xc1, 0xca, 0x44, 0x94, 0x32, 0x07, 0x98, 0xa7, 0x60, 0xdf,
+	0x09, 0x5d, 0xd4, 0x7d, 0xb1, 0x29, 0x18, 0xa3, 0x19, 0x77, 0x28, 0x69, 0xbe, 0x05, 0xb0, 0xd4,
+	0xa2, 0xfe, 0x1d, 0x82, 0x23, 0x4c, 0x25, 0xec, 0xfb, 0xc3, 0x10, 0x91, 0x17, 0x54, 0x97, 0x2d,
+	0xb8, 0x10, 0xa2, 0x61, 0x1b, 0x0f, 0xc3, 0x33, 0xda, 0xa4, 0x2c, 0x73, 0x21, 0x1a, 0x72, 0xd7,
+	0xe3, 0x01, 0xac, 0xc2, 0x5a, 0x06, 0xa2, 0x0c, 0xa3, 0x0f, 0xaf, 0xb6, 0xa8, 0x7f, 0xcb, 0x75,
+	0x51, 0xc4, 0x9e, 0x3b, 0x08, 0x25, 0x59, 0xfe, 0x22, 0xb2, 0x15, 0x58, 0x55, 0xbb, 0x95, 0x60,
+	0x1d, 0xa8, 0xb7, 0xa8, 0x9f, 0x36, 0xcd, 0x28, 0xfd, 0x43, 0x87, 0x78, 0x59, 0x3d, 0x0a, 0x32,
+	0x7a, 0x74, 0x6f, 0x29, 0x76, 0x7a, 0xef, 0xaf, 0x1f, 0xb6, 0x14, 0xbb, 0x8c, 0x2f, 0x01, 0x34,
+	0xb2, 0x5d, 0xa5, 0x40, 0x9a, 0x0b, 0x8b, 0x4e, 0x0f, 0xf7, 0x43, 0x56, 0x06, 0xbc, 0xc5, 0x2b,
+	0xa6, 0x78, 0xea, 0xe2, 0xe7, 0xd4, 0x14, 0xcf, 0xa9, 0xb9, 0x8f, 0x83, 0xb0, 0xb9, 0x1d, 0xf7,
+	0xf7, 0x77, 0xbf, 0xd7, 0xea, 0x7e, 0xc0, 0x3a, 0xfd, 0x43, 0xd3, 0xc5, 0x3d, 0xf1, 0x2e, 0x8a,
+	0x1f, 0x0d, 0xea, 0x1d, 0x59, 0xec, 0x38, 0x42, 0x94, 0x6f, 0xa0, 0xb6, 0x38, 0xda, 0xf8, 0x0a,
+	0xc0, 0x39, 0x79, 0x03, 0xee, 0x38, 0xc4, 0xe9, 0x51, 0xed, 0x26, 0xbc, 0xe4, 0xf4, 0x59, 0x07,
+	0x93, 0x80, 0x1d, 0x27, 0x21, 0x36, 0xcb, 0x3f, 0xff, 0xd8, 0x58, 0x14, 0xee, 0x45, 0x8c, 0x07,
+	0x8c, 0x04, 0xa1, 0x6f, 0x8f, 0xa4, 0xda, 0x4d, 0x58, 0x8c, 0xf8, 0x09, 0xbc, 0x14, 0xb3, 0xbb,
+	0xe5, 0xc9, 0x3b, 0x99, 0x78, 0x10, 0xf7, 0x51, 0xa8, 0xf7, 0x2e, 0xc7, 0x89, 0x1a, 0x9d, 0x63,
+	0x54, 0x60, 0xe9, 0x1c, 0x92, 0x2c, 0xd2, 0x83, 0x3c, 0x7f, 0xb6, 0x0e, 0x10, 0xdb, 0x17, 0x2d,
+	0x91, 0x54, 0x72, 0x80, 0x08, 0x09, 0x3c, 0xf4, 0xdc, 0xe8, 0xaa, 0xde, 0xcb, 0xab, 0x7b, 0xaf,
+	0x04, 0x5f, 0x76, 0xb1, 0x87, 0xda, 0x81, 0xc7, 0xef, 0x42, 0xc1, 0x2e, 0xc6, 0x9f, 0xef, 0x7a,
+	0xda, 0x47, 0xf1, 0xcd, 0x1a, 0xa0, 0x2e, 0x8e, 0x10, 0x69, 0xf3, 0x88, 0xe3, 0xc7, 0x29, 0x46,
+	0x30, 0xe3, 0x70, 0x7f, 0x7b, 0x52, 0xdb, 0x98, 0xa2, 0x3c, 0xb7, 0x91, 0x6b, 0xcf, 0xc9, 0x73,
+	0x78, 0x74, 0x54, 0xbb, 0x0a, 0x8b, 0x04, 0xf5, 0xf0, 0x00, 0x95, 0x5f, 0x5a, 0x01, 0xf5, 0x57,
+	0x6c, 0xf1, 0x35, 0x91, 0xb9, 0x75, 0xb8, 0x76, 0x41, 0x76, 0x64, 0x16, 0x7f, 0x02, 0x70, 0x31,
+	0xd1, 0x35, 0xbb, 0xd8, 0x3d, 0xea, 0x06, 0x94, 0xbd, 0x1d, 0x32, 0x72, 0xfc, 0x9f, 0xa6, 0x6f,
+	0x14, 0x63, 0xe1, 0xc2, 0x18, 0xab, 0x70, 0x59, 0xc5, 0x9e, 0x06, 0xb7, 0xfb, 0x05, 0x84, 0x33,
+	0x2d, 0xea, 0x6b, 0xdf, 0x00, 0x38, 0x3f, 0x31, 0xc6, 0xd7, 0x27, 0x5b, 0x52, 0x31, 0x05, 0xf5,
+	0xc6, 0x54, 0x32, 0x99, 0x4f, 0xf3, 0xde, 0x2f, 0x4f, 0xbf, 0xce, 0xd7, 0x8d, 0x0d, 0x4b, 0xf1,
+	0x37, 0x93, 0x45, 0xc4, 0xb6, 0xb6, 0xa4, 0xb8, 0x0f, 0xe0, 0xe5, 0x73, 0x93, 0x75, 0x4d, 0xe9,
+	0x71, 0x5c, 0xa4, 0x5f, 0x9f, 0x42, 0x24, 0xa1, 0x6e, 0x70, 0xa8, 0x0d, 0xe3, 0x9a, 0x12, 0xaa,
+	0xcf, 0x37, 0x8d, 0x23, 0x9d, 0x9b, 0x74, 0x6a, 0xa4, 0x71, 0x91, 0x7e, 0x7d, 0x0a, 0xd1, 0x94,
+	0x48, 0x2e, 0xdf, 0x34, 0x42, 0x7a, 0x08, 0xe0, 0xa2, 0x7a, 0xda, 0x29, 0x7d, 0xaa, 0xa4, 0xfa,
+	0xce, 0xd4, 0x52, 0x09, 0xb9, 0xc5, 0x21, 0xaf, 0x19, 0x86, 0x12, 0x32, 0x4a, 0xb6, 0x26, 0xa3,
+	0x47, 0x7b, 0x00, 0xe0, 0x15, 0xd5, 0x28, 0xab, 0x2b, 0xdd, 0x2a, 0x94, 0xfa, 0xf6, 0xb4, 0x4a,
+	0xc9, 0xb7, 0xc9, 0xf9, 0xd6, 0x8c, 0x55, 0x25, 0x9f, 0xc3, 0x77, 0x0a, 0xbc, 0xef, 0x01, 0x2c,
+	0x65, 0x0d, 0xb4, 0x1b, 0x4a, 0xc7, 0x19, 0x6a, 0xfd, 0xcd, 0x67, 0x51, 0x4b, 0xd4, 0x06, 0x47,
+	0x7d, 0xdd, 0x58, 0x57, 0xa2, 0xa6, 0x93, 0xb1, 0x4d, 0x04, 0xd2, 0x27, 0xf0, 0xd5, 0xb1, 0x39,
+	0xb4, 0x7a, 0x41, 0xbb, 0x27, 0x12, 0x7d, 0xf3, 0x5f, 0x25, 0x72, 0x9c, 0x7e, 0x0e, 0x60, 0x39,
+	0x73, 0x6e, 0xa8, 0x2f, 0x7c, 0x96, 0x5c, 0x7f, 0xeb, 0x99, 0xe4, 0x12, 0xe1, 0x08, 0x2e, 0x4c,
+	0xbe, 0xb9, 0x1b, 0x59, 0x67, 0x8d, 0xeb, 0x74, 0x73, 0x3a, 0x5d, 0xea, 0xac, 0xf9, 0xde, 0xa3,
+	0x93, 0x2a, 0x78, 0x7c, 0x52, 0x05, 0x7f, 0x9c, 0x54, 0xc1, 0xfd, 0xd3, 0x6a, 0xee, 0xf1, 0x69,
+	0x35, 0xf7, 0xeb, 0x69, 0x35, 0xf7, 0xf1, 0xf6, 0x99, 0x31, 0xb4, 0xcf, 0x5f, 0xf6, 0x14, 0x9b,
+	0x26, 0x85, 0xba, 0x3b, 0x2a, 0x15, 0x1f, 0x4a, 0x87, 0x45, 0xfe, 0xcf, 0xd1, 0x1b, 0xff, 0x0c,
+	0x00, 0x7d, 0xbc, 0x8c, 0x06, 0x14, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterFeeShare registers a new contract for receiving transaction fees
+	RegisterFeeShare(ctx context.Context, in *MsgRegisterFeeShare, opts ...grpc.CallOption) (*MsgRegisterFeeShareResponse, error)
+	// UpdateFeeShare updates the withdrawers of a FeeShare
+	UpdateFeeShare(ctx context.Context, in *MsgUpdateFeeShare, opts ...grpc.CallOption) (*MsgUpdateFeeShareResponse, error)
+	// CancelFeeShare cancels a contract's fee registration and further receival
+	// of transaction fees
+	CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, opts ...grpc.CallOption) (*MsgCancelFeeShareResponse, error)
+	// ProposeFeeShareOwner proposes a new owner for a FeeShare
+	ProposeFeeShareOwner(ctx context.Context, in *MsgProposeFeeShareOwner, opts ...grpc.CallOption) (*MsgProposeFeeShareOwnerResponse, error)
+	// AcceptFeeShareOwner accepts the ownership of a FeeShare proposed by its
+	// current owner
+	AcceptFeeShareOwner(ctx context.Context, in *MsgAcceptFeeShareOwner, opts ...grpc.CallOption) (*MsgAcceptFeeShareOwnerResponse, error)
+	// WithdrawFeeShareRewards pays out the fees accrued by a withdrawer
+	WithdrawFeeShareRewards(ctx context.Context, in *MsgWithdrawFeeShareRewards, opts ...grpc.CallOption) (*MsgWithdrawFeeShareRewardsResponse, error)
+	// Update the params of the module through gov v1 type.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetContractShareOverride sets or removes the developer shares of a
+	// contract or a code id through gov v1 type.
+	SetContractShareOverride(ctx context.Context, in *MsgSetContractShareOverride, opts ...grpc.CallOption) (*MsgSetContractShareOverrideResponse, error)
+	// SetBlocklistEntry blocks or unblocks a contract or a code id from
+	// receiving fee shares through gov v1 type.
+	SetBlocklistEntry(ctx context.Context, in *MsgSetBlocklistEntry, opts ...grpc.CallOption) (*MsgSetBlocklistEntryResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterFeeShare(ctx context.Context, in *MsgRegisterFeeShare, opts ...grpc.CallOption) (*MsgRegisterFeeShareResponse, error) {
+	out := new(MsgRegisterFeeShareResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/RegisterFeeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *msgClient) UpdateFeeShare(ctx context.Context, in *MsgUpdateFeeShare, opts ...grpc.CallOption) (*MsgUpdateFeeShareResponse, error) {
@@ -540,6 +1145,33 @@ func (c *msgClient) CancelFeeShare(ctx context.Context, in *MsgCancelFeeShare, o
 	return out, nil
 }
 
+func (c *msgClient) ProposeFeeShareOwner(ctx context.Context, in *MsgProposeFeeShareOwner, opts ...grpc.CallOption) (*MsgProposeFeeShareOwnerResponse, error) {
+	out := new(MsgProposeFeeShareOwnerResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/ProposeFeeShareOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptFeeShareOwner(ctx context.Context, in *MsgAcceptFeeShareOwner, opts ...grpc.CallOption) (*MsgAcceptFeeShareOwnerResponse, error) {
+	out := new(MsgAcceptFeeShareOwnerResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/AcceptFeeShareOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawFeeShareRewards(ctx context.Context, in *MsgWithdrawFeeShareRewards, opts ...grpc.CallOption) (*MsgWithdrawFeeShareRewardsResponse, error) {
+	out := new(MsgWithdrawFeeShareRewardsResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/WithdrawFeeShareRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/UpdateParams", in, out, opts...)
@@ -549,17 +1181,48 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetContractShareOverride(ctx context.Context, in *MsgSetContractShareOverride, opts ...grpc.CallOption) (*MsgSetContractShareOverrideResponse, error) {
+	out := new(MsgSetContractShareOverrideResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/SetContractShareOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBlocklistEntry(ctx context.Context, in *MsgSetBlocklistEntry, opts ...grpc.CallOption) (*MsgSetBlocklistEntryResponse, error) {
+	out := new(MsgSetBlocklistEntryResponse)
+	err := c.cc.Invoke(ctx, "/juno.feeshare.v1.Msg/SetBlocklistEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RegisterFeeShare registers a new contract for receiving transaction fees
 	RegisterFeeShare(context.Context, *MsgRegisterFeeShare) (*MsgRegisterFeeShareResponse, error)
-	// UpdateFeeShare updates the withdrawer address of a FeeShare
+	// UpdateFeeShare updates the withdrawers of a FeeShare
 	UpdateFeeShare(context.Context, *MsgUpdateFeeShare) (*MsgUpdateFeeShareResponse, error)
 	// CancelFeeShare cancels a contract's fee registration and further receival
 	// of transaction fees
 	CancelFeeShare(context.Context, *MsgCancelFeeShare) (*MsgCancelFeeShareResponse, error)
+	// ProposeFeeShareOwner proposes a new owner for a FeeShare
+	ProposeFeeShareOwner(context.Context, *MsgProposeFeeShareOwner) (*MsgProposeFeeShareOwnerResponse, error)
+	// AcceptFeeShareOwner accepts the ownership of a FeeShare proposed by its
+	// current owner
+	AcceptFeeShareOwner(context.Context, *MsgAcceptFeeShareOwner) (*MsgAcceptFeeShareOwnerResponse, error)
+	// WithdrawFeeShareRewards pays out the fees accrued by a withdrawer
+	WithdrawFeeShareRewards(context.Context, *MsgWithdrawFeeShareRewards) (*MsgWithdrawFeeShareRewardsResponse, error)
 	// Update the params of the module through gov v1 type.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetContractShareOverride sets or removes the developer shares of a
+	// contract or a code id through gov v1 type.
+	SetContractShareOverride(context.Context, *MsgSetContractShareOverride) (*MsgSetContractShareOverrideResponse, error)
+	// SetBlocklistEntry blocks or unblocks a contract or a code id from
+	// receiving fee shares through gov v1 type.
+	SetBlocklistEntry(context.Context, *MsgSetBlocklistEntry) (*MsgSetBlocklistEntryResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -575,9 +1238,24 @@ func (*UnimplementedMsgServer) UpdateFeeShare(ctx context.Context, req *MsgUpdat
 func (*UnimplementedMsgServer) CancelFeeShare(ctx context.Context, req *MsgCancelFeeShare) (*MsgCancelFeeShareResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelFeeShare not implemented")
 }
+func (*UnimplementedMsgServer) ProposeFeeShareOwner(ctx context.Context, req *MsgProposeFeeShareOwner) (*MsgProposeFeeShareOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeFeeShareOwner not implemented")
+}
+func (*UnimplementedMsgServer) AcceptFeeShareOwner(ctx context.Context, req *MsgAcceptFeeShareOwner) (*MsgAcceptFeeShareOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFeeShareOwner not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFeeShareRewards(ctx context.Context, req *MsgWithdrawFeeShareRewards) (*MsgWithdrawFeeShareRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFeeShareRewards not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetContractShareOverride(ctx context.Context, req *MsgSetContractShareOverride) (*MsgSetContractShareOverrideResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractShareOverride not implemented")
+}
+func (*UnimplementedMsgServer) SetBlocklistEntry(ctx context.Context, req *MsgSetBlocklistEntry) (*MsgSetBlocklistEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlocklistEntry not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -637,6 +1315,60 @@ func _Msg_CancelFeeShare_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ProposeFeeShareOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeFeeShareOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeFeeShareOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/ProposeFeeShareOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeFeeShareOwner(ctx, req.(*MsgProposeFeeShareOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptFeeShareOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptFeeShareOwner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptFeeShareOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/AcceptFeeShareOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptFeeShareOwner(ctx, req.(*MsgAcceptFeeShareOwner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFeeShareRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFeeShareRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFeeShareRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/WithdrawFeeShareRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFeeShareRewards(ctx, req.(*MsgWithdrawFeeShareRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -655,6 +1387,43 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetContractShareOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractShareOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractShareOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/SetContractShareOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractShareOverride(ctx, req.(*MsgSetContractShareOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBlocklistEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlocklistEntry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlocklistEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/juno.feeshare.v1.Msg/SetBlocklistEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlocklistEntry(ctx, req.(*MsgSetBlocklistEntry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "juno.feeshare.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -671,10 +1440,30 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelFeeShare",
 			Handler:    _Msg_CancelFeeShare_Handler,
 		},
+		{
+			MethodName: "ProposeFeeShareOwner",
+			Handler:    _Msg_ProposeFeeShareOwner_Handler,
+		},
+		{
+			MethodName: "AcceptFeeShareOwner",
+			Handler:    _Msg_AcceptFeeShareOwner_Handler,
+		},
+		{
+			MethodName: "WithdrawFeeShareRewards",
+			Handler:    _Msg_WithdrawFeeShareRewards_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetContractShareOverride",
+			Handler:    _Msg_SetContractShareOverride_Handler,
+		},
+		{
+			MethodName: "SetBlocklistEntry",
+			Handler:    _Msg_SetBlocklistEntry_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "juno/feeshare/v1/tx.proto",
@@ -700,6 +1489,20 @@ func (m *MsgRegisterFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.WithdrawerAddress) > 0 {
 		i -= len(m.WithdrawerAddress)
 		copy(dAtA[i:], m.WithdrawerAddress)
@@ -767,9 +1570,23 @@ func (m *MsgUpdateFeeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawerAddress) > 0 {
-		i -= len(m.WithdrawerAddress)
-		copy(dAtA[i:], m.WithdrawerAddress)
+	if len(m.Withdrawers) > 0 {
+		for iNdEx := len(m.Withdrawers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
 		i--
 		dAtA[i] = 0x1a
@@ -874,7 +1691,7 @@ func (m *MsgCancelFeeShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposeFeeShareOwner) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -884,37 +1701,41 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposeFeeShareOwner) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposeFeeShareOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.NewOwnerAddress) > 0 {
+		i -= len(m.NewOwnerAddress)
+		copy(dAtA[i:], m.NewOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgProposeFeeShareOwnerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -924,12 +1745,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgProposeFeeShareOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgProposeFeeShareOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -937,134 +1758,1270 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAcceptFeeShareOwner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterFeeShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgAcceptFeeShareOwner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptFeeShareOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.NewOwnerAddress) > 0 {
+		i -= len(m.NewOwnerAddress)
+		copy(dAtA[i:], m.NewOwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwnerAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRegisterFeeShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAcceptFeeShareOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptFeeShareOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptFeeShareOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateFeeShare) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFeeShareRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeShareRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeShareRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.WithdrawerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateFeeShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawFeeShareRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFeeShareRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFeeShareRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelFeeShare) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.DeployerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelFeeShareResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetContractShareOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSetContractShareOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozTx(x uint64) (n int) {
+
+func (m *MsgSetContractShareOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.DeveloperShares.Size()
+		i -= size
+		if _, err := m.DeveloperShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractShareOverrideResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractShareOverrideResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractShareOverrideResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocklistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocklistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocklistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBlocklistEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBlocklistEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBlocklistEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Withdrawers) > 0 {
+		for _, e := range m.Withdrawers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelFeeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeFeeShareOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeFeeShareOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptFeeShareOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptFeeShareOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawFeeShareRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFeeShareRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetContractShareOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	l = m.DeveloperShares.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetContractShareOverrideResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBlocklistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTx(uint64(m.CodeId))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBlocklistEntryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawers = append(m.Withdrawers, Withdrawer{})
+			if err := m.Withdrawers[len(m.Withdrawers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeeShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelFeeShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeFeeShareOwner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1087,10 +3044,10 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeFeeShareOwner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeFeeShareOwner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1152,12 +3109,308 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeFeeShareOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeFeeShareOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeFeeShareOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptFeeShareOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptFeeShareOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptFeeShareOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptFeeShareOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptFeeShareOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptFeeShareOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFeeShareRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFeeShareRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFeeShareRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
 			}
@@ -1210,7 +3463,7 @@ func (m *MsgRegisterFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawFeeShareRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,12 +3486,46 @@ func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawFeeShareRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawFeeShareRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1260,7 +3547,7 @@ func (m *MsgRegisterFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1283,15 +3570,15 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1319,13 +3606,13 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1335,55 +3622,24 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1406,7 +3662,7 @@ func (m *MsgUpdateFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,10 +3685,10 @@ func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1456,7 +3712,7 @@ func (m *MsgUpdateFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractShareOverride) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1479,13 +3735,45 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractShareOverride: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractShareOverride: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
@@ -1517,9 +3805,28 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1547,8 +3854,30 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1570,7 +3899,7 @@ func (m *MsgCancelFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetContractShareOverrideResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1593,10 +3922,10 @@ func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelFeeShareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetContractShareOverrideResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetContractShareOverrideResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1620,7 +3949,7 @@ func (m *MsgCancelFeeShareResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBlocklistEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1643,10 +3972,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBlocklistEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBlocklistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1683,9 +4012,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1695,25 +4024,63 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1735,7 +4102,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetBlocklistEntryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1758,10 +4125,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetBlocklistEntryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetBlocklistEntryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
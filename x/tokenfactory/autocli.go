@@ -0,0 +1,60 @@
+package tokenfactory
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: "osmosis.tokenfactory.v1beta1.Query",
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Get the params for the x/tokenfactory module",
+				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Get the authority metadata for a specific denom, including its roles and renounced capabilities",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator address]",
+					Short:          "Returns a list of all tokens created by a specific creator address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "BeforeSendHookAddress",
+					Use:            "before-send-hook [denom]",
+					Short:          "Get the before send hook contract of a specific denom, along with its version, gas limits and consecutive failures",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomMaxSupply",
+					Use:            "denom-max-supply [denom]",
+					Short:          "Get the max supply of a specific denom, which is zero when the denom has no max supply",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomFreezeList",
+					Use:            "denom-freeze-list [denom]",
+					Short:          "Get the frozen addresses of a specific denom and whether its transfers are paused",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "FrozenAddress",
+					Use:            "frozen-address [denom] [address]",
+					Short:          "Get whether an address is frozen for a specific denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: "osmosis.tokenfactory.v1beta1.Msg",
+		},
+	}
+}
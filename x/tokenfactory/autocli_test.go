@@ -0,0 +1,34 @@
+package tokenfactory_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/terra-money/core/v2/x/tokenfactory"
+)
+
+// TestAutoCLIOptions tests that the autocli options refer to existing
+// services, methods and request fields.
+func TestAutoCLIOptions(t *testing.T) {
+	options := tokenfactory.AppModule{}.AutoCLIOptions()
+
+	for _, service := range []string{options.Query.Service, options.Tx.Service} {
+		desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(service))
+		require.NoError(t, err, service)
+		require.Implements(t, (*protoreflect.ServiceDescriptor)(nil), desc, service)
+	}
+
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(options.Query.Service))
+	require.NoError(t, err)
+	methods := desc.(protoreflect.ServiceDescriptor).Methods()
+	for _, rpcOptions := range options.Query.RpcCommandOptions {
+		method := methods.ByName(protoreflect.Name(rpcOptions.RpcMethod))
+		require.NotNil(t, method, rpcOptions.RpcMethod)
+		for _, arg := range rpcOptions.PositionalArgs {
+			require.NotNil(t, method.Input().Fields().ByName(protoreflect.Name(arg.ProtoField)), arg.ProtoField)
+		}
+	}
+}
@@ -13,7 +13,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/terra-money/core/v2/app"
+	tokenfactorybindings "github.com/terra-money/core/v2/x/tokenfactory/bindings"
 	bindings "github.com/terra-money/core/v2/x/tokenfactory/bindings/types"
+	tokenfactorykeeper "github.com/terra-money/core/v2/x/tokenfactory/keeper"
 	"github.com/terra-money/core/v2/x/tokenfactory/types"
 )
 
@@ -360,6 +362,484 @@ func TestMintMsg(t *testing.T) {
 	require.Equal(t, resp.Denom, coin.Denom)
 }
 
+func TestMintOverMaxSupplyMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	// Create denom for minting
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	// cap the supply of the denom
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(app.Keepers.TokenFactoryKeeper)
+	_, err = msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), types.NewMsgSetMaxSupply(reflect.String(), sunDenom, sdk.NewInt(1000)))
+	require.NoError(t, err)
+
+	msg = bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(1001),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.Error(t, err)
+
+	msg.MintTokens.Amount = sdk.NewInt(1000)
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	balances := app.Keepers.BankKeeper.GetAllBalances(ctx, lucky)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 1000)), balances)
+}
+
+func TestSetDenomRoleMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	// Create denom for minting
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	// grant the minter and metadata manager roles to lucky
+	allowance := sdk.NewInt(500)
+	msg = bindings.TokenMsg{SetDenomRole: &bindings.SetDenomRole{
+		Denom:           sunDenom,
+		Role:            "minter",
+		Address:         lucky.String(),
+		MinterAllowance: &allowance,
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	msg = bindings.TokenMsg{SetDenomRole: &bindings.SetDenomRole{
+		Denom:   sunDenom,
+		Role:    "metadata_manager",
+		Address: lucky.String(),
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	// an unknown role is rejected
+	msg = bindings.TokenMsg{SetDenomRole: &bindings.SetDenomRole{
+		Denom:   sunDenom,
+		Role:    "admin",
+		Address: lucky.String(),
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.Error(t, err)
+
+	// only the admin grants roles
+	msg = bindings.TokenMsg{SetDenomRole: &bindings.SetDenomRole{
+		Denom:   sunDenom,
+		Role:    "burner",
+		Address: lucky.String(),
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	authorityMetadata, err := app.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(ctx, sunDenom)
+	require.NoError(t, err)
+	require.Equal(t, reflect.String(), authorityMetadata.Admin)
+	require.Equal(t, lucky.String(), authorityMetadata.Minter)
+	require.Equal(t, lucky.String(), authorityMetadata.MetadataManager)
+	require.Equal(t, &allowance, authorityMetadata.MinterAllowance)
+
+	// the contract is no longer allowed to mint nor to set the metadata
+	msg = bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.Error(t, err)
+
+	msg = bindings.TokenMsg{SetMetadata: &bindings.SetMetadata{
+		Denom: sunDenom,
+		Metadata: bindings.Metadata{
+			Description: "Awesome token, get it now!",
+			DenomUnits:  []bindings.DenomUnit{{Denom: sunDenom, Exponent: 0}},
+			Base:        sunDenom,
+			Display:     sunDenom,
+			Name:        "SUN",
+			Symbol:      "SUN",
+		},
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.Error(t, err)
+
+	// lucky mints up to its allowance
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(app.Keepers.TokenFactoryKeeper)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(lucky.String(), sdk.NewInt64Coin(sunDenom, 500)))
+	require.NoError(t, err)
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMint(lucky.String(), sdk.NewInt64Coin(sunDenom, 1)))
+	require.ErrorIs(t, err, types.ErrMinterAllowanceExceeded)
+
+	balances := app.Keepers.BankKeeper.GetAllBalances(ctx, lucky)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 500)), balances)
+}
+
+func TestRenounceCapabilityMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	// Create denom for minting
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	mintMsg := bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, mintMsg, sdk.Coin{})
+	require.NoError(t, err)
+
+	// only the admin renounces capabilities
+	msg = bindings.TokenMsg{RenounceCapability: &bindings.RenounceCapability{
+		Denom:      sunDenom,
+		Capability: "mint",
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	// an unknown capability is rejected
+	msg = bindings.TokenMsg{RenounceCapability: &bindings.RenounceCapability{
+		Denom:      sunDenom,
+		Capability: "burn",
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.Error(t, err)
+
+	authorityMetadata, err := app.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(ctx, sunDenom)
+	require.NoError(t, err)
+	require.True(t, authorityMetadata.MintRenounced)
+	require.False(t, authorityMetadata.BurnFromRenounced)
+
+	// the contract can no longer mint
+	err = executeCustom(t, ctx, app, reflect, lucky, mintMsg, sdk.Coin{})
+	require.Error(t, err)
+
+	balances := app.Keepers.BankKeeper.GetAllBalances(ctx, lucky)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 100)), balances)
+}
+
+func TestFreezeMsgs(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	// Create denom and mint to lucky
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	msg = bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	// only the admin freezes addresses
+	msg = bindings.TokenMsg{SetFrozenAddress: &bindings.SetFrozenAddress{
+		Denom:   sunDenom,
+		Address: lucky.String(),
+		Frozen:  true,
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	frozenRes := bindings.FrozenAddressResponse{}
+	err = querierCustom(t, ctx, app, bindings.TokenQuery{FrozenAddress: &bindings.FrozenAddress{
+		Denom:   sunDenom,
+		Address: lucky.String(),
+	}}, &frozenRes)
+	require.NoError(t, err)
+	require.True(t, frozenRes.Frozen)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 10))
+	err = app.Keepers.BankKeeper.SendCoins(ctx, lucky, creator, coins)
+	require.ErrorIs(t, err, types.ErrAddressFrozen)
+
+	// pause the denom and unfreeze lucky
+	msg = bindings.TokenMsg{SetDenomPaused: &bindings.SetDenomPaused{
+		Denom:  sunDenom,
+		Paused: true,
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	msg = bindings.TokenMsg{SetFrozenAddress: &bindings.SetFrozenAddress{
+		Denom:   sunDenom,
+		Address: lucky.String(),
+		Frozen:  false,
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	freezeListRes := bindings.FreezeListResponse{}
+	err = querierCustom(t, ctx, app, bindings.TokenQuery{FreezeList: &bindings.FreezeList{
+		Denom: sunDenom,
+	}}, &freezeListRes)
+	require.NoError(t, err)
+	require.Equal(t, bindings.FreezeListResponse{Paused: true, FrozenAddresses: []string{}}, freezeListRes)
+
+	err = app.Keepers.BankKeeper.SendCoins(ctx, lucky, creator, coins)
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// resume the transfers
+	msg = bindings.TokenMsg{SetDenomPaused: &bindings.SetDenomPaused{
+		Denom:  sunDenom,
+		Paused: false,
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	err = app.Keepers.BankKeeper.SendCoins(ctx, lucky, creator, coins)
+	require.NoError(t, err)
+}
+
+func TestForceTransferMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	// Create denom and mint to lucky
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	msg = bindings.TokenMsg{MintTokens: &bindings.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdk.NewInt(100),
+		MintToAddress: lucky.String(),
+	}}
+	err = executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+
+	// only the force transferrer moves tokens of other addresses
+	msg = bindings.TokenMsg{ForceTransfer: &bindings.ForceTransfer{
+		Denom:       sunDenom,
+		Amount:      sdk.NewInt(40),
+		FromAddress: lucky.String(),
+		ToAddress:   creator.String(),
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt64Coin(sunDenom, 60), app.Keepers.BankKeeper.GetBalance(ctx, lucky, sunDenom))
+	require.Equal(t, sdk.NewInt64Coin(sunDenom, 40), app.Keepers.BankKeeper.GetBalance(ctx, creator, sunDenom))
+
+	// can't transfer more than the balance
+	msg.ForceTransfer.Amount = sdk.NewInt(100)
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.Error(t, err)
+
+	// the authority metadata shows the renounced capability
+	msg = bindings.TokenMsg{RenounceCapability: &bindings.RenounceCapability{
+		Denom:      sunDenom,
+		Capability: "force_transfer",
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	metadataRes := bindings.AuthorityMetadataResponse{}
+	err = querierCustom(t, ctx, app, bindings.TokenQuery{AuthorityMetadata: &bindings.GetAuthorityMetadata{
+		Denom: sunDenom,
+	}}, &metadataRes)
+	require.NoError(t, err)
+	require.Equal(t, bindings.AuthorityMetadata{
+		Admin:                  reflect.String(),
+		Minter:                 reflect.String(),
+		Burner:                 reflect.String(),
+		ForceTransferrer:       reflect.String(),
+		MetadataManager:        reflect.String(),
+		HookManager:            reflect.String(),
+		ForceTransferRenounced: true,
+	}, metadataRes.AuthorityMetadata)
+
+	msg = bindings.TokenMsg{ForceTransfer: &bindings.ForceTransfer{
+		Denom:       sunDenom,
+		Amount:      sdk.NewInt(10),
+		FromAddress: lucky.String(),
+		ToAddress:   creator.String(),
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.ErrorIs(t, err, types.ErrCapabilityRenounced)
+}
+
+func TestSetBeforeSendHookMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+	hook := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, hook)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	hookQuery := bindings.TokenQuery{BeforeSendHook: &bindings.BeforeSendHook{
+		Denom: sunDenom,
+	}}
+	hookRes := bindings.BeforeSendHookResponse{}
+	err = querierCustom(t, ctx, app, hookQuery, &hookRes)
+	require.NoError(t, err)
+	require.Equal(t, bindings.BeforeSendHookResponse{Version: "v1"}, hookRes)
+
+	// only the hook manager sets the hook
+	msg = bindings.TokenMsg{SetBeforeSendHook: &bindings.SetBeforeSendHook{
+		Denom:        sunDenom,
+		ContractAddr: hook.String(),
+		Version:      "v2",
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	err = querierCustom(t, ctx, app, hookQuery, &hookRes)
+	require.NoError(t, err)
+	require.Equal(t, bindings.BeforeSendHookResponse{ContractAddr: hook.String(), Version: "v2"}, hookRes)
+
+	// unknown versions are rejected
+	msg.SetBeforeSendHook.Version = "v3"
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.ErrorIs(t, err, types.ErrInvalidHookVersion)
+
+	// an empty contract address removes the hook
+	msg = bindings.TokenMsg{SetBeforeSendHook: &bindings.SetBeforeSendHook{
+		Denom: sunDenom,
+	}}
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	err = querierCustom(t, ctx, app, hookQuery, &hookRes)
+	require.NoError(t, err)
+	require.Equal(t, bindings.BeforeSendHookResponse{Version: "v1"}, hookRes)
+}
+
+func TestMintBatchMsg(t *testing.T) {
+	creator := RandomAccountAddress()
+	app, ctx := SetupCustomApp(t, creator)
+
+	lucky := RandomAccountAddress()
+	reflect := instantiateReflectContract(t, ctx, app, lucky)
+	require.NotEmpty(t, reflect)
+
+	// Fund reflect contract with 100 base denom creation fees
+	reflectAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	fundAccount(t, ctx, app, reflect, reflectAmount)
+
+	msg := bindings.TokenMsg{CreateDenom: &bindings.CreateDenom{
+		Subdenom: "SUN",
+	}}
+	err := executeCustom(t, ctx, app, reflect, lucky, msg, sdk.Coin{})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/%s", reflect.String(), msg.CreateDenom.Subdenom)
+
+	// only the minter mints batches
+	msg = bindings.TokenMsg{MintBatch: &bindings.MintBatch{
+		Denom: sunDenom,
+		Recipients: []bindings.MintBatchRecipient{
+			{Address: lucky.String(), Amount: sdk.NewInt(100)},
+			{Address: creator.String(), Amount: sdk.NewInt(250)},
+		},
+	}}
+	err = dispatchCustom(t, ctx, app, lucky, msg)
+	require.Error(t, err)
+
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt64Coin(sunDenom, 100), app.Keepers.BankKeeper.GetBalance(ctx, lucky, sunDenom))
+	require.Equal(t, sdk.NewInt64Coin(sunDenom, 250), app.Keepers.BankKeeper.GetBalance(ctx, creator, sunDenom))
+
+	// a batch without recipients is rejected
+	msg.MintBatch.Recipients = nil
+	err = dispatchCustom(t, ctx, app, reflect, msg)
+	require.ErrorIs(t, err, types.ErrInvalidMintBatch)
+}
+
 func TestBurnMsg(t *testing.T) {
 	creator := RandomAccountAddress()
 	app, ctx := SetupCustomApp(t, creator)
@@ -459,3 +939,14 @@ func executeCustom(t *testing.T, ctx sdk.Context, app *app.TerraApp, contract sd
 	_, err = contractKeeper.Execute(ctx, contract, sender, reflectBz, coins)
 	return err
 }
+
+// dispatchCustom dispatches the message as if the contract emitted it, for
+// the messages that the reflect contract doesn't know how to reflect.
+func dispatchCustom(t *testing.T, ctx sdk.Context, app *app.TerraApp, contract sdk.AccAddress, msg bindings.TokenMsg) error {
+	customBz, err := json.Marshal(bindings.TokenFactoryMsg{Token: &msg})
+	require.NoError(t, err)
+
+	messenger := tokenfactorybindings.CustomMessageDecorator(&app.Keepers.BankKeeper.BaseKeeper, &app.Keepers.TokenFactoryKeeper)(nil)
+	_, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
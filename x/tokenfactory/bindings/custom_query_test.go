@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/terra-money/core/v2/app"
+	tokenfactorybindings "github.com/terra-money/core/v2/x/tokenfactory/bindings"
 	bindings "github.com/terra-money/core/v2/x/tokenfactory/bindings/types"
 )
 
@@ -177,3 +178,19 @@ func queryCustom(t *testing.T, ctx sdk.Context, app *app.TerraApp, contract sdk.
 
 	return nil
 }
+
+// querierCustom runs the query as if a contract requested it, for the
+// queries that the reflect contract doesn't know how to reflect.
+func querierCustom(t *testing.T, ctx sdk.Context, app *app.TerraApp, request bindings.TokenQuery, response interface{}) error {
+	t.Helper()
+	requestBz, err := json.Marshal(bindings.TokenFactoryQuery{Token: &request})
+	require.NoError(t, err)
+
+	querier := tokenfactorybindings.CustomQuerier(tokenfactorybindings.NewQueryPlugin(&app.Keepers.BankKeeper.BaseKeeper, &app.Keepers.TokenFactoryKeeper))
+	resBz, err := querier(ctx, requestBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBz, response)
+}
@@ -65,6 +65,27 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if tokenMsg.SetMetadata != nil {
 			return m.setMetadata(ctx, contractAddr, tokenMsg.SetMetadata)
 		}
+		if tokenMsg.SetDenomRole != nil {
+			return m.setDenomRole(ctx, contractAddr, tokenMsg.SetDenomRole)
+		}
+		if tokenMsg.RenounceCapability != nil {
+			return m.renounceCapability(ctx, contractAddr, tokenMsg.RenounceCapability)
+		}
+		if tokenMsg.SetFrozenAddress != nil {
+			return m.setFrozenAddress(ctx, contractAddr, tokenMsg.SetFrozenAddress)
+		}
+		if tokenMsg.SetDenomPaused != nil {
+			return m.setDenomPaused(ctx, contractAddr, tokenMsg.SetDenomPaused)
+		}
+		if tokenMsg.ForceTransfer != nil {
+			return m.forceTransfer(ctx, contractAddr, tokenMsg.ForceTransfer)
+		}
+		if tokenMsg.SetBeforeSendHook != nil {
+			return m.setBeforeSendHook(ctx, contractAddr, tokenMsg.SetBeforeSendHook)
+		}
+		if tokenMsg.MintBatch != nil {
+			return m.mintBatch(ctx, contractAddr, tokenMsg.MintBatch)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -228,13 +249,13 @@ func (m *CustomMessenger) setMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata bindingstypes.Metadata) error {
-	// ensure contract address is admin of denom
+	// ensure contract address is metadata manager of denom
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if !auth.HasRole(tokenfactorytypes.DenomRoleMetadataManager, contractAddr.String()) {
+		return wasmvmtypes.InvalidRequest{Err: "only metadata manager can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -255,6 +276,228 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b *bankkeeper.BaseKeeper,
 	return nil
 }
 
+// setDenomRole grants or revokes a role over a denom.
+func (m *CustomMessenger) setDenomRole(ctx sdk.Context, contractAddr sdk.AccAddress, setDenomRole *bindingstypes.SetDenomRole) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetDenomRole(m.tokenFactory, ctx, contractAddr, setDenomRole)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set denom role")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetDenomRole is used with setDenomRole to validate setDenomRole messages and to dispatch.
+func PerformSetDenomRole(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setDenomRole *bindingstypes.SetDenomRole) error {
+	if setDenomRole == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set denom role null"}
+	}
+	role, err := tokenfactorytypes.DenomRoleFromString(setDenomRole.Role)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomRole(contractAddr.String(), setDenomRole.Denom, role, setDenomRole.Address, setDenomRole.MinterAllowance)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.SetDenomRole(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting denom role from message")
+	}
+	return nil
+}
+
+// renounceCapability permanently renounces a capability over a denom.
+func (m *CustomMessenger) renounceCapability(ctx sdk.Context, contractAddr sdk.AccAddress, renounceCapability *bindingstypes.RenounceCapability) ([]sdk.Event, [][]byte, error) {
+	err := PerformRenounceCapability(m.tokenFactory, ctx, contractAddr, renounceCapability)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform renounce capability")
+	}
+	return nil, nil, nil
+}
+
+// PerformRenounceCapability is used with renounceCapability to validate renounceCapability messages and to dispatch.
+func PerformRenounceCapability(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, renounceCapability *bindingstypes.RenounceCapability) error {
+	if renounceCapability == nil {
+		return wasmvmtypes.InvalidRequest{Err: "renounce capability null"}
+	}
+	capability, err := tokenfactorytypes.DenomCapabilityFromString(renounceCapability.Capability)
+	if err != nil {
+		return err
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgRenounceCapability(contractAddr.String(), renounceCapability.Denom, capability)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err = msgServer.RenounceCapability(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "renouncing capability from message")
+	}
+	return nil
+}
+
+// setFrozenAddress freezes or unfreezes an address for a denom.
+func (m *CustomMessenger) setFrozenAddress(ctx sdk.Context, contractAddr sdk.AccAddress, setFrozenAddress *bindingstypes.SetFrozenAddress) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetFrozenAddress(m.tokenFactory, ctx, contractAddr, setFrozenAddress)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set frozen address")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetFrozenAddress is used with setFrozenAddress to validate setFrozenAddress messages and to dispatch.
+func PerformSetFrozenAddress(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setFrozenAddress *bindingstypes.SetFrozenAddress) error {
+	if setFrozenAddress == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set frozen address null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetFrozenAddress(contractAddr.String(), setFrozenAddress.Denom, setFrozenAddress.Address, setFrozenAddress.Frozen)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetFrozenAddress(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting frozen address from message")
+	}
+	return nil
+}
+
+// setDenomPaused pauses or resumes the transfers of a denom.
+func (m *CustomMessenger) setDenomPaused(ctx sdk.Context, contractAddr sdk.AccAddress, setDenomPaused *bindingstypes.SetDenomPaused) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetDenomPaused(m.tokenFactory, ctx, contractAddr, setDenomPaused)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set denom paused")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetDenomPaused is used with setDenomPaused to validate setDenomPaused messages and to dispatch.
+func PerformSetDenomPaused(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setDenomPaused *bindingstypes.SetDenomPaused) error {
+	if setDenomPaused == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set denom paused null"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomPaused(contractAddr.String(), setDenomPaused.Denom, setDenomPaused.Paused)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetDenomPaused(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting denom paused from message")
+	}
+	return nil
+}
+
+// forceTransfer transfers tokens of a denom between two addresses.
+func (m *CustomMessenger) forceTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindingstypes.ForceTransfer) ([]sdk.Event, [][]byte, error) {
+	err := PerformForceTransfer(m.tokenFactory, ctx, contractAddr, forceTransfer)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform force transfer")
+	}
+	return nil, nil, nil
+}
+
+// PerformForceTransfer is used with forceTransfer to validate forceTransfer messages and to dispatch.
+func PerformForceTransfer(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, forceTransfer *bindingstypes.ForceTransfer) error {
+	if forceTransfer == nil {
+		return wasmvmtypes.InvalidRequest{Err: "force transfer null"}
+	}
+
+	coin := sdk.Coin{Denom: forceTransfer.Denom, Amount: forceTransfer.Amount}
+	sdkMsg := tokenfactorytypes.NewMsgForceTransfer(contractAddr.String(), coin, forceTransfer.FromAddress, forceTransfer.ToAddress)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "force transferring from message")
+	}
+	return nil
+}
+
+// setBeforeSendHook sets or removes the before send hook of a denom.
+func (m *CustomMessenger) setBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) ([]sdk.Event, [][]byte, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform set before send hook")
+	}
+	return nil, nil, nil
+}
+
+// PerformSetBeforeSendHook is used with setBeforeSendHook to validate setBeforeSendHook messages and to dispatch.
+func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *bindingstypes.SetBeforeSendHook) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null"}
+	}
+
+	version := tokenfactorytypes.BeforeSendHookVersionV1
+	if setBeforeSendHook.Version != "" {
+		var err error
+		version, err = tokenfactorytypes.BeforeSendHookVersionFromString(setBeforeSendHook.Version)
+		if err != nil {
+			return err
+		}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetVersionedBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.ContractAddr, version)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHook(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting before send hook from message")
+	}
+	return nil
+}
+
+// mintBatch mints tokens of a denom to many recipients.
+func (m *CustomMessenger) mintBatch(ctx sdk.Context, contractAddr sdk.AccAddress, mintBatch *bindingstypes.MintBatch) ([]sdk.Event, [][]byte, error) {
+	err := PerformMintBatch(m.tokenFactory, ctx, contractAddr, mintBatch)
+	if err != nil {
+		return nil, nil, errorsmod.Wrap(err, "perform mint batch")
+	}
+	return nil, nil, nil
+}
+
+// PerformMintBatch is used with mintBatch to validate mintBatch messages and to dispatch.
+func PerformMintBatch(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, mintBatch *bindingstypes.MintBatch) error {
+	if mintBatch == nil {
+		return wasmvmtypes.InvalidRequest{Err: "mint batch null"}
+	}
+
+	recipients := make([]tokenfactorytypes.MintBatchRecipient, len(mintBatch.Recipients))
+	for i, recipient := range mintBatch.Recipients {
+		recipients[i] = tokenfactorytypes.MintBatchRecipient{
+			Address: recipient.Address,
+			Amount:  recipient.Amount,
+		}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgMintBatch(contractAddr.String(), mintBatch.Denom, recipients)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.MintBatch(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "minting batch from message")
+	}
+	return nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
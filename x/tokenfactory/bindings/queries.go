@@ -2,6 +2,7 @@ package bindings
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -61,3 +62,63 @@ func (qp QueryPlugin) GetParams(ctx sdk.Context) (*bindingstypes.ParamsResponse,
 		},
 	}, nil
 }
+
+func (qp QueryPlugin) GetFreezeList(ctx sdk.Context, denom string) (*bindingstypes.FreezeListResponse, error) {
+	res, err := qp.tokenFactoryKeeper.DenomFreezeList(ctx, &types.QueryDenomFreezeListRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	frozenAddresses := res.FrozenAddresses
+	if frozenAddresses == nil {
+		frozenAddresses = []string{}
+	}
+	return &bindingstypes.FreezeListResponse{Paused: res.Paused, FrozenAddresses: frozenAddresses}, nil
+}
+
+func (qp QueryPlugin) GetFrozenAddress(ctx sdk.Context, denom string, address string) (*bindingstypes.FrozenAddressResponse, error) {
+	res, err := qp.tokenFactoryKeeper.FrozenAddress(ctx, &types.QueryFrozenAddressRequest{
+		Denom:   denom,
+		Address: address,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.FrozenAddressResponse{Frozen: res.Frozen}, nil
+}
+
+func (qp QueryPlugin) GetBeforeSendHook(ctx sdk.Context, denom string) (*bindingstypes.BeforeSendHookResponse, error) {
+	res, err := qp.tokenFactoryKeeper.BeforeSendHookAddress(ctx, &types.QueryBeforeSendHookAddressRequest{
+		Denom: denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &bindingstypes.BeforeSendHookResponse{
+		ContractAddr: res.CosmwasmAddress,
+		Version:      strings.ToLower(strings.TrimPrefix(res.Version.String(), "BEFORE_SEND_HOOK_VERSION_")),
+	}, nil
+}
+
+func (qp QueryPlugin) GetAuthorityMetadata(ctx sdk.Context, denom string) (*bindingstypes.AuthorityMetadataResponse, error) {
+	metadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get authority metadata for denom: %s", denom)
+	}
+	return &bindingstypes.AuthorityMetadataResponse{
+		AuthorityMetadata: bindingstypes.AuthorityMetadata{
+			Admin:                  metadata.Admin,
+			Minter:                 metadata.Minter,
+			Burner:                 metadata.Burner,
+			ForceTransferrer:       metadata.ForceTransferrer,
+			MetadataManager:        metadata.MetadataManager,
+			HookManager:            metadata.HookManager,
+			MinterAllowance:        metadata.MinterAllowance,
+			MintRenounced:          metadata.MintRenounced,
+			BurnFromRenounced:      metadata.BurnFromRenounced,
+			ForceTransferRenounced: metadata.ForceTransferRenounced,
+			HookChangesRenounced:   metadata.HookChangesRenounced,
+		},
+	}, nil
+}
@@ -98,6 +98,58 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case tokenQuery.FreezeList != nil:
+			res, err := qp.GetFreezeList(ctx, tokenQuery.FreezeList.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal FreezeListResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case tokenQuery.FrozenAddress != nil:
+			res, err := qp.GetFrozenAddress(ctx, tokenQuery.FrozenAddress.Denom, tokenQuery.FrozenAddress.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal FrozenAddressResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case tokenQuery.BeforeSendHook != nil:
+			res, err := qp.GetBeforeSendHook(ctx, tokenQuery.BeforeSendHook.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookResponse: %w", err)
+			}
+
+			return bz, nil
+
+		case tokenQuery.AuthorityMetadata != nil:
+			res, err := qp.GetAuthorityMetadata(ctx, tokenQuery.AuthorityMetadata.Denom)
+			if err != nil {
+				return nil, err
+			}
+
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, fmt.Errorf("failed to JSON marshal AuthorityMetadataResponse: %w", err)
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown query"}
 		}
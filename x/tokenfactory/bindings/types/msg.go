@@ -13,14 +13,35 @@ type TokenMsg struct {
 	/// Contracts can change the admin of a denom that they are the admin of.
 	ChangeAdmin *ChangeAdmin `json:"change_admin,omitempty"`
 	/// Contracts can mint native tokens for an existing factory denom
-	/// that they are the admin of.
+	/// that they are the minter of.
 	MintTokens *MintTokens `json:"mint_tokens,omitempty"`
 	/// Contracts can burn native tokens for an existing factory denom
-	/// that they are the admin of.
+	/// that they are the burner of.
 	/// Currently, the burn from address must be the admin contract.
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
 	/// Sets the metadata on a denom which the contract controls
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
+	/// Contracts can grant or revoke the roles of a denom
+	/// that they are the admin of.
+	SetDenomRole *SetDenomRole `json:"set_denom_role,omitempty"`
+	/// Contracts can permanently renounce the capabilities of a denom
+	/// that they are the admin of.
+	RenounceCapability *RenounceCapability `json:"renounce_capability,omitempty"`
+	/// Contracts can freeze or unfreeze addresses for a denom
+	/// that they are the admin of.
+	SetFrozenAddress *SetFrozenAddress `json:"set_frozen_address,omitempty"`
+	/// Contracts can pause or resume the transfers of a denom
+	/// that they are the admin of.
+	SetDenomPaused *SetDenomPaused `json:"set_denom_paused,omitempty"`
+	/// Contracts can transfer tokens of a denom between any two addresses
+	/// if they are the force transferrer of the denom.
+	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Contracts can set or remove the before send hook of a denom
+	/// that they are the hook manager of.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Contracts can mint native tokens to many recipients at once
+	/// for an existing factory denom that they are the minter of.
+	MintBatch *MintBatch `json:"mint_batch,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -58,3 +79,68 @@ type SetMetadata struct {
 	Denom    string   `json:"denom"`
 	Metadata Metadata `json:"metadata"`
 }
+
+// SetDenomRole grants a role over a factory denom to an address, or revokes
+// it if the Address is empty. The role is one of "minter", "burner",
+// "force_transferrer", "metadata_manager" or "hook_manager". The
+// MinterAllowance is only accepted along with the minter role, and leaves
+// the minter unlimited if empty.
+type SetDenomRole struct {
+	Denom           string    `json:"denom"`
+	Role            string    `json:"role"`
+	Address         string    `json:"address"`
+	MinterAllowance *math.Int `json:"minter_allowance,omitempty"`
+}
+
+// RenounceCapability permanently renounces a capability over a factory denom.
+// The capability is one of "mint", "burn_from", "force_transfer" or
+// "hook_changes".
+type RenounceCapability struct {
+	Denom      string `json:"denom"`
+	Capability string `json:"capability"`
+}
+
+// SetFrozenAddress freezes an address, which can then neither send nor
+// receive the factory denom, or unfreezes it.
+type SetFrozenAddress struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Frozen  bool   `json:"frozen"`
+}
+
+// SetDenomPaused pauses or resumes all the transfers of a factory denom.
+type SetDenomPaused struct {
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+// ForceTransfer transfers tokens of a factory denom from any address to any
+// other address.
+type ForceTransfer struct {
+	Denom       string   `json:"denom"`
+	Amount      math.Int `json:"amount"`
+	FromAddress string   `json:"from_address"`
+	ToAddress   string   `json:"to_address"`
+}
+
+// SetBeforeSendHook sets the before send hook contract of a factory denom, or
+// removes it if the ContractAddr is empty. The Version is "v1" (the default),
+// which sudo-calls the contract once per coin, or "v2", which sudo-calls the
+// contract once per transfer with all its coins.
+type SetBeforeSendHook struct {
+	Denom        string `json:"denom"`
+	ContractAddr string `json:"contract_addr"`
+	Version      string `json:"version,omitempty"`
+}
+
+// MintBatch mints tokens of a factory denom to many recipients at once.
+// The contract must be the minter of the denom, as for MintTokens.
+type MintBatch struct {
+	Denom      string               `json:"denom"`
+	Recipients []MintBatchRecipient `json:"recipients"`
+}
+
+type MintBatchRecipient struct {
+	Address string   `json:"address"`
+	Amount  math.Int `json:"amount"`
+}
@@ -8,11 +8,15 @@ type TokenFactoryQuery struct {
 type TokenQuery struct {
 	/// Given a subdenom minted by a contract via `OsmosisMsg::MintTokens`,
 	/// returns the full denom as used by `BankMsg::Send`.
-	FullDenom       *FullDenom       `json:"full_denom,omitempty"`
-	Admin           *DenomAdmin      `json:"admin,omitempty"`
-	Metadata        *GetMetadata     `json:"metadata,omitempty"`
-	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
-	Params          *GetParams       `json:"params,omitempty"`
+	FullDenom         *FullDenom            `json:"full_denom,omitempty"`
+	Admin             *DenomAdmin           `json:"admin,omitempty"`
+	Metadata          *GetMetadata          `json:"metadata,omitempty"`
+	DenomsByCreator   *DenomsByCreator      `json:"denoms_by_creator,omitempty"`
+	Params            *GetParams            `json:"params,omitempty"`
+	FreezeList        *FreezeList           `json:"freeze_list,omitempty"`
+	FrozenAddress     *FrozenAddress        `json:"frozen_address,omitempty"`
+	BeforeSendHook    *BeforeSendHook       `json:"before_send_hook,omitempty"`
+	AuthorityMetadata *GetAuthorityMetadata `json:"authority_metadata,omitempty"`
 }
 
 // query types
@@ -36,6 +40,23 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type FreezeList struct {
+	Denom string `json:"denom"`
+}
+
+type FrozenAddress struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
+type BeforeSendHook struct {
+	Denom string `json:"denom"`
+}
+
+type GetAuthorityMetadata struct {
+	Denom string `json:"denom"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -57,3 +78,24 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type FreezeListResponse struct {
+	Paused          bool     `json:"paused"`
+	FrozenAddresses []string `json:"frozen_addresses"`
+}
+
+type FrozenAddressResponse struct {
+	Frozen bool `json:"frozen"`
+}
+
+// BeforeSendHookResponse holds the before send hook contract of a denom, which
+// is empty when the denom has no hook, and the version of the hook, which is
+// "v1" or "v2".
+type BeforeSendHookResponse struct {
+	ContractAddr string `json:"contract_addr"`
+	Version      string `json:"version"`
+}
+
+type AuthorityMetadataResponse struct {
+	AuthorityMetadata AuthorityMetadata `json:"authority_metadata"`
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 )
 
@@ -35,3 +36,21 @@ type DenomUnit struct {
 type Params struct {
 	DenomCreationFee []wasmvmtypes.Coin `json:"denom_creation_fee"`
 }
+
+// AuthorityMetadata holds the admin and the role holders of a denom, which are
+// empty when nobody holds them, along with its renounced capabilities.
+type AuthorityMetadata struct {
+	Admin            string `json:"admin"`
+	Minter           string `json:"minter"`
+	Burner           string `json:"burner"`
+	ForceTransferrer string `json:"force_transferrer"`
+	MetadataManager  string `json:"metadata_manager"`
+	HookManager      string `json:"hook_manager"`
+	// MinterAllowance is the amount the minter is still allowed to mint,
+	// unlimited when empty.
+	MinterAllowance        *math.Int `json:"minter_allowance,omitempty"`
+	MintRenounced          bool      `json:"mint_renounced"`
+	BurnFromRenounced      bool      `json:"burn_from_renounced"`
+	ForceTransferRenounced bool      `json:"force_transfer_renounced"`
+	HookChangesRenounced   bool      `json:"hook_changes_renounced"`
+}
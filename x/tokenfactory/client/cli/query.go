@@ -29,6 +29,10 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomMaxSupply(),
+		GetCmdBeforeSendHook(),
+		GetCmdDenomFreezeList(),
+		GetCmdFrozenAddress(),
 	)
 
 	return cmd
@@ -65,7 +69,7 @@ func GetParams() *cobra.Command {
 func GetCmdDenomAuthorityMetadata() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "denom-authority-metadata [denom] [flags]",
-		Short: "Get the authority metadata for a specific denom",
+		Short: "Get the authority metadata for a specific denom, including its roles and renounced capabilities",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -118,3 +122,121 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomMaxSupply returns the max supply of a queried denom
+func GetCmdDenomMaxSupply() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-max-supply [denom] [flags]",
+		Short: "Get the max supply of a specific denom, which is zero when the denom has no max supply",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomMaxSupply(cmd.Context(), &types.QueryDenomMaxSupplyRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBeforeSendHook returns the before send hook of a queried denom
+func GetCmdBeforeSendHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Get the before send hook contract of a specific denom, along with its version, gas limits and consecutive failures",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomFreezeList returns the frozen addresses of a queried denom and
+// whether its transfers are paused
+func GetCmdDenomFreezeList() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-freeze-list [denom] [flags]",
+		Short: "Get the frozen addresses of a specific denom and whether its transfers are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomFreezeList(cmd.Context(), &types.QueryDenomFreezeListRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAddress returns whether an address is frozen for a queried denom
+func GetCmdFrozenAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-address [denom] [address] [flags]",
+		Short: "Get whether an address is frozen for a specific denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FrozenAddress(cmd.Context(), &types.QueryFrozenAddressRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
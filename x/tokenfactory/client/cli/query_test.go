@@ -46,6 +46,30 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryDenomsFromCreatorRequest{Creator: s.TestAccs[0].String()},
 			&types.QueryDenomsFromCreatorResponse{},
 		},
+		{
+			"Query denom max supply",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomMaxSupply",
+			&types.QueryDenomMaxSupplyRequest{Denom: "tokenfactory"},
+			&types.QueryDenomMaxSupplyResponse{},
+		},
+		{
+			"Query before send hook",
+			"/osmosis.tokenfactory.v1beta1.Query/BeforeSendHookAddress",
+			&types.QueryBeforeSendHookAddressRequest{Denom: "tokenfactory"},
+			&types.QueryBeforeSendHookAddressResponse{},
+		},
+		{
+			"Query denom freeze list",
+			"/osmosis.tokenfactory.v1beta1.Query/DenomFreezeList",
+			&types.QueryDenomFreezeListRequest{Denom: "tokenfactory"},
+			&types.QueryDenomFreezeListResponse{},
+		},
+		{
+			"Query frozen address",
+			"/osmosis.tokenfactory.v1beta1.Query/FrozenAddress",
+			&types.QueryFrozenAddressRequest{Denom: "tokenfactory", Address: s.TestAccs[0].String()},
+			&types.QueryFrozenAddressResponse{},
+		},
 		{
 			"Query params",
 			"/osmosis.tokenfactory.v1beta1.Query/Params",
@@ -1,18 +1,58 @@
 package cli
 
 import (
+	"encoding/csv"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/terra-money/core/v2/x/tokenfactory/types"
 )
 
+// Flags of the tokenfactory transactions
+const (
+	// FlagMinterAllowance is the flag setting the allowance of a minter
+	FlagMinterAllowance = "minter-allowance"
+	// FlagMintTo is the flag setting the recipient of the minted tokens
+	FlagMintTo = "to"
+	// FlagBurnFrom is the flag setting the address the tokens are burnt from.
+	// It cannot be named from since that flag sets the signer of the tx.
+	FlagBurnFrom = "burn-from"
+	// FlagHookVersion is the flag setting the version of a before send hook
+	FlagHookVersion = "hook-version"
+
+	// FlagMetadataFile is the flag setting the JSON file holding the metadata of a denom
+	FlagMetadataFile = "metadata-file"
+	// FlagUnits is the flag setting the denom units of a denom
+	FlagUnits = "units"
+	// FlagDisplay is the flag setting the display denom unit of a denom
+	FlagDisplay = "display"
+	// FlagName is the flag setting the name of a denom
+	FlagName = "name"
+	// FlagSymbol is the flag setting the symbol of a denom
+	FlagSymbol = "symbol"
+	// FlagDescription is the flag setting the description of a denom
+	FlagDescription = "description"
+	// FlagURI is the flag setting the URI of a document describing a denom
+	FlagURI = "uri"
+	// FlagURIHash is the flag setting the sha256 hash of the document at the URI
+	FlagURIHash = "uri-hash"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -28,6 +68,18 @@ func GetTxCmd() *cobra.Command {
 		NewMintCmd(),
 		NewBurnCmd(),
 		NewChangeAdminCmd(),
+		NewSetDenomMetadataCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewForceTransferCmd(),
+		NewSetMaxSupplyCmd(),
+		NewSetDenomRoleCmd(),
+		NewRenounceCapabilityCmd(),
+		NewFreezeCmd(),
+		NewUnfreezeCmd(),
+		NewPauseCmd(),
+		NewUnpauseCmd(),
+		NewSetBeforeSendHookGasLimitsCmd(),
+		NewMintBatchCmd(),
 	)
 
 	return cmd
@@ -66,9 +118,10 @@ func NewCreateDenomCmd() *cobra.Command {
 // NewMintCmd broadcast MsgMint
 func NewMintCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "mint [amount] [flags]",
-		Short: "Mint a denom to an address. Must have admin authority to do so.",
-		Args:  cobra.ExactArgs(1),
+		Use:     "mint [amount] [flags]",
+		Short:   "Mint a denom to the sender, or to another address with --to. Must have the minter role to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory mint 1000factory/terra1.../mytoken --%s terra1...", version.AppName, FlagMintTo),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -85,9 +138,61 @@ func NewMintCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgMint(
+			mintTo, err := cmd.Flags().GetString(FlagMintTo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMintTo(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				mintTo,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintTo, "", "Address to mint the tokens to, the sender when empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMintBatchCmd broadcast MsgMintBatch
+func NewMintBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-batch [denom] [recipients-csv-file] [flags]",
+		Short: "Mint a denom to many recipients at once. Must have minter authority to do so.",
+		Long: `Mint a denom to the recipients listed in a CSV file, one address and amount per line.
+An optional "address,amount" header line and lines starting with # are ignored. Must have minter authority to do so.`,
+		Example: fmt.Sprintf("%s tx tokenfactory mint-batch factory/terra1.../mytoken recipients.csv", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(args[1])
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			recipients, err := parseMintBatchRecipients(file)
+			if err != nil {
+				return fmt.Errorf("invalid recipients file %s: %w", args[1], err)
+			}
+
+			msg := types.NewMsgMintBatch(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				recipients,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
@@ -101,9 +206,10 @@ func NewMintCmd() *cobra.Command {
 // NewBurnCmd broadcast MsgBurn
 func NewBurnCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "burn [amount] [flags]",
-		Short: "Burn tokens from an address. Must have admin authority to do so.",
-		Args:  cobra.ExactArgs(1),
+		Use:     "burn [amount] [flags]",
+		Short:   "Burn tokens from the sender, or from another address with --burn-from. Must have the burner role to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory burn 1000factory/terra1.../mytoken --%s terra1...", version.AppName, FlagBurnFrom),
+		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -120,15 +226,22 @@ func NewBurnCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgBurn(
+			burnFrom, err := cmd.Flags().GetString(FlagBurnFrom)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurnFrom(
 				clientCtx.GetFromAddress().String(),
 				amount,
+				burnFrom,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagBurnFrom, "", "Address to burn the tokens from, the sender when empty")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -163,3 +276,584 @@ func NewChangeAdminCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetDenomMetadataCmd broadcast MsgSetDenomMetadata
+func NewSetDenomMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [denom] [flags]",
+		Short: "Sets the bank metadata of a factory-created denom. Must have the metadata manager role to do so.",
+		Long: fmt.Sprintf(`Sets the bank metadata of a factory-created denom, either from a JSON file with --%s
+or from the flags. The units are comma separated unit:exponent pairs, and default to the denom with
+exponent 0. The display unit defaults to the unit with the highest exponent, and the name to the symbol.`, FlagMetadataFile),
+		Example: fmt.Sprintf(`%[1]s tx tokenfactory set-denom-metadata factory/terra1.../umytoken --%[2]s factory/terra1.../umytoken:0,mytoken:6 --%[3]s MYT
+%[1]s tx tokenfactory set-denom-metadata factory/terra1.../umytoken --%[4]s metadata.json`,
+			version.AppName, FlagUnits, FlagSymbol, FlagMetadataFile),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			metadata, err := parseDenomMetadata(clientCtx.Codec, cmd.Flags(), args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomMetadata(
+				clientCtx.GetFromAddress().String(),
+				metadata,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadataFile, "", "JSON file holding the bank metadata of the denom, exclusive with the other metadata flags")
+	cmd.Flags().String(FlagUnits, "", "Comma separated unit:exponent pairs of the denom units")
+	cmd.Flags().String(FlagDisplay, "", "Unit used to display the denom")
+	cmd.Flags().String(FlagName, "", "Name of the denom")
+	cmd.Flags().String(FlagSymbol, "", "Symbol of the denom")
+	cmd.Flags().String(FlagDescription, "", "Description of the denom")
+	cmd.Flags().String(FlagURI, "", "URI of a document describing the denom")
+	cmd.Flags().String(FlagURIHash, "", "sha256 hash of the document at the URI")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the before send hook contract of a factory-created denom, or removes it when no address is given. Must have the hook manager role to do so.",
+		Long: fmt.Sprintf(`Sets the before send hook contract of a factory-created denom, or removes it when no address is given.
+The --%s flag is v1 (the default), which sudo-calls the contract once per coin, or v2, which sudo-calls
+the contract once per transfer with all its coins.`, FlagHookVersion),
+		Example: fmt.Sprintf("%s tx tokenfactory set-before-send-hook factory/terra1.../mytoken terra1... --%s v2", version.AppName, FlagHookVersion),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var cosmwasmAddress string
+			if len(args) == 2 {
+				cosmwasmAddress = args[1]
+			}
+
+			versionStr, err := cmd.Flags().GetString(FlagHookVersion)
+			if err != nil {
+				return err
+			}
+			hookVersion, err := types.BeforeSendHookVersionFromString(versionStr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetVersionedBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				cosmwasmAddress,
+				hookVersion,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHookVersion, "v1", "Version of the sudo msgs sent to the contract, v1 or v2")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewForceTransferCmd broadcast MsgForceTransfer
+func NewForceTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "force-transfer [amount] [transfer-from-address] [transfer-to-address] [flags]",
+		Short:   "Transfers tokens of a factory-created denom from any address. Must have the force transferrer role to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory force-transfer 1000factory/terra1.../mytoken terra1... terra1...", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceTransfer(
+				clientCtx.GetFromAddress().String(),
+				amount,
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Sets the max supply of a factory-created denom, which can only be lowered once set. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			maxSupply, ok := sdk.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomRoleCmd broadcast MsgSetDenomRole
+func NewSetDenomRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-role [denom] [role] [address] [flags]",
+		Short: "Grants a role of a factory-created denom to an address, or revokes it when no address is given. Must have admin authority to do so.",
+		Long: `Grants a role of a factory-created denom to an address, or revokes it when no address is given.
+The role is one of minter, burner, force_transferrer, metadata_manager or hook_manager.
+The minter role accepts an optional allowance, which leaves the minter unlimited when omitted.`,
+		Example: fmt.Sprintf("%s tx tokenfactory set-denom-role factory/terra1.../mytoken minter terra1... --%s 1000000", version.AppName, FlagMinterAllowance),
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			role, err := types.DenomRoleFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			var address string
+			if len(args) == 3 {
+				address = args[2]
+			}
+
+			var minterAllowance *sdk.Int
+			allowanceStr, err := cmd.Flags().GetString(FlagMinterAllowance)
+			if err != nil {
+				return err
+			}
+			if allowanceStr != "" {
+				allowance, ok := sdk.NewIntFromString(allowanceStr)
+				if !ok {
+					return fmt.Errorf("invalid minter allowance: %s", allowanceStr)
+				}
+				minterAllowance = &allowance
+			}
+
+			msg := types.NewMsgSetDenomRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				role,
+				address,
+				minterAllowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMinterAllowance, "", "Amount the minter is allowed to mint, unlimited when empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRenounceCapabilityCmd broadcast MsgRenounceCapability
+func NewRenounceCapabilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce-capability [denom] [capability] [flags]",
+		Short: "Permanently renounces a capability over a factory-created denom. Must have admin authority to do so.",
+		Long: `Permanently renounces a capability over a factory-created denom, which can never be restored.
+The capability is one of mint, burn_from, force_transfer or hook_changes.`,
+		Example: fmt.Sprintf("%s tx tokenfactory renounce-capability factory/terra1.../mytoken force_transfer", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			capability, err := types.DenomCapabilityFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenounceCapability(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				capability,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFreezeCmd broadcast MsgSetFrozenAddress
+func NewFreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "freeze [denom] [address] [flags]",
+		Short:   "Freezes an address, which can then neither send nor receive a factory-created denom. Must have admin authority to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory freeze factory/terra1.../mytoken terra1...", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFrozenAddress(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnfreezeCmd broadcast MsgSetFrozenAddress
+func NewUnfreezeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unfreeze [denom] [address] [flags]",
+		Short:   "Unfreezes an address for a factory-created denom. Must have admin authority to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory unfreeze factory/terra1.../mytoken terra1...", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFrozenAddress(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseCmd broadcast MsgSetDenomPaused
+func NewPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause [denom] [flags]",
+		Short:   "Pauses all the transfers of a factory-created denom. Must have admin authority to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory pause factory/terra1.../mytoken", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				true,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseCmd broadcast MsgSetDenomPaused
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause [denom] [flags]",
+		Short:   "Resumes the transfers of a factory-created denom. Must have admin authority to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory unpause factory/terra1.../mytoken", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetDenomPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				false,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetBeforeSendHookGasLimitsCmd broadcast MsgSetBeforeSendHookGasLimits
+func NewSetBeforeSendHookGasLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook-gas-limits [denom] [track-gas-limit] [block-gas-limit] [flags]",
+		Short: "Sets the gas limits of the before send hook calls of a factory-created denom, where 0 uses the gas limit of the params. Must have the hook manager role to do so.",
+		Example: fmt.Sprintf("%s tx tokenfactory set-before-send-hook-gas-limits factory/terra1.../mytoken 200000 500000",
+			version.AppName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			trackGasLimit, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid track gas limit: %s", args[1])
+			}
+
+			blockGasLimit, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid block gas limit: %s", args[2])
+			}
+
+			msg := types.NewMsgSetBeforeSendHookGasLimits(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				trackGasLimit,
+				blockGasLimit,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseDenomMetadata returns the bank metadata of the denom read from the file
+// of the metadata file flag, or built from the other metadata flags.
+func parseDenomMetadata(cdc codec.JSONCodec, fs *pflag.FlagSet, denom string) (banktypes.Metadata, error) {
+	var metadata banktypes.Metadata
+
+	file, err := fs.GetString(FlagMetadataFile)
+	if err != nil {
+		return metadata, err
+	}
+
+	if file != "" {
+		for _, flag := range []string{FlagUnits, FlagDisplay, FlagName, FlagSymbol, FlagDescription, FlagURI, FlagURIHash} {
+			if fs.Changed(flag) {
+				return metadata, fmt.Errorf("--%s cannot be used with --%s", flag, FlagMetadataFile)
+			}
+		}
+
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return metadata, err
+		}
+		if err := cdc.UnmarshalJSON(bz, &metadata); err != nil {
+			return metadata, fmt.Errorf("invalid metadata file %s: %w", file, err)
+		}
+
+		if metadata.Base == "" {
+			metadata.Base = denom
+		}
+		if metadata.Base != denom {
+			return metadata, fmt.Errorf("metadata base %s does not match denom %s", metadata.Base, denom)
+		}
+		return metadata, nil
+	}
+
+	units, err := fs.GetString(FlagUnits)
+	if err != nil {
+		return metadata, err
+	}
+	metadata.DenomUnits, err = parseDenomUnits(units, denom)
+	if err != nil {
+		return metadata, err
+	}
+	metadata.Base = denom
+
+	for flag, field := range map[string]*string{
+		FlagDisplay:     &metadata.Display,
+		FlagName:        &metadata.Name,
+		FlagSymbol:      &metadata.Symbol,
+		FlagDescription: &metadata.Description,
+		FlagURI:         &metadata.URI,
+		FlagURIHash:     &metadata.URIHash,
+	} {
+		if *field, err = fs.GetString(flag); err != nil {
+			return metadata, err
+		}
+	}
+
+	if metadata.Display == "" {
+		metadata.Display = metadata.DenomUnits[len(metadata.DenomUnits)-1].Denom
+	}
+	if metadata.Name == "" {
+		metadata.Name = metadata.Symbol
+	}
+	return metadata, nil
+}
+
+// parseDenomUnits parses comma separated unit:exponent pairs, which default to
+// the denom with exponent 0 when empty.
+func parseDenomUnits(units string, denom string) ([]*banktypes.DenomUnit, error) {
+	if units == "" {
+		return []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}}, nil
+	}
+
+	var denomUnits []*banktypes.DenomUnit
+	for _, unit := range strings.Split(units, ",") {
+		i := strings.LastIndex(unit, ":")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid denom unit %s, expected unit:exponent", unit)
+		}
+
+		exponent, err := strconv.ParseUint(unit[i+1:], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent of denom unit %s: %w", unit, err)
+		}
+
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    strings.TrimSpace(unit[:i]),
+			Exponent: uint32(exponent),
+		})
+	}
+	return denomUnits, nil
+}
+
+// parseMintBatchRecipients parses the recipients of a MsgMintBatch from CSV
+// records of an address and an amount, skipping an optional header record.
+func parseMintBatchRecipients(r io.Reader) ([]types.MintBatchRecipient, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	var recipients []types.MintBatchRecipient
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		address, amount := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if len(recipients) == 0 && strings.EqualFold(address, "address") && strings.EqualFold(amount, "amount") {
+			continue
+		}
+
+		amountInt, ok := sdk.NewIntFromString(amount)
+		if !ok {
+			line, _ := reader.FieldPos(0)
+			return nil, fmt.Errorf("invalid amount %q on line %d", amount, line)
+		}
+		recipients = append(recipients, types.MintBatchRecipient{
+			Address: address,
+			Amount:  amountInt,
+		})
+	}
+
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("no recipients")
+	}
+	return recipients, nil
+}
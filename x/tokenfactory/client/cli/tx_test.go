@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+func TestParseDenomMetadata(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	denom := "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/ubitcoin"
+
+	metadataFile := filepath.Join(t.TempDir(), "metadata.json")
+	err := os.WriteFile(metadataFile, []byte(`{
+		"description": "Bitcoin",
+		"denom_units": [{"denom": "`+denom+`", "exponent": 0}, {"denom": "bitcoin", "exponent": 6, "aliases": ["btc"]}],
+		"display": "bitcoin",
+		"name": "Bitcoin",
+		"symbol": "BTC"
+	}`), 0o600)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		desc     string
+		args     []string
+		expected banktypes.Metadata
+		valid    bool
+	}{
+		{
+			desc: "flags",
+			args: []string{"--units", denom + ":0,bitcoin:6", "--symbol", "BTC", "--uri", "https://bitcoin.org"},
+			expected: banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "bitcoin", Exponent: 6}},
+				Base:       denom,
+				Display:    "bitcoin",
+				Name:       "BTC",
+				Symbol:     "BTC",
+				URI:        "https://bitcoin.org",
+			},
+			valid: true,
+		},
+		{
+			desc: "default units",
+			args: []string{"--symbol", "BTC", "--name", "Bitcoin"},
+			expected: banktypes.Metadata{
+				DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+				Base:       denom,
+				Display:    denom,
+				Name:       "Bitcoin",
+				Symbol:     "BTC",
+			},
+			valid: true,
+		},
+		{
+			desc: "metadata file",
+			args: []string{"--metadata-file", metadataFile},
+			expected: banktypes.Metadata{
+				Description: "Bitcoin",
+				DenomUnits:  []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}, {Denom: "bitcoin", Exponent: 6, Aliases: []string{"btc"}}},
+				Base:        denom,
+				Display:     "bitcoin",
+				Name:        "Bitcoin",
+				Symbol:      "BTC",
+			},
+			valid: true,
+		},
+		{
+			desc:  "metadata file with flags",
+			args:  []string{"--metadata-file", metadataFile, "--symbol", "BTC"},
+			valid: false,
+		},
+		{
+			desc:  "missing metadata file",
+			args:  []string{"--metadata-file", filepath.Join(t.TempDir(), "missing.json")},
+			valid: false,
+		},
+		{
+			desc:  "unit without exponent",
+			args:  []string{"--units", denom},
+			valid: false,
+		},
+		{
+			desc:  "invalid exponent",
+			args:  []string{"--units", denom + ":-1"},
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			cmd := NewSetDenomMetadataCmd()
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			metadata, err := parseDenomMetadata(cdc, cmd.Flags(), denom)
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, metadata)
+			require.NoError(t, metadata.Validate())
+		})
+	}
+}
+
+func TestParseMintBatchRecipients(t *testing.T) {
+	addr1 := "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g"
+	addr2 := "terra1dww2nvg0xmd26k3zecs6thmw7nmlt5z0yaf66k"
+
+	for _, tc := range []struct {
+		desc     string
+		csv      string
+		expected []types.MintBatchRecipient
+		valid    bool
+	}{
+		{
+			desc: "records",
+			csv:  addr1 + ",100\n" + addr2 + ",2500\n",
+			expected: []types.MintBatchRecipient{
+				{Address: addr1, Amount: sdk.NewInt(100)},
+				{Address: addr2, Amount: sdk.NewInt(2500)},
+			},
+			valid: true,
+		},
+		{
+			desc: "header, comments and spaces",
+			csv:  "# airdrop\naddress, amount\n" + addr1 + ", 100\n\n" + addr2 + ",2500",
+			expected: []types.MintBatchRecipient{
+				{Address: addr1, Amount: sdk.NewInt(100)},
+				{Address: addr2, Amount: sdk.NewInt(2500)},
+			},
+			valid: true,
+		},
+		{
+			desc:  "invalid amount",
+			csv:   addr1 + ",100uluna\n",
+			valid: false,
+		},
+		{
+			desc:  "missing amount",
+			csv:   addr1 + "\n",
+			valid: false,
+		},
+		{
+			desc:  "no recipients",
+			csv:   "address,amount\n",
+			valid: false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			recipients, err := parseMintBatchRecipients(strings.NewReader(tc.csv))
+			if !tc.valid {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, recipients)
+		})
+	}
+}
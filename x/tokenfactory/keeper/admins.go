@@ -36,13 +36,78 @@ func (k Keeper) setAuthorityMetadata(ctx sdk.Context, denom string, metadata typ
 	return nil
 }
 
+// setAdmin changes the admin of a denom, handing over to the new admin the
+// roles held by the previous one.
 func (k Keeper) setAdmin(ctx sdk.Context, denom string, admin string) error {
 	metadata, err := k.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
 
+	for _, role := range types.AllDenomRoles() {
+		if metadata.HasRole(role, metadata.Admin) {
+			if err := metadata.SetRole(role, admin); err != nil {
+				return err
+			}
+		}
+	}
 	metadata.Admin = admin
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
+
+// setDenomRole grants a role over a denom to an address, or revokes it when
+// the address is empty. The minter allowance is only set along with the
+// minter role.
+func (k Keeper) setDenomRole(ctx sdk.Context, denom string, role types.DenomRole, address string, minterAllowance *sdk.Int) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := metadata.SetRole(role, address); err != nil {
+		return err
+	}
+	if role == types.DenomRoleMinter {
+		metadata.MinterAllowance = minterAllowance
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// renounceCapability permanently renounces a capability over a denom.
+func (k Keeper) renounceCapability(ctx sdk.Context, denom string, capability types.DenomCapability) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.IsRenounced(capability) {
+		return types.ErrCapabilityRenounced.Wrapf("capability: %s", capability)
+	}
+	if err := metadata.Renounce(capability); err != nil {
+		return err
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// consumeMinterAllowance deducts the amount from the minter allowance of the
+// denom, if the minter has one.
+func (k Keeper) consumeMinterAllowance(ctx sdk.Context, amount sdk.Coin) error {
+	metadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if metadata.MinterAllowance == nil {
+		return nil
+	}
+	if metadata.MinterAllowance.LT(amount.Amount) {
+		return types.ErrMinterAllowanceExceeded.Wrapf("allowance: %s, amount: %s", metadata.MinterAllowance, amount.Amount)
+	}
+
+	allowance := metadata.MinterAllowance.Sub(amount.Amount)
+	metadata.MinterAllowance = &allowance
+	return k.setAuthorityMetadata(ctx, amount.Denom, metadata)
+}
@@ -0,0 +1,343 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// TestSetDenomRoleMsg tests TypeMsgSetDenomRole message is emitted on a successful role change
+func (s *KeeperTestSuite) TestSetDenomRoleMsg() {
+	allowance := sdk.NewInt(100)
+
+	for _, tc := range []struct {
+		desc                  string
+		sender                func() string
+		role                  types.DenomRole
+		address               func() string
+		minterAllowance       *sdk.Int
+		expectedErr           error
+		expectedMessageEvents int
+	}{
+		{
+			desc:                  "grant minter with allowance",
+			sender:                func() string { return s.TestAccs[0].String() },
+			role:                  types.DenomRoleMinter,
+			address:               func() string { return s.TestAccs[1].String() },
+			minterAllowance:       &allowance,
+			expectedMessageEvents: 1,
+		},
+		{
+			desc:                  "grant force transferrer",
+			sender:                func() string { return s.TestAccs[0].String() },
+			role:                  types.DenomRoleForceTransferrer,
+			address:               func() string { return s.TestAccs[1].String() },
+			expectedMessageEvents: 1,
+		},
+		{
+			desc:                  "revoke hook manager",
+			sender:                func() string { return s.TestAccs[0].String() },
+			role:                  types.DenomRoleHookManager,
+			address:               func() string { return "" },
+			expectedMessageEvents: 1,
+		},
+		{
+			desc:        "role holders can't grant roles",
+			sender:      func() string { return s.TestAccs[1].String() },
+			role:        types.DenomRoleBurner,
+			address:     func() string { return s.TestAccs[1].String() },
+			expectedErr: types.ErrUnauthorized,
+		},
+		{
+			desc:        "unspecified role",
+			sender:      func() string { return s.TestAccs[0].String() },
+			role:        types.DenomRoleUnspecified,
+			address:     func() string { return s.TestAccs[1].String() },
+			expectedErr: types.ErrInvalidDenomRole,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgSetDenomRole(tc.sender(), denom, tc.role, tc.address(), tc.minterAllowance)
+			_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(ctx), msg)
+			s.AssertEventEmitted(ctx, types.TypeMsgSetDenomRole, tc.expectedMessageEvents)
+
+			authorityMetadata, queryErr := s.App.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+			s.Require().NoError(queryErr)
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.Require().Equal(types.NewAdminAuthorityMetadata(s.TestAccs[0].String()), authorityMetadata)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.address(), authorityMetadata.RoleAddress(tc.role))
+			s.Require().Equal(tc.minterAllowance, authorityMetadata.MinterAllowance)
+			s.Require().Equal(s.TestAccs[0].String(), authorityMetadata.Admin)
+		})
+	}
+}
+
+// TestRolesAreChecked tests that each message can only be sent by the
+// holder of its role, and no longer by the admin once the role is granted
+// to another address
+func (s *KeeperTestSuite) TestRolesAreChecked() {
+	for _, tc := range []struct {
+		desc string
+		role types.DenomRole
+		send func(sender string, denom string) error
+	}{
+		{
+			desc: "mint",
+			role: types.DenomRoleMinter,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 10)))
+				return err
+			},
+		},
+		{
+			desc: "burn",
+			role: types.DenomRoleBurner,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(sender, sdk.NewInt64Coin(denom, 10), s.TestAccs[2].String()))
+				return err
+			},
+		},
+		{
+			desc: "force transfer",
+			role: types.DenomRoleForceTransferrer,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.ForceTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceTransfer(sender, sdk.NewInt64Coin(denom, 10), s.TestAccs[2].String(), sender))
+				return err
+			},
+		},
+		{
+			desc: "set denom metadata",
+			role: types.DenomRoleMetadataManager,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.SetDenomMetadata(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomMetadata(sender, banktypes.Metadata{
+					DenomUnits: []*banktypes.DenomUnit{{Denom: denom, Exponent: 0}},
+					Base:       denom,
+					Display:    denom,
+					Name:       "bitcoin",
+					Symbol:     "BTC",
+				}))
+				return err
+			},
+		},
+		{
+			desc: "set before send hook",
+			role: types.DenomRoleHookManager,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(sender, denom, ""))
+				return err
+			},
+		},
+		{
+			desc: "set before send hook gas limits",
+			role: types.DenomRoleHookManager,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.SetBeforeSendHookGasLimits(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHookGasLimits(sender, denom, 0, 0))
+				return err
+			},
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			admin := s.TestAccs[0].String()
+			holder := s.TestAccs[1].String()
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), s.TestAccs[2].String()))
+			s.Require().NoError(err)
+
+			_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, tc.role, holder, nil))
+			s.Require().NoError(err)
+
+			s.Require().ErrorIs(tc.send(admin, denom), types.ErrUnauthorized)
+			s.Require().NoError(tc.send(holder, denom))
+
+			_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, tc.role, "", nil))
+			s.Require().NoError(err)
+
+			s.Require().ErrorIs(tc.send(holder, denom), types.ErrUnauthorized)
+		})
+	}
+}
+
+// TestMinterAllowance tests that the minter can't mint more than its allowance
+func (s *KeeperTestSuite) TestMinterAllowance() {
+	admin := s.TestAccs[0].String()
+	minter := s.TestAccs[1].String()
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	allowance := sdk.NewInt(100)
+	_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, types.DenomRoleMinter, minter, &allowance))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 60)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 41)))
+	s.Require().ErrorIs(err, types.ErrMinterAllowanceExceeded)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+
+	authorityMetadata, err := s.App.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroInt(), *authorityMetadata.MinterAllowance)
+	s.Require().Equal(sdk.NewInt(100), s.App.Keepers.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], denom).Amount)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 1)))
+	s.Require().ErrorIs(err, types.ErrMinterAllowanceExceeded)
+
+	// granting the minter role again without allowance makes the minter unlimited
+	_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, types.DenomRoleMinter, minter, nil))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(minter, sdk.NewInt64Coin(denom, 1000)))
+	s.Require().NoError(err)
+}
+
+// TestChangeAdminMovesRoles tests that the new admin receives the roles
+// held by the previous admin, but not the roles held by other addresses
+func (s *KeeperTestSuite) TestChangeAdminMovesRoles() {
+	oldAdmin := s.TestAccs[0].String()
+	newAdmin := s.TestAccs[1].String()
+	minter := s.TestAccs[2].String()
+
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(oldAdmin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(oldAdmin, denom, types.DenomRoleMinter, minter, nil))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(oldAdmin, denom, newAdmin))
+	s.Require().NoError(err)
+
+	authorityMetadata, err := s.App.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomAuthorityMetadata{
+		Admin:            newAdmin,
+		Minter:           minter,
+		Burner:           newAdmin,
+		ForceTransferrer: newAdmin,
+		MetadataManager:  newAdmin,
+		HookManager:      newAdmin,
+	}, authorityMetadata)
+}
+
+// TestRenounceCapabilityMsg tests that a renounced capability can never be used again,
+// not even by a later admin
+func (s *KeeperTestSuite) TestRenounceCapabilityMsg() {
+	for _, tc := range []struct {
+		desc       string
+		capability types.DenomCapability
+		send       func(sender string, denom string) error
+	}{
+		{
+			desc:       "mint",
+			capability: types.DenomCapabilityMint,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(sender, sdk.NewInt64Coin(denom, 10)))
+				return err
+			},
+		},
+		{
+			desc:       "burn from",
+			capability: types.DenomCapabilityBurnFrom,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(sender, sdk.NewInt64Coin(denom, 10), s.TestAccs[2].String()))
+				return err
+			},
+		},
+		{
+			desc:       "force transfer",
+			capability: types.DenomCapabilityForceTransfer,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.ForceTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceTransfer(sender, sdk.NewInt64Coin(denom, 10), s.TestAccs[2].String(), sender))
+				return err
+			},
+		},
+		{
+			desc:       "hook changes",
+			capability: types.DenomCapabilityHookChanges,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(sender, denom, ""))
+				return err
+			},
+		},
+		{
+			desc:       "hook gas limits changes",
+			capability: types.DenomCapabilityHookChanges,
+			send: func(sender string, denom string) error {
+				_, err := s.msgServer.SetBeforeSendHookGasLimits(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHookGasLimits(sender, denom, 0, 0))
+				return err
+			},
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			admin := s.TestAccs[0].String()
+			newAdmin := s.TestAccs[1].String()
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 100), s.TestAccs[2].String()))
+			s.Require().NoError(err)
+
+			// only the admin renounces capabilities
+			_, err = s.msgServer.RenounceCapability(sdk.WrapSDKContext(s.Ctx), types.NewMsgRenounceCapability(newAdmin, denom, tc.capability))
+			s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = s.msgServer.RenounceCapability(sdk.WrapSDKContext(ctx), types.NewMsgRenounceCapability(admin, denom, tc.capability))
+			s.Require().NoError(err)
+			s.AssertEventEmitted(ctx, types.TypeMsgRenounceCapability, 1)
+
+			authorityMetadata, err := s.App.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+			s.Require().NoError(err)
+			s.Require().True(authorityMetadata.IsRenounced(tc.capability))
+			s.Require().ErrorIs(tc.send(admin, denom), types.ErrCapabilityRenounced)
+
+			// a capability can only be renounced once
+			_, err = s.msgServer.RenounceCapability(sdk.WrapSDKContext(s.Ctx), types.NewMsgRenounceCapability(admin, denom, tc.capability))
+			s.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+
+			// changing the admin doesn't restore the capability
+			_, err = s.msgServer.ChangeAdmin(sdk.WrapSDKContext(s.Ctx), types.NewMsgChangeAdmin(admin, denom, newAdmin))
+			s.Require().NoError(err)
+			s.Require().ErrorIs(tc.send(newAdmin, denom), types.ErrCapabilityRenounced)
+		})
+	}
+}
+
+// TestBurnOwnTokensAfterRenouncingBurnFrom tests that the burner can still burn
+// its own tokens once burning from other accounts has been renounced
+func (s *KeeperTestSuite) TestBurnOwnTokensAfterRenouncingBurnFrom() {
+	admin := s.TestAccs[0].String()
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(admin, sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RenounceCapability(sdk.WrapSDKContext(s.Ctx), types.NewMsgRenounceCapability(admin, denom, types.DenomCapabilityBurnFrom))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(admin, sdk.NewInt64Coin(denom, 40)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(60), s.App.Keepers.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+}
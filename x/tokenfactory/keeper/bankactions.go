@@ -1,12 +1,14 @@
 package keeper
 
 import (
+	"math/bits"
 	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	errorsmod "cosmossdk.io/errors"
 	customterratypes "github.com/terra-money/core/v2/x/bank/types"
@@ -20,6 +22,11 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	err = k.checkMaxSupply(ctx, amount)
+	if err != nil {
+		return err
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -30,11 +37,66 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(withAuthorityTransfer(ctx, amount.Denom, moduleAddr, addr), types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
 }
 
+// mintBatch mints the total amount of the recipients at once and sends each
+// recipient its amount with its own send, so that the before send hooks of the
+// denom are called once per recipient, as for a mint to each of them. The cost
+// of these hook calls is charged on top of the mint batch gas per recipient.
+func (k Keeper) mintBatch(ctx sdk.Context, denom string, recipients []types.MintBatchRecipient) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	// if MintBatchGasPerRecipient is non-zero, consume the gas for each recipient
+	params := k.GetParams(ctx)
+	if params.MintBatchGasPerRecipient != 0 {
+		overflow, gas := bits.Mul64(params.MintBatchGasPerRecipient, uint64(len(recipients)))
+		if overflow != 0 {
+			return types.ErrInvalidMintBatch.Wrapf("too many recipients: %d", len(recipients))
+		}
+		ctx.GasMeter().ConsumeGas(gas, "consume mint batch gas")
+	}
+
+	total := sdk.ZeroInt()
+	for _, recipient := range recipients {
+		total = total.Add(recipient.Amount)
+	}
+	totalCoin := sdk.NewCoin(denom, total)
+
+	err = k.checkMaxSupply(ctx, totalCoin)
+	if err != nil {
+		return err
+	}
+
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(totalCoin))
+	if err != nil {
+		return err
+	}
+
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	for _, recipient := range recipients {
+		addr, err := sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return err
+		}
+
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(withAuthorityTransfer(ctx, denom, moduleAddr, addr), types.ModuleName,
+			addr,
+			sdk.NewCoins(sdk.NewCoin(denom, recipient.Amount)))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(amount.Denom)
@@ -48,6 +110,12 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	}
 	coins := sdk.NewCoins(amount)
 
+	recipientAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if recipientAcc == nil {
+		panic(errorsmod.Wrapf(customterratypes.ErrUnknownAddress, "module account %s does not exist", recipientAcc))
+	}
+
+	ctx = withAuthorityTransfer(ctx, amount.Denom, addr, recipientAcc.GetAddress())
 	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
 		addr,
 		types.ModuleName,
@@ -55,10 +123,6 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 	if err != nil {
 		return err
 	}
-	recipientAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
-	if recipientAcc == nil {
-		panic(errorsmod.Wrapf(customterratypes.ErrUnknownAddress, "module account %s does not exist", recipientAcc))
-	}
 
 	err = k.bankKeeper.BlockBeforeSend(ctx, addr, recipientAcc.GetAddress(), coins)
 	if err != nil {
@@ -108,5 +172,5 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return err
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	return k.bankKeeper.SendCoins(withAuthorityTransfer(ctx, amount.Denom, fromSdkAddr, toSdkAddr), fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
 }
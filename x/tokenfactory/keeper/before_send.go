@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -11,7 +13,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 )
 
-func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string) error {
+func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress string, version types.BeforeSendHookVersion) error {
 	// verify that denom is an x/tokenfactory denom
 	_, _, err := types.DeconstructDenom(denom)
 	if err != nil {
@@ -20,9 +22,14 @@ func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress
 
 	store := k.GetDenomPrefixStore(ctx, denom)
 
+	// a new hook starts without any failure and with its track calls enabled
+	store.Delete([]byte(types.BeforeSendHookFailuresKey))
+	store.Delete([]byte(types.BeforeSendHookTrackDisabledKey))
+
 	// delete the store for denom prefix store when cosmwasm address is nil
 	if cosmwasmAddress == "" {
 		store.Delete([]byte(types.BeforeSendHookAddressPrefixKey))
+		store.Delete([]byte(types.BeforeSendHookVersionKey))
 		return nil
 	}
 
@@ -31,8 +38,20 @@ func (k Keeper) setBeforeSendHook(ctx sdk.Context, denom string, cosmwasmAddress
 		return err
 	}
 
+	if _, ok := types.BeforeSendHookVersion_name[int32(version)]; !ok {
+		return types.ErrInvalidHookVersion.Wrapf("version: %s", version)
+	}
+
 	store.Set([]byte(types.BeforeSendHookAddressPrefixKey), []byte(cosmwasmAddress))
 
+	// the legacy version is the default so that the hooks registered before the
+	// versions existed keep receiving the same sudo messages
+	if version == types.BeforeSendHookVersionV1 {
+		store.Delete([]byte(types.BeforeSendHookVersionKey))
+	} else {
+		store.Set([]byte(types.BeforeSendHookVersionKey), sdk.Uint64ToBigEndian(uint64(version)))
+	}
+
 	return nil
 }
 
@@ -47,6 +66,178 @@ func (k Keeper) GetBeforeSendHook(ctx sdk.Context, denom string) string {
 	return string(bz)
 }
 
+// GetBeforeSendHookVersion returns the version of the sudo messages sent to
+// the before send hook of a denom.
+func (k Keeper) GetBeforeSendHookVersion(ctx sdk.Context, denom string) types.BeforeSendHookVersion {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookVersionKey))
+	if bz == nil {
+		return types.BeforeSendHookVersionV1
+	}
+
+	return types.BeforeSendHookVersion(sdk.BigEndianToUint64(bz))
+}
+
+// GetBeforeSendHookGasLimits returns the gas limits set for the before send
+// hook calls of a denom, which are zero when the params apply.
+func (k Keeper) GetBeforeSendHookGasLimits(ctx sdk.Context, denom string) types.BeforeSendHookGasLimits {
+	var gasLimits types.BeforeSendHookGasLimits
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookGasLimitsKey))
+	if bz == nil {
+		return gasLimits
+	}
+
+	k.cdc.MustUnmarshal(bz, &gasLimits)
+	return gasLimits
+}
+
+// setBeforeSendHookGasLimits sets the gas limits of the before send hook calls
+// of a denom, which cannot exceed the max before send gas limit of the params.
+func (k Keeper) setBeforeSendHookGasLimits(ctx sdk.Context, denom string, gasLimits types.BeforeSendHookGasLimits) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	maxGasLimit := k.GetParams(ctx).MaxBeforeSendGasLimit
+	if gasLimits.TrackGasLimit > maxGasLimit || gasLimits.BlockGasLimit > maxGasLimit {
+		return types.ErrInvalidHookGasLimit.Wrapf("gas limits must not exceed the max before send gas limit: %d", maxGasLimit)
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if gasLimits.TrackGasLimit == 0 && gasLimits.BlockGasLimit == 0 {
+		store.Delete([]byte(types.BeforeSendHookGasLimitsKey))
+		return nil
+	}
+
+	store.Set([]byte(types.BeforeSendHookGasLimitsKey), k.cdc.MustMarshal(&gasLimits))
+	return nil
+}
+
+// getBeforeSendHookGasLimit returns the gas limit of a before send hook call
+// carrying the coins of the denoms, which is the highest gas limit of the
+// denoms, capped to the max before send gas limit of the params.
+func (k Keeper) getBeforeSendHookGasLimit(ctx sdk.Context, params types.Params, denoms []string, blockBeforeSend bool) uint64 {
+	var gasLimit uint64
+	for _, denom := range denoms {
+		gasLimits := k.GetBeforeSendHookGasLimits(ctx, denom)
+
+		denomGasLimit := gasLimits.TrackGasLimit
+		if denomGasLimit == 0 {
+			denomGasLimit = params.TrackBeforeSendGasLimit
+		}
+		if blockBeforeSend {
+			denomGasLimit = gasLimits.BlockGasLimit
+			if denomGasLimit == 0 {
+				denomGasLimit = params.BlockBeforeSendGasLimit
+			}
+		}
+
+		if denomGasLimit > gasLimit {
+			gasLimit = denomGasLimit
+		}
+	}
+
+	if gasLimit > params.MaxBeforeSendGasLimit {
+		return params.MaxBeforeSendGasLimit
+	}
+	return gasLimit
+}
+
+// GetBeforeSendHookFailures returns the number of consecutive failed track
+// before send hook calls of a denom.
+func (k Keeper) GetBeforeSendHookFailures(ctx sdk.Context, denom string) uint64 {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.BeforeSendHookFailuresKey))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// recordBeforeSendHookFailure increments the consecutive failures of the
+// before send hook of a denom, and disables the track before send calls of the
+// hook once the failures reach the max before send hook failures of the params.
+// The hook itself is kept, so that its block before send calls keep enforcing
+// the transfers of the denom. The track calls are never disabled when the hook
+// changes of the denom are renounced, since they could not be enabled again.
+func (k Keeper) recordBeforeSendHookFailure(ctx sdk.Context, params types.Params, denom string, cosmwasmAddress string, err error) {
+	failures := k.GetBeforeSendHookFailures(ctx, denom) + 1
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBeforeSendHookFailed,
+			sdk.NewAttribute(types.AttributeDenom, denom),
+			sdk.NewAttribute(types.AttributeBeforeSendHookAddress, cosmwasmAddress),
+			sdk.NewAttribute(types.AttributeConsecutiveFailures, strconv.FormatUint(failures, 10)),
+			sdk.NewAttribute(types.AttributeError, err.Error()),
+		),
+	)
+
+	if params.MaxBeforeSendHookFailures == 0 || failures < params.MaxBeforeSendHookFailures {
+		k.setBeforeSendHookFailures(ctx, denom, failures)
+		return
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil || authorityMetadata.IsRenounced(types.DenomCapabilityHookChanges) {
+		k.setBeforeSendHookFailures(ctx, denom, failures)
+		return
+	}
+
+	k.setBeforeSendHookFailures(ctx, denom, 0)
+	k.setBeforeSendHookTrackDisabled(ctx, denom, true)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBeforeSendHookTrackDisabled,
+			sdk.NewAttribute(types.AttributeDenom, denom),
+			sdk.NewAttribute(types.AttributeBeforeSendHookAddress, cosmwasmAddress),
+			sdk.NewAttribute(types.AttributeConsecutiveFailures, strconv.FormatUint(failures, 10)),
+		),
+	)
+}
+
+// IsBeforeSendHookTrackDisabled returns whether the track before send calls
+// of the before send hook of a denom were disabled after too many consecutive
+// failures.
+func (k Keeper) IsBeforeSendHookTrackDisabled(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.BeforeSendHookTrackDisabledKey))
+}
+
+// setBeforeSendHookTrackDisabled disables or enables the track before send
+// calls of the before send hook of a denom.
+func (k Keeper) setBeforeSendHookTrackDisabled(ctx sdk.Context, denom string, disabled bool) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if !disabled {
+		store.Delete([]byte(types.BeforeSendHookTrackDisabledKey))
+		return
+	}
+
+	store.Set([]byte(types.BeforeSendHookTrackDisabledKey), []byte{1})
+}
+
+// setBeforeSendHookFailures sets the consecutive failures of the before send
+// hook of a denom.
+func (k Keeper) setBeforeSendHookFailures(ctx sdk.Context, denom string, failures uint64) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if failures == 0 {
+		store.Delete([]byte(types.BeforeSendHookFailuresKey))
+		return
+	}
+
+	store.Set([]byte(types.BeforeSendHookFailuresKey), sdk.Uint64ToBigEndian(failures))
+}
+
+// resetBeforeSendHookFailures resets the consecutive failures of the before
+// send hook of a denom after a successful track before send hook call.
+func (k Keeper) resetBeforeSendHookFailures(ctx sdk.Context, denom string) {
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if store.Has([]byte(types.BeforeSendHookFailuresKey)) {
+		store.Delete([]byte(types.BeforeSendHookFailuresKey))
+	}
+}
+
 func CWCoinsFromSDKCoins(in sdk.Coins) wasmvmtypes.Coins {
 	var cwCoins wasmvmtypes.Coins
 	for _, coin := range in {
@@ -74,83 +265,183 @@ func (k Keeper) Hooks() Hooks {
 	return Hooks{k}
 }
 
-// TrackBeforeSend calls the before send listener contract surpresses any errors
+// TrackBeforeSend calls the before send listener contract, recording its failures instead of returning them
 func (h Hooks) TrackBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) {
 	/* #nosec */
 	_ = h.k.callBeforeSendListener(ctx, from, to, amount, false)
 }
 
-// BlockBeforeSend calls the before send listener contract returns any errors
+// BlockBeforeSend checks the freeze list of the coins and then calls the before
+// send listener contract returns any errors
 func (h Hooks) BlockBeforeSend(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	if err := h.k.checkFreezeList(ctx, from, to, amount); err != nil {
+		return err
+	}
 	return h.k.callBeforeSendListener(ctx, from, to, amount, true)
 }
 
-// callBeforeSendListener iterates over each coin and sends corresponding sudo msg to the contract address stored in state.
-// If blockBeforeSend is true, sudoMsg wraps BlockBeforeSendMsg, otherwise sudoMsg wraps TrackBeforeSendMsg.
-// Note that we gas meter trackBeforeSend to prevent infinite contract calls.
+// beforeSendHookCall is a sudo msg to send to a before send hook contract, along with the denoms of the coins it
+// carries.
+type beforeSendHookCall struct {
+	cosmwasmAddress string
+	denoms          []string
+	msg             interface{}
+}
+
+// callBeforeSendListener sends the sudo msgs to the contract addresses stored in state for the denoms of the amount.
+// The contracts registered with BeforeSendHookVersionV1 receive one sudo msg per coin, while the contracts registered
+// with BeforeSendHookVersionV2 receive a single sudo msg with all the coins whose denoms are hooked to the contract.
+// If blockBeforeSend is true, sudoMsg wraps BlockBeforeSendMsg and the first failed call is returned, otherwise
+// sudoMsg wraps TrackBeforeSendMsg, the hooks whose track calls were disabled are skipped and the failed calls are
+// recorded by recordBeforeSendHookFailure.
+// Note that each call is gas metered with the gas limit of the hook to prevent infinite contract calls.
 // CONTRACT: this should not be called in beginBlock or endBlock since out of gas will cause this method to panic.
-func (k Keeper) callBeforeSendListener(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) (err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			err = errorsmod.Wrapf(types.ErrTrackBeforeSendOutOfGas, "%v", r)
+func (k Keeper) callBeforeSendListener(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) error {
+	calls := k.getBeforeSendHookCalls(ctx, from, to, amount, blockBeforeSend)
+	if len(calls) == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, call := range calls {
+		gasLimit := k.getBeforeSendHookGasLimit(ctx, params, call.denoms, blockBeforeSend)
+		err := k.sudoBeforeSendHook(ctx, call.cosmwasmAddress, call.msg, gasLimit)
+
+		if blockBeforeSend {
+			if err != nil {
+				return errorsmod.Wrapf(err, "failed to call before send hook for denoms %s", strings.Join(call.denoms, ","))
+			}
+			continue
 		}
-	}()
+
+		for _, denom := range call.denoms {
+			if err != nil {
+				k.recordBeforeSendHookFailure(ctx, params, denom, call.cosmwasmAddress, err)
+			} else {
+				k.resetBeforeSendHookFailures(ctx, denom)
+			}
+		}
+	}
+	return nil
+}
+
+// getBeforeSendHookCalls returns the calls to make to the before send hook contracts of the denoms of the amount,
+// with the calls of the v2 hooks grouped per contract after the calls of the v1 hooks.
+func (k Keeper) getBeforeSendHookCalls(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins, blockBeforeSend bool) []beforeSendHookCall {
+	var calls []beforeSendHookCall
+
+	// group the coins of the v2 hooks per contract, in the order of the coins
+	var v2Contracts []string
+	v2Coins := map[string]sdk.Coins{}
 
 	for _, coin := range amount {
 		cosmwasmAddress := k.GetBeforeSendHook(ctx, coin.Denom)
-		if cosmwasmAddress != "" {
-			cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
-			if err != nil {
-				return err
+		if cosmwasmAddress == "" {
+			continue
+		}
+		if !blockBeforeSend && k.IsBeforeSendHookTrackDisabled(ctx, coin.Denom) {
+			continue
+		}
+
+		if k.GetBeforeSendHookVersion(ctx, coin.Denom) == types.BeforeSendHookVersionV2 {
+			if _, found := v2Coins[cosmwasmAddress]; !found {
+				v2Contracts = append(v2Contracts, cosmwasmAddress)
 			}
+			v2Coins[cosmwasmAddress] = append(v2Coins[cosmwasmAddress], coin)
+			continue
+		}
 
-			var msgBz []byte
-
-			// get msgBz, either BlockBeforeSend or TrackBeforeSend
-			// Note that for trackBeforeSend, we need to gas meter computations to prevent infinite loop
-			// specifically because module to module sends are not gas metered.
-			// We don't need to do this for blockBeforeSend since blockBeforeSend is not called during module to module sends.
-			if blockBeforeSend {
-				msg := types.BlockBeforeSendSudoMsg{
-					BlockBeforeSend: types.BlockBeforeSendMsg{
-						From:   from.String(),
-						To:     to.String(),
-						Amount: CWCoinFromSDKCoin(coin),
-					},
-				}
-				msgBz, err = json.Marshal(msg)
-			} else {
-				msg := types.TrackBeforeSendSudoMsg{
-					TrackBeforeSend: types.TrackBeforeSendMsg{
-						From:   from.String(),
-						To:     to.String(),
-						Amount: CWCoinFromSDKCoin(coin),
-					},
-				}
-				msgBz, err = json.Marshal(msg)
+		var msg interface{}
+		if blockBeforeSend {
+			msg = types.BlockBeforeSendSudoMsg{
+				BlockBeforeSend: types.BlockBeforeSendMsg{
+					From:   from.String(),
+					To:     to.String(),
+					Amount: CWCoinFromSDKCoin(coin),
+				},
 			}
-			if err != nil {
-				return err
+		} else {
+			msg = types.TrackBeforeSendSudoMsg{
+				TrackBeforeSend: types.TrackBeforeSendMsg{
+					From:   from.String(),
+					To:     to.String(),
+					Amount: CWCoinFromSDKCoin(coin),
+				},
 			}
-			em := sdk.NewEventManager()
-
-			// if its track before send, apply gas meter to prevent infinite loop
-			if blockBeforeSend {
-				_, err = k.contractKeeper.Sudo(ctx.WithEventManager(em), cwAddr, msgBz)
-				if err != nil {
-					return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
-				}
-			} else {
-				childCtx := ctx.WithGasMeter(sdk.NewGasMeter(types.TrackBeforeSendGasLimit))
-				_, err = k.contractKeeper.Sudo(childCtx.WithEventManager(em), cwAddr, msgBz)
-				if err != nil {
-					return errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
-				}
-
-				// consume gas used for calling contract to the parent ctx
-				ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumed(), "track before send gas")
+		}
+		calls = append(calls, beforeSendHookCall{
+			cosmwasmAddress: cosmwasmAddress,
+			denoms:          []string{coin.Denom},
+			msg:             msg,
+		})
+	}
+
+	for _, cosmwasmAddress := range v2Contracts {
+		coins := v2Coins[cosmwasmAddress]
+
+		var msg interface{}
+		if blockBeforeSend {
+			msg = types.BlockBeforeSendV2SudoMsg{
+				BlockBeforeSendV2: types.BeforeSendV2Msg{
+					From:   from.String(),
+					To:     to.String(),
+					Amount: CWCoinsFromSDKCoins(coins),
+				},
+			}
+		} else {
+			msg = types.TrackBeforeSendV2SudoMsg{
+				TrackBeforeSendV2: types.BeforeSendV2Msg{
+					From:   from.String(),
+					To:     to.String(),
+					Amount: CWCoinsFromSDKCoins(coins),
+				},
 			}
 		}
+		denoms := make([]string, 0, len(coins))
+		for _, coin := range coins {
+			denoms = append(denoms, coin.Denom)
+		}
+		calls = append(calls, beforeSendHookCall{
+			cosmwasmAddress: cosmwasmAddress,
+			denoms:          denoms,
+			msg:             msg,
+		})
+	}
+	return calls
+}
+
+// sudoBeforeSendHook sends the sudo msg to the before send hook contract with a gas meter limited to gasLimit,
+// and consumes the gas used by the contract on the parent ctx. The gas metering is specifically needed for
+// trackBeforeSend to prevent infinite loops because module to module sends are not gas metered. The state changes
+// of the contract are discarded when the call fails, so that a failed trackBeforeSend leaves no partial changes.
+func (k Keeper) sudoBeforeSendHook(ctx sdk.Context, cosmwasmAddress string, msg interface{}, gasLimit uint64) (err error) {
+	cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	// the messages dispatched by the contract must not skip the freeze list
+	// like the authority transfer that may have triggered the hook
+	childCtx, write := withoutAuthorityTransfer(ctx).WithGasMeter(sdk.NewGasMeter(gasLimit)).CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrTrackBeforeSendOutOfGas, "%v", r)
+		}
+
+		// consume gas used for calling contract to the parent ctx
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "before send hook gas")
+	}()
+
+	_, err = k.contractKeeper.Sudo(childCtx.WithEventManager(sdk.NewEventManager()), cwAddr, msgBz)
+	if err != nil {
+		return err
 	}
+
+	write()
 	return nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 
@@ -231,3 +232,303 @@ func (s *KeeperTestSuite) TestGetBeforeSendHook() {
 
 	s.Require().Equal(expected, res)
 }
+
+// sudoRecorder is a contract keeper recording the sudo msgs sent to the
+// contracts instead of executing them, failing them with err when set.
+type sudoRecorder struct {
+	contracts []string
+	msgs      []string
+	gasLimits []uint64
+	err       error
+}
+
+func (r *sudoRecorder) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	r.contracts = append(r.contracts, contractAddress.String())
+	r.msgs = append(r.msgs, string(msg))
+	r.gasLimits = append(r.gasLimits, ctx.GasMeter().Limit())
+	return nil, r.err
+}
+
+func (r *sudoRecorder) HasContractInfo(_ sdk.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+// TestBeforeSendHookVersions tests that the v2 hooks receive a single sudo msg
+// per transfer with all their coins while the v1 hooks receive one sudo msg
+// per coin
+func (s *KeeperTestSuite) TestBeforeSendHookVersions() {
+	recorder := &sudoRecorder{}
+	k := s.App.Keepers.TokenFactoryKeeper
+	k.SetContractKeeper(recorder)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	v1Contract := s.TestAccs[1].String()
+	v2Contract := s.TestAccs[2].String()
+
+	var coins sdk.Coins
+	for _, tc := range []struct {
+		subdenom string
+		contract string
+		version  types.BeforeSendHookVersion
+	}{
+		{"aaa", v2Contract, types.BeforeSendHookVersionV2},
+		{"bbb", v1Contract, types.BeforeSendHookVersionV1},
+		{"ccc", v2Contract, types.BeforeSendHookVersionV2},
+		{"ddd", v1Contract, types.BeforeSendHookVersionV1},
+		{"eee", "", types.BeforeSendHookVersionV1},
+	} {
+		res, err := msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), tc.subdenom))
+		s.Require().NoError(err)
+		denom := res.GetNewTokenDenom()
+		if tc.contract != "" {
+			msg := types.NewMsgSetVersionedBeforeSendHook(s.TestAccs[0].String(), denom, tc.contract, tc.version)
+			s.Require().NoError(msg.ValidateBasic())
+			_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), msg)
+			s.Require().NoError(err)
+		}
+		s.Require().Equal(tc.version, k.GetBeforeSendHookVersion(s.Ctx, denom))
+		coins = coins.Add(sdk.NewInt64Coin(denom, 100))
+	}
+
+	from, to := s.TestAccs[0], s.TestAccs[1]
+	expectedMsgs := func(v1Key, v2Key string) []string {
+		var msgs []string
+		for _, coin := range []sdk.Coin{coins[1], coins[3]} {
+			bz, err := json.Marshal(map[string]types.BlockBeforeSendMsg{
+				v1Key: {From: from.String(), To: to.String(), Amount: keeper.CWCoinFromSDKCoin(coin)},
+			})
+			s.Require().NoError(err)
+			msgs = append(msgs, string(bz))
+		}
+		bz, err := json.Marshal(map[string]types.BeforeSendV2Msg{
+			v2Key: {From: from.String(), To: to.String(), Amount: keeper.CWCoinsFromSDKCoins(sdk.NewCoins(coins[0], coins[2]))},
+		})
+		s.Require().NoError(err)
+		return append(msgs, string(bz))
+	}
+
+	err := k.Hooks().BlockBeforeSend(s.Ctx, from, to, coins)
+	s.Require().NoError(err)
+	s.Require().Equal([]string{v1Contract, v1Contract, v2Contract}, recorder.contracts)
+	s.Require().Equal(expectedMsgs("block_before_send", "block_before_send_v2"), recorder.msgs)
+
+	*recorder = sudoRecorder{}
+	k.Hooks().TrackBeforeSend(s.Ctx, from, to, coins)
+	s.Require().Equal([]string{v1Contract, v1Contract, v2Contract}, recorder.contracts)
+	s.Require().Equal(expectedMsgs("track_before_send", "track_before_send_v2"), recorder.msgs)
+
+	// removing the hook resets its version
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(s.TestAccs[0].String(), coins[0].Denom, ""))
+	s.Require().NoError(err)
+	s.Require().Equal(types.BeforeSendHookVersionV1, k.GetBeforeSendHookVersion(s.Ctx, coins[0].Denom))
+
+	queryRes, err := k.BeforeSendHookAddress(s.Ctx, &types.QueryBeforeSendHookAddressRequest{Denom: coins[2].Denom})
+	s.Require().NoError(err)
+	s.Require().Equal(v2Contract, queryRes.CosmwasmAddress)
+	s.Require().Equal(types.BeforeSendHookVersionV2, queryRes.Version)
+}
+
+// TestSetBeforeSendHookGasLimitsMsg tests that the admin can set the gas limits
+// of the before send hook calls of a denom up to the max gas limit of the params
+func (s *KeeperTestSuite) TestSetBeforeSendHookGasLimitsMsg() {
+	recorder := &sudoRecorder{}
+	k := s.App.Keepers.TokenFactoryKeeper
+	k.SetContractKeeper(recorder)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	admin := s.TestAccs[0].String()
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+
+	params := types.DefaultParams()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	callHooks := func() []uint64 {
+		*recorder = sudoRecorder{}
+		s.Require().NoError(k.Hooks().BlockBeforeSend(s.Ctx, s.TestAccs[0], s.TestAccs[1], coins))
+		k.Hooks().TrackBeforeSend(s.Ctx, s.TestAccs[0], s.TestAccs[1], coins)
+		return recorder.gasLimits
+	}
+	s.Require().Equal([]uint64{params.BlockBeforeSendGasLimit, params.TrackBeforeSendGasLimit}, callHooks())
+
+	for _, tc := range []struct {
+		desc          string
+		sender        string
+		trackGasLimit uint64
+		blockGasLimit uint64
+		expectedErr   error
+	}{
+		{
+			desc:          "non-admin",
+			sender:        s.TestAccs[1].String(),
+			trackGasLimit: 200_000,
+			expectedErr:   types.ErrUnauthorized,
+		},
+		{
+			desc:          "above the max gas limit",
+			sender:        admin,
+			blockGasLimit: params.MaxBeforeSendGasLimit + 1,
+			expectedErr:   types.ErrInvalidHookGasLimit,
+		},
+		{
+			desc:          "track gas limit only",
+			sender:        admin,
+			trackGasLimit: 200_000,
+		},
+		{
+			desc:          "both gas limits",
+			sender:        admin,
+			trackGasLimit: 300_000,
+			blockGasLimit: params.MaxBeforeSendGasLimit,
+		},
+	} {
+		ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgSetBeforeSendHookGasLimits(tc.sender, denom, tc.trackGasLimit, tc.blockGasLimit)
+		s.Require().NoError(msg.ValidateBasic())
+		_, err := msgServer.SetBeforeSendHookGasLimits(sdk.WrapSDKContext(ctx), msg)
+		if tc.expectedErr != nil {
+			s.Require().ErrorIs(err, tc.expectedErr, tc.desc)
+			s.AssertEventEmitted(ctx, types.TypeMsgSetHookGasLimits, 0)
+			continue
+		}
+		s.Require().NoError(err, tc.desc)
+		s.AssertEventEmitted(ctx, types.TypeMsgSetHookGasLimits, 1)
+
+		expectedBlockGasLimit := tc.blockGasLimit
+		if expectedBlockGasLimit == 0 {
+			expectedBlockGasLimit = params.BlockBeforeSendGasLimit
+		}
+		s.Require().Equal([]uint64{expectedBlockGasLimit, tc.trackGasLimit}, callHooks(), tc.desc)
+	}
+
+	queryRes, err := k.BeforeSendHookAddress(s.Ctx, &types.QueryBeforeSendHookAddressRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(types.BeforeSendHookGasLimits{TrackGasLimit: 300_000, BlockGasLimit: params.MaxBeforeSendGasLimit}, queryRes.GasLimits)
+
+	// lowering the max gas limit caps the gas limits of the denom
+	params.MaxBeforeSendGasLimit = 250_000
+	params.BlockBeforeSendGasLimit = 250_000
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	s.Require().Equal([]uint64{250_000, 250_000}, callHooks())
+
+	// resetting the gas limits of the denom uses the gas limits of the params
+	_, err = msgServer.SetBeforeSendHookGasLimits(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHookGasLimits(admin, denom, 0, 0))
+	s.Require().NoError(err)
+	s.Require().Equal(types.BeforeSendHookGasLimits{}, k.GetBeforeSendHookGasLimits(s.Ctx, denom))
+	s.Require().Equal([]uint64{250_000, params.TrackBeforeSendGasLimit}, callHooks())
+}
+
+// TestBeforeSendHookFailures tests that the failed track before send hook
+// calls are counted and that the track calls of the hook are disabled after
+// too many consecutive failures, while its block calls are still made
+func (s *KeeperTestSuite) TestBeforeSendHookFailures() {
+	recorder := &sudoRecorder{}
+	k := s.App.Keepers.TokenFactoryKeeper
+	k.SetContractKeeper(recorder)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.DefaultParams()
+	params.MaxBeforeSendHookFailures = 3
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+
+	admin := s.TestAccs[0].String()
+	contract := s.TestAccs[2].String()
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, contract))
+	s.Require().NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	trackBeforeSend := func() sdk.Context {
+		ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+		k.Hooks().TrackBeforeSend(ctx, s.TestAccs[0], s.TestAccs[1], coins)
+		return ctx
+	}
+
+	// failed calls are counted
+	recorder.err = fmt.Errorf("hook failed")
+	for i := 1; i < 3; i++ {
+		ctx := trackBeforeSend()
+		s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookFailed, 1)
+		s.Require().Equal(uint64(i), k.GetBeforeSendHookFailures(s.Ctx, denom))
+	}
+
+	// failed block before send calls return their error without being counted
+	err = k.Hooks().BlockBeforeSend(s.Ctx, s.TestAccs[0], s.TestAccs[1], coins)
+	s.Require().ErrorContains(err, "hook failed")
+	s.Require().Equal(uint64(2), k.GetBeforeSendHookFailures(s.Ctx, denom))
+
+	queryRes, err := k.BeforeSendHookAddress(s.Ctx, &types.QueryBeforeSendHookAddressRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), queryRes.ConsecutiveFailures)
+
+	// a successful call resets the failures
+	recorder.err = nil
+	ctx := trackBeforeSend()
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookFailed, 0)
+	s.Require().Equal(uint64(0), k.GetBeforeSendHookFailures(s.Ctx, denom))
+
+	// the track calls are disabled after max before send hook failures consecutive failures
+	recorder.err = fmt.Errorf("hook failed")
+	for i := 1; i < 3; i++ {
+		trackBeforeSend()
+	}
+	s.Require().False(k.IsBeforeSendHookTrackDisabled(s.Ctx, denom))
+	ctx = trackBeforeSend()
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookFailed, 1)
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookTrackDisabled, 1)
+	s.Require().True(k.IsBeforeSendHookTrackDisabled(s.Ctx, denom))
+	s.Require().Equal(uint64(0), k.GetBeforeSendHookFailures(s.Ctx, denom))
+
+	queryRes, err = k.BeforeSendHookAddress(s.Ctx, &types.QueryBeforeSendHookAddressRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(contract, queryRes.CosmwasmAddress)
+	s.Require().True(queryRes.TrackDisabled)
+
+	// the track calls are no longer made ...
+	*recorder = sudoRecorder{}
+	ctx = trackBeforeSend()
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookFailed, 0)
+	s.Require().Empty(recorder.contracts)
+
+	// ... while the block calls keep enforcing the transfers
+	recorder.err = fmt.Errorf("hook failed")
+	err = k.Hooks().BlockBeforeSend(s.Ctx, s.TestAccs[0], s.TestAccs[1], coins)
+	s.Require().ErrorContains(err, "hook failed")
+	s.Require().Equal([]string{contract}, recorder.contracts)
+
+	// setting the hook again enables its track calls
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, contract))
+	s.Require().NoError(err)
+	s.Require().False(k.IsBeforeSendHookTrackDisabled(s.Ctx, denom))
+	*recorder = sudoRecorder{}
+	trackBeforeSend()
+	s.Require().Equal([]string{contract}, recorder.contracts)
+
+	// hooks are never disabled without max before send hook failures
+	params.MaxBeforeSendHookFailures = 0
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, contract))
+	s.Require().NoError(err)
+	recorder.err = fmt.Errorf("hook failed")
+	for i := 0; i < 5; i++ {
+		trackBeforeSend()
+	}
+	s.Require().False(k.IsBeforeSendHookTrackDisabled(s.Ctx, denom))
+	s.Require().Equal(uint64(5), k.GetBeforeSendHookFailures(s.Ctx, denom))
+
+	// the track calls are never disabled once the hook changes are renounced,
+	// since they could not be enabled again
+	params.MaxBeforeSendHookFailures = 3
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+	_, err = msgServer.RenounceCapability(sdk.WrapSDKContext(s.Ctx), types.NewMsgRenounceCapability(admin, denom, types.DenomCapabilityHookChanges))
+	s.Require().NoError(err)
+	ctx = trackBeforeSend()
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookFailed, 1)
+	s.AssertEventEmitted(ctx, types.EventTypeBeforeSendHookTrackDisabled, 0)
+	s.Require().False(k.IsBeforeSendHookTrackDisabled(s.Ctx, denom))
+	s.Require().Equal(uint64(6), k.GetBeforeSendHookFailures(s.Ctx, denom))
+}
@@ -41,9 +41,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr string,
 		k.bankKeeper.SetDenomMetaData(ctx, denomMetaData)
 	}
 
-	authorityMetadata := types.DenomAuthorityMetadata{
-		Admin: creatorAddr,
-	}
+	authorityMetadata := types.NewAdminAuthorityMetadata(creatorAddr)
 	err = k.setAuthorityMetadata(ctx, denom, authorityMetadata)
 	if err != nil {
 		return err
@@ -0,0 +1,4 @@
+package keeper
+
+// WithAuthorityTransfer exports withAuthorityTransfer to the keeper tests.
+var WithAuthorityTransfer = withAuthorityTransfer
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// authorityTransferKey is the context key flagging the transfer made by the
+// authorities of a denom, which is not restricted by its freeze list.
+type authorityTransferKey struct{}
+
+// authorityTransfer is the transfer of a denom flagged by authorityTransferKey.
+type authorityTransfer struct {
+	denom string
+	from  sdk.AccAddress
+	to    sdk.AccAddress
+}
+
+// withAuthorityTransfer returns a context in which the transfer of denom from
+// an address to another, made by a mint, burn or force transfer of the
+// authorities of the denom, skips the freeze list and the pause of the denom,
+// so that they can still be used to recover the funds of a frozen address.
+// Any other transfer made with the context is still restricted.
+func withAuthorityTransfer(ctx sdk.Context, denom string, from, to sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(authorityTransferKey{}, authorityTransfer{denom: denom, from: from, to: to})
+}
+
+// withoutAuthorityTransfer returns a context in which no transfer skips the
+// freeze list, such as the context passed to the before send hook contracts,
+// whose messages must not inherit the exemption of the authorities.
+func withoutAuthorityTransfer(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(authorityTransferKey{}, authorityTransfer{})
+}
+
+// isAuthorityTransfer returns whether the transfer of denom from an address
+// to another is the one made by the authorities of the denom with the context.
+func isAuthorityTransfer(ctx sdk.Context, denom string, from, to sdk.AccAddress) bool {
+	transfer, ok := ctx.Value(authorityTransferKey{}).(authorityTransfer)
+	return ok && transfer.denom != "" && transfer.denom == denom && transfer.from.Equals(from) && transfer.to.Equals(to)
+}
+
+// IsFrozen returns whether an address is frozen for a denom.
+func (k Keeper) IsFrozen(ctx sdk.Context, denom string, address sdk.AccAddress) bool {
+	return k.getFrozenAddressesStore(ctx, denom).Has(address)
+}
+
+// GetFrozenAddresses returns the addresses frozen for a denom.
+func (k Keeper) GetFrozenAddresses(ctx sdk.Context, denom string) []string {
+	iterator := k.getFrozenAddressesStore(ctx, denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()).String())
+	}
+	return addresses
+}
+
+// setFrozen freezes or unfreezes an address for a denom.
+func (k Keeper) setFrozen(ctx sdk.Context, denom string, address string, frozen bool) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+
+	store := k.getFrozenAddressesStore(ctx, denom)
+	if frozen {
+		store.Set(addr, []byte{1})
+	} else {
+		store.Delete(addr)
+	}
+	return nil
+}
+
+// IsPaused returns whether the transfers of a denom are paused.
+func (k Keeper) IsPaused(ctx sdk.Context, denom string) bool {
+	return k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomPausedKey))
+}
+
+// setPaused pauses or resumes the transfers of a denom.
+func (k Keeper) setPaused(ctx sdk.Context, denom string, paused bool) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(ctx, denom)
+	if paused {
+		store.Set([]byte(types.DenomPausedKey), []byte{1})
+	} else {
+		store.Delete([]byte(types.DenomPausedKey))
+	}
+	return nil
+}
+
+// checkFreezeList returns an error if any of the coins is paused or frozen
+// for the sender or the recipient, unless the transfer is made by the
+// authorities of the denom.
+func (k Keeper) checkFreezeList(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coins) error {
+	for _, coin := range amount {
+		if isAuthorityTransfer(ctx, coin.Denom, from, to) {
+			continue
+		}
+		if k.IsPaused(ctx, coin.Denom) {
+			return types.ErrDenomPaused.Wrapf("denom: %s", coin.Denom)
+		}
+		if k.IsFrozen(ctx, coin.Denom, from) {
+			return types.ErrAddressFrozen.Wrapf("address %s is frozen for denom %s", from, coin.Denom)
+		}
+		if k.IsFrozen(ctx, coin.Denom, to) {
+			return types.ErrAddressFrozen.Wrapf("address %s is frozen for denom %s", to, coin.Denom)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) getFrozenAddressesStore(ctx sdk.Context, denom string) sdk.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.GetFrozenAddressesPrefix(denom))
+}
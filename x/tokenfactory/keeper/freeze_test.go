@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/keeper"
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// TestSetFrozenAddressMsg tests that only the admin can freeze addresses and
+// that frozen addresses can neither send nor receive the denom
+func (s *KeeperTestSuite) TestSetFrozenAddressMsg() {
+	for _, tc := range []struct {
+		desc        string
+		sender      func() string
+		address     func() string
+		frozen      bool
+		expectedErr error
+	}{
+		{
+			desc:    "freeze an address",
+			sender:  func() string { return s.TestAccs[0].String() },
+			address: func() string { return s.TestAccs[1].String() },
+			frozen:  true,
+		},
+		{
+			desc:    "unfreeze an address",
+			sender:  func() string { return s.TestAccs[0].String() },
+			address: func() string { return s.TestAccs[1].String() },
+			frozen:  false,
+		},
+		{
+			desc:        "not the admin",
+			sender:      func() string { return s.TestAccs[1].String() },
+			address:     func() string { return s.TestAccs[1].String() },
+			frozen:      true,
+			expectedErr: types.ErrUnauthorized,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1000), s.TestAccs[1].String()))
+			s.Require().NoError(err)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgSetFrozenAddress(tc.sender(), denom, tc.address(), tc.frozen)
+			s.Require().NoError(msg.ValidateBasic())
+			_, err = s.msgServer.SetFrozenAddress(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetFrozenAddress, 0)
+			} else {
+				s.Require().NoError(err)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetFrozenAddress, 1)
+			}
+
+			frozen := tc.frozen && tc.expectedErr == nil
+			s.Require().Equal(frozen, s.App.Keepers.TokenFactoryKeeper.IsFrozen(s.Ctx, denom, s.TestAccs[1]))
+
+			// the frozen address can neither send nor receive the denom
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+			_, err = s.bankMsgServer.Send(sdk.WrapSDKContext(s.Ctx), banktypes.NewMsgSend(s.TestAccs[1], s.TestAccs[2], coins))
+			_, err2 := s.bankMsgServer.Send(sdk.WrapSDKContext(s.Ctx), banktypes.NewMsgSend(s.TestAccs[2], s.TestAccs[1], coins))
+			if frozen {
+				s.Require().ErrorIs(err, types.ErrAddressFrozen)
+				s.Require().ErrorIs(err2, types.ErrAddressFrozen)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(err2)
+			}
+		})
+	}
+}
+
+// TestSetDenomPausedMsg tests that only the admin can pause a denom and that
+// the transfers of a paused denom are blocked
+func (s *KeeperTestSuite) TestSetDenomPausedMsg() {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1000), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetDenomPaused(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomPaused(s.TestAccs[1].String(), denom, true))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.SetDenomPaused(sdk.WrapSDKContext(ctx), types.NewMsgSetDenomPaused(s.TestAccs[0].String(), denom, true))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, types.TypeMsgSetDenomPaused, 1)
+	s.Require().True(s.App.Keepers.TokenFactoryKeeper.IsPaused(s.Ctx, denom))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	_, err = s.bankMsgServer.Send(sdk.WrapSDKContext(s.Ctx), banktypes.NewMsgSend(s.TestAccs[1], s.TestAccs[2], coins))
+	s.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// other denoms are not paused
+	_, err = s.bankMsgServer.Send(sdk.WrapSDKContext(s.Ctx), banktypes.NewMsgSend(s.TestAccs[1], s.TestAccs[2], sdk.NewCoins(sdk.NewInt64Coin("uluna", 10))))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetDenomPaused(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomPaused(s.TestAccs[0].String(), denom, false))
+	s.Require().NoError(err)
+	s.Require().False(s.App.Keepers.TokenFactoryKeeper.IsPaused(s.Ctx, denom))
+
+	_, err = s.bankMsgServer.Send(sdk.WrapSDKContext(s.Ctx), banktypes.NewMsgSend(s.TestAccs[1], s.TestAccs[2], coins))
+	s.Require().NoError(err)
+}
+
+// TestAuthoritiesBypassFreezeList tests that the authorities of a denom can
+// still mint, burn and force transfer the funds of frozen addresses
+func (s *KeeperTestSuite) TestAuthoritiesBypassFreezeList() {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = s.msgServer.SetFrozenAddress(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetFrozenAddress(s.TestAccs[0].String(), denom, s.TestAccs[1].String(), true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetDenomPaused(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomPaused(s.TestAccs[0].String(), denom, true))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1000), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurnFrom(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 100), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ForceTransfer(sdk.WrapSDKContext(s.Ctx), types.NewMsgForceTransfer(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 900), s.TestAccs[1].String(), s.TestAccs[2].String()))
+	s.Require().NoError(err)
+
+	s.Require().True(s.App.Keepers.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], denom).IsZero())
+	s.Require().Equal(sdk.NewInt(900), s.App.Keepers.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], denom).Amount)
+}
+
+// sendingContract is a contract keeper whose contracts send tokens from their
+// before send hook, the way a contract dispatching a bank send message in its
+// sudo response does, recording the error of the send.
+type sendingContract struct {
+	send    func(ctx sdk.Context) error
+	sending bool
+	err     error
+}
+
+func (c *sendingContract) Sudo(ctx sdk.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	// the send triggers the hook of the denom again
+	if c.sending {
+		return nil, nil
+	}
+	c.sending = true
+	defer func() { c.sending = false }()
+
+	c.err = c.send(ctx)
+	return nil, c.err
+}
+
+func (c *sendingContract) HasContractInfo(_ sdk.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+// TestHooksDoNotBypassFreezeList tests that only the transfer made by the
+// authorities of a denom skips its freeze list, and not the transfers made
+// by the before send hook contract called during the authority transfer
+func (s *KeeperTestSuite) TestHooksDoNotBypassFreezeList() {
+	contract := &sendingContract{}
+	k := s.App.Keepers.TokenFactoryKeeper
+	k.SetContractKeeper(contract)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	admin := s.TestAccs[0].String()
+	frozen := s.TestAccs[1]
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(admin, sdk.NewInt64Coin(denom, 1000), frozen.String()))
+	s.Require().NoError(err)
+	_, err = msgServer.SetFrozenAddress(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetFrozenAddress(admin, denom, frozen.String(), true))
+	s.Require().NoError(err)
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+
+	// the contract tries to move the tokens of the frozen address
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	contract.send = func(ctx sdk.Context) error {
+		return k.Hooks().BlockBeforeSend(ctx, frozen, s.TestAccs[2], coins)
+	}
+
+	// a mint is an authority transfer from the module account
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	ctx := keeper.WithAuthorityTransfer(s.Ctx, denom, moduleAddr, s.TestAccs[0])
+	err = k.Hooks().BlockBeforeSend(ctx, moduleAddr, s.TestAccs[0], coins)
+	s.Require().ErrorIs(err, types.ErrAddressFrozen)
+	s.Require().ErrorIs(contract.err, types.ErrAddressFrozen)
+
+	// the authority transfer itself skips the freeze list
+	contract.send = func(_ sdk.Context) error { return nil }
+	err = k.Hooks().BlockBeforeSend(ctx, moduleAddr, s.TestAccs[0], coins)
+	s.Require().NoError(err)
+
+	// but no other transfer made with its context does
+	err = k.Hooks().BlockBeforeSend(ctx, frozen, s.TestAccs[2], coins)
+	s.Require().ErrorIs(err, types.ErrAddressFrozen)
+	otherCoins := sdk.NewCoins(sdk.NewInt64Coin(denom+"other", 100))
+	_, err = msgServer.SetDenomPaused(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomPaused(admin, denom, true))
+	s.Require().NoError(err)
+	err = k.Hooks().BlockBeforeSend(ctx, moduleAddr, s.TestAccs[0], coins.Add(otherCoins...))
+	s.Require().NoError(err)
+	err = k.Hooks().BlockBeforeSend(ctx, s.TestAccs[0], moduleAddr, coins)
+	s.Require().ErrorIs(err, types.ErrDenomPaused)
+}
+
+func (s *KeeperTestSuite) TestQueryDenomFreezeList() {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	queryRes, err := s.App.Keepers.TokenFactoryKeeper.DenomFreezeList(s.Ctx, &types.QueryDenomFreezeListRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().False(queryRes.Paused)
+	s.Require().Empty(queryRes.FrozenAddresses)
+
+	_, err = s.msgServer.SetFrozenAddress(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetFrozenAddress(s.TestAccs[0].String(), denom, s.TestAccs[1].String(), true))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetDenomPaused(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomPaused(s.TestAccs[0].String(), denom, true))
+	s.Require().NoError(err)
+
+	queryRes, err = s.App.Keepers.TokenFactoryKeeper.DenomFreezeList(s.Ctx, &types.QueryDenomFreezeListRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().True(queryRes.Paused)
+	s.Require().Equal([]string{s.TestAccs[1].String()}, queryRes.FrozenAddresses)
+
+	frozenRes, err := s.App.Keepers.TokenFactoryKeeper.FrozenAddress(s.Ctx, &types.QueryFrozenAddressRequest{Denom: denom, Address: s.TestAccs[1].String()})
+	s.Require().NoError(err)
+	s.Require().True(frozenRes.Frozen)
+
+	frozenRes, err = s.App.Keepers.TokenFactoryKeeper.FrozenAddress(s.Ctx, &types.QueryFrozenAddressRequest{Denom: denom, Address: s.TestAccs[2].String()})
+	s.Require().NoError(err)
+	s.Require().False(frozenRes.Frozen)
+}
@@ -32,6 +32,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		if !genDenom.MaxSupply.IsNil() && !genDenom.MaxSupply.IsZero() {
+			err = k.setMaxSupply(ctx, genDenom.GetDenom(), genDenom.MaxSupply)
+			if err != nil {
+				panic(err)
+			}
+		}
+		for _, address := range genDenom.GetFrozenAddresses() {
+			err = k.setFrozen(ctx, genDenom.GetDenom(), address, true)
+			if err != nil {
+				panic(err)
+			}
+		}
+		if genDenom.Paused {
+			err = k.setPaused(ctx, genDenom.GetDenom(), true)
+			if err != nil {
+				panic(err)
+			}
+		}
+		// setting the hook resets its failures and disabled track calls, which are set afterwards
+		err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress(), genDenom.BeforeSendHookVersion)
+		if err != nil {
+			panic(err)
+		}
+		err = k.setBeforeSendHookGasLimits(ctx, genDenom.GetDenom(), genDenom.BeforeSendHookGasLimits)
+		if err != nil {
+			panic(err)
+		}
+		k.setBeforeSendHookFailures(ctx, genDenom.GetDenom(), genDenom.BeforeSendHookFailures)
+		k.setBeforeSendHookTrackDisabled(ctx, genDenom.GetDenom(), genDenom.BeforeSendHookTrackDisabled)
 	}
 }
 
@@ -48,10 +77,22 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			panic(err)
 		}
 
-		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
-		})
+		genDenom := types.GenesisDenom{
+			Denom:                       denom,
+			AuthorityMetadata:           authorityMetadata,
+			FrozenAddresses:             k.GetFrozenAddresses(ctx, denom),
+			Paused:                      k.IsPaused(ctx, denom),
+			BeforeSendHookAddress:       k.GetBeforeSendHook(ctx, denom),
+			BeforeSendHookVersion:       k.GetBeforeSendHookVersion(ctx, denom),
+			BeforeSendHookGasLimits:     k.GetBeforeSendHookGasLimits(ctx, denom),
+			BeforeSendHookFailures:      k.GetBeforeSendHookFailures(ctx, denom),
+			BeforeSendHookTrackDisabled: k.IsBeforeSendHookTrackDisabled(ctx, denom),
+		}
+		if maxSupply, found := k.GetMaxSupply(ctx, denom); found {
+			genDenom.MaxSupply = maxSupply
+		}
+
+		genDenoms = append(genDenoms, genDenom)
 	}
 
 	return &types.GenesisState{
@@ -9,6 +9,7 @@ import (
 
 func (s *KeeperTestSuite) TestGenesis() {
 	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
 		FactoryDenoms: []types.GenesisDenom{
 			{
 				Denom: "factory/terra13s4gwzxv6dycfctvddfuy6r3zm7d6zklynzzj5/bitcoin",
@@ -21,12 +22,24 @@ func (s *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "terra16jpsrgl423fqg6n0e9edllew9z0gm7rhl5300u",
 				},
+				BeforeSendHookAddress:       "terra1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrquka9l6",
+				BeforeSendHookTrackDisabled: true,
 			},
 			{
 				Denom: "factory/terra13s4gwzxv6dycfctvddfuy6r3zm7d6zklynzzj5/litecoin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "terra13s4gwzxv6dycfctvddfuy6r3zm7d6zklynzzj5",
 				},
+				MaxSupply:       sdk.NewInt(21_000_000),
+				FrozenAddresses: []string{"terra16jpsrgl423fqg6n0e9edllew9z0gm7rhl5300u"},
+				Paused:          true,
+				BeforeSendHookGasLimits: types.BeforeSendHookGasLimits{
+					TrackGasLimit: 200_000,
+					BlockGasLimit: 1_500_000,
+				},
+				BeforeSendHookFailures: 3,
+				BeforeSendHookAddress:  "terra1nc5tatafv6eyq7llkr2gv50ff9e22mnf70qgjlv737ktmt4eswrquka9l6",
+				BeforeSendHookVersion:  types.BeforeSendHookVersionV2,
 			},
 		},
 	}
@@ -47,6 +47,56 @@ func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBefor
 	}
 
 	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	version := k.GetBeforeSendHookVersion(sdkCtx, req.GetDenom())
+	gasLimits := k.GetBeforeSendHookGasLimits(sdkCtx, req.GetDenom())
+	failures := k.GetBeforeSendHookFailures(sdkCtx, req.GetDenom())
+	trackDisabled := k.IsBeforeSendHookTrackDisabled(sdkCtx, req.GetDenom())
 
-	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+	return &types.QueryBeforeSendHookAddressResponse{
+		CosmwasmAddress:     cosmwasmAddress,
+		Version:             version,
+		GasLimits:           gasLimits,
+		ConsecutiveFailures: failures,
+		TrackDisabled:       trackDisabled,
+	}, nil
+}
+
+func (k Keeper) DenomMaxSupply(ctx context.Context, req *types.QueryDenomMaxSupplyRequest) (*types.QueryDenomMaxSupplyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	maxSupply, _ := k.GetMaxSupply(sdkCtx, req.GetDenom())
+
+	return &types.QueryDenomMaxSupplyResponse{MaxSupply: maxSupply}, nil
+}
+
+func (k Keeper) DenomFreezeList(ctx context.Context, req *types.QueryDenomFreezeListRequest) (*types.QueryDenomFreezeListResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	return &types.QueryDenomFreezeListResponse{
+		Paused:          k.IsPaused(sdkCtx, req.GetDenom()),
+		FrozenAddresses: k.GetFrozenAddresses(sdkCtx, req.GetDenom()),
+	}, nil
+}
+
+func (k Keeper) FrozenAddress(ctx context.Context, req *types.QueryFrozenAddressRequest) (*types.QueryFrozenAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	decodedDenom, err := url.QueryUnescape(req.Denom)
+	if err == nil {
+		req.Denom = decodedDenom
+	}
+
+	address, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenAddressResponse{Frozen: k.IsFrozen(sdkCtx, req.GetDenom(), address)}, nil
 }
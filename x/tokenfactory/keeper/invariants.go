@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// RegisterInvariants registers the tokenfactory module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "denoms", DenomsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "supply", SupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "before-send-hooks", BeforeSendHooksInvariant(k))
+}
+
+// DenomsInvariant checks that every denom stored by the module has
+// authority metadata and is indexed under its creator.
+func DenomsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, denom := range k.getAllStoredDenoms(ctx) {
+			creator, _, err := types.DeconstructDenom(denom)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s is invalid: %s\n", denom, err)
+				continue
+			}
+			if !k.GetDenomPrefixStore(ctx, denom).Has([]byte(types.DenomAuthorityMetadataKey)) {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s has no authority metadata\n", denom)
+			}
+			if !k.GetCreatorPrefixStore(ctx, creator).Has([]byte(denom)) {
+				broken++
+				msg += fmt.Sprintf("\tdenom %s is not indexed under its creator %s\n", denom, creator)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "denoms",
+			fmt.Sprintf("\tinconsistent denoms: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// SupplyInvariant checks that every factory denom with a bank supply
+// has been created through the module and is within its max supply.
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			if !strings.HasPrefix(coin.Denom, types.ModuleDenomPrefix+"/") {
+				return false
+			}
+			if !k.GetDenomPrefixStore(ctx, coin.Denom).Has([]byte(types.DenomAuthorityMetadataKey)) {
+				broken++
+				msg += fmt.Sprintf("\tsupply of %s does not belong to a known denom\n", coin)
+			}
+			if maxSupply, found := k.GetMaxSupply(ctx, coin.Denom); found && coin.Amount.GT(maxSupply) {
+				broken++
+				msg += fmt.Sprintf("\tsupply of %s is over the max supply %s\n", coin, maxSupply)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "supply",
+			fmt.Sprintf("\tfactory denoms with invalid supply: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// BeforeSendHooksInvariant checks that the before send hook of every
+// denom points to an existing contract.
+func BeforeSendHooksInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken int
+		)
+
+		for _, denom := range k.getAllStoredDenoms(ctx) {
+			cosmwasmAddress := k.GetBeforeSendHook(ctx, denom)
+			if cosmwasmAddress == "" {
+				continue
+			}
+			cwAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+			if err != nil {
+				broken++
+				msg += fmt.Sprintf("\tbefore send hook %s of %s is invalid: %s\n", cosmwasmAddress, denom, err)
+				continue
+			}
+			if k.contractKeeper == nil || !k.contractKeeper.HasContractInfo(ctx, cwAddr) {
+				broken++
+				msg += fmt.Sprintf("\tbefore send hook %s of %s is not a contract\n", cosmwasmAddress, denom)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "before-send-hooks",
+			fmt.Sprintf("\tbefore send hooks without contract: %d\n%s", broken, msg),
+		), broken != 0
+	}
+}
+
+// getAllStoredDenoms returns the denoms indexed under their creator and
+// the denoms with authority metadata, so that a denom missing either of
+// them is still returned.
+func (k Keeper) getAllStoredDenoms(ctx sdk.Context) []string {
+	denoms := []string{}
+	seenDenoms := map[string]bool{}
+	addDenom := func(denom string) {
+		if !seenDenoms[denom] {
+			seenDenoms[denom] = true
+			denoms = append(denoms, denom)
+		}
+	}
+
+	creatorsIterator := k.GetAllDenomsIterator(ctx)
+	defer creatorsIterator.Close()
+	for ; creatorsIterator.Valid(); creatorsIterator.Next() {
+		addDenom(string(creatorsIterator.Value()))
+	}
+
+	// the authority metadata is stored at {denom}|authoritymetadata,
+	// and the denoms cannot contain the separator
+	suffix := types.KeySeparator + types.DenomAuthorityMetadataKey
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DenomsPrefixKey+types.KeySeparator))
+	denomsIterator := store.Iterator(nil, nil)
+	defer denomsIterator.Close()
+	for ; denomsIterator.Valid(); denomsIterator.Next() {
+		if key := string(denomsIterator.Key()); strings.HasSuffix(key, suffix) {
+			addDenom(strings.TrimSuffix(key, suffix))
+		}
+	}
+
+	return denoms
+}
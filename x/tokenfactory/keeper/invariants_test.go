@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"os"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/keeper"
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	for _, tc := range []struct {
+		desc      string
+		malleate  func(denom string)
+		invariant func(k keeper.Keeper) sdk.Invariant
+	}{
+		{
+			desc: "denom without authority metadata",
+			malleate: func(denom string) {
+				s.App.Keepers.TokenFactoryKeeper.GetDenomPrefixStore(s.Ctx, denom).Delete([]byte(types.DenomAuthorityMetadataKey))
+			},
+			invariant: keeper.DenomsInvariant,
+		},
+		{
+			desc: "denom without creator index entry",
+			malleate: func(denom string) {
+				s.App.Keepers.TokenFactoryKeeper.GetCreatorPrefixStore(s.Ctx, s.TestAccs[0].String()).Delete([]byte(denom))
+			},
+			invariant: keeper.DenomsInvariant,
+		},
+		{
+			desc: "supply of an unknown factory denom",
+			malleate: func(denom string) {
+				coins := sdk.NewCoins(sdk.NewInt64Coin(denom+"unknown", 1))
+				s.Require().NoError(s.App.Keepers.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+			},
+			invariant: keeper.SupplyInvariant,
+		},
+		{
+			desc: "supply over the max supply",
+			malleate: func(denom string) {
+				bz, err := sdk.NewInt(999).Marshal()
+				s.Require().NoError(err)
+				store := s.App.Keepers.TokenFactoryKeeper.GetDenomPrefixStore(s.Ctx, denom)
+				store.Set([]byte(types.DenomMaxSupplyKey), bz)
+			},
+			invariant: keeper.SupplyInvariant,
+		},
+		{
+			desc: "before send hook without contract",
+			malleate: func(denom string) {
+				store := s.App.Keepers.TokenFactoryKeeper.GetDenomPrefixStore(s.Ctx, denom)
+				store.Set([]byte(types.BeforeSendHookAddressPrefixKey), []byte(s.TestAccs[1].String()))
+			},
+			invariant: keeper.BeforeSendHooksInvariant,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			s.SetupTest()
+
+			// create a denom with a before send hook
+			wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+			s.Require().NoError(err)
+			codeID, _, err := s.contractKeeper.Create(s.Ctx, s.TestAccs[0], wasmCode, &wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeEverybody})
+			s.Require().NoError(err)
+			cosmwasmAddress, _, err := s.contractKeeper.Instantiate(s.Ctx, codeID, s.TestAccs[0], s.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+			s.Require().NoError(err)
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1000)))
+			s.Require().NoError(err)
+			_, err = s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(s.TestAccs[0].String(), denom, cosmwasmAddress.String()))
+			s.Require().NoError(err)
+			// the freeze list is part of a consistent state
+			_, err = s.msgServer.SetFrozenAddress(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetFrozenAddress(s.TestAccs[0].String(), denom, s.TestAccs[1].String(), true))
+			s.Require().NoError(err)
+
+			// the invariant holds for a consistent state...
+			_, broken := tc.invariant(s.App.Keepers.TokenFactoryKeeper)(s.Ctx)
+			s.Require().False(broken)
+
+			// ... and is broken by the inconsistency
+			tc.malleate(denom)
+			msg, broken := tc.invariant(s.App.Keepers.TokenFactoryKeeper)(s.Ctx)
+			s.Require().True(broken, msg)
+		})
+	}
+}
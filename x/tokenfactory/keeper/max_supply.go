@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// GetMaxSupply returns the max supply of a specific denom and whether
+// the denom has one.
+func (k Keeper) GetMaxSupply(ctx sdk.Context, denom string) (sdk.Int, bool) {
+	bz := k.GetDenomPrefixStore(ctx, denom).Get([]byte(types.DenomMaxSupplyKey))
+	if bz == nil {
+		return sdk.ZeroInt(), false
+	}
+
+	var maxSupply sdk.Int
+	if err := maxSupply.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return maxSupply, true
+}
+
+// setMaxSupply stores the max supply of a specific denom. The max supply
+// can only be lowered once set and can never be lower than the current
+// supply of the denom.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply sdk.Int) error {
+	// verify that denom is an x/tokenfactory denom
+	_, _, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return types.ErrInvalidMaxSupply.Wrap("max supply must be positive")
+	}
+
+	if current, found := k.GetMaxSupply(ctx, denom); found && maxSupply.GT(current) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply of %s can only be lowered from %s", denom, current)
+	}
+
+	if supply := k.bankKeeper.GetSupply(ctx, denom); maxSupply.LT(supply.Amount) {
+		return types.ErrInvalidMaxSupply.Wrapf("max supply %s is lower than the current supply %s", maxSupply, supply)
+	}
+
+	bz, err := maxSupply.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k.GetDenomPrefixStore(ctx, denom).Set([]byte(types.DenomMaxSupplyKey), bz)
+	return nil
+}
+
+// checkMaxSupply returns an error if minting the amount would take the
+// supply of its denom over the max supply.
+func (k Keeper) checkMaxSupply(ctx sdk.Context, amount sdk.Coin) error {
+	maxSupply, found := k.GetMaxSupply(ctx, amount.Denom)
+	if !found {
+		return nil
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+	if supply.Amount.Add(amount.Amount).GT(maxSupply) {
+		return types.ErrMaxSupplyExceeded.Wrapf(
+			"minting %s would take the supply from %s over the max supply %s", amount, supply.Amount, maxSupply,
+		)
+	}
+	return nil
+}
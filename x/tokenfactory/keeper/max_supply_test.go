@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// TestSetMaxSupplyMsg tests that the max supply can only be lowered once
+// set and never below the current supply
+func (s *KeeperTestSuite) TestSetMaxSupplyMsg() {
+	for _, tc := range []struct {
+		desc              string
+		malleate          func(denom string)
+		sender            func() string
+		maxSupply         int64
+		expectedMaxSupply int64
+		expectedErr       error
+	}{
+		{
+			desc:              "first max supply",
+			malleate:          func(denom string) {},
+			sender:            func() string { return s.TestAccs[0].String() },
+			maxSupply:         5000,
+			expectedMaxSupply: 5000,
+		},
+		{
+			desc: "lower max supply",
+			malleate: func(denom string) {
+				_, err := s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(s.TestAccs[0].String(), denom, sdk.NewInt(5000)))
+				s.Require().NoError(err)
+			},
+			sender:            func() string { return s.TestAccs[0].String() },
+			maxSupply:         4000,
+			expectedMaxSupply: 4000,
+		},
+		{
+			desc:              "max supply equal to the supply",
+			malleate:          func(denom string) {},
+			sender:            func() string { return s.TestAccs[0].String() },
+			maxSupply:         1000,
+			expectedMaxSupply: 1000,
+		},
+		{
+			desc: "raise max supply",
+			malleate: func(denom string) {
+				_, err := s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(s.TestAccs[0].String(), denom, sdk.NewInt(5000)))
+				s.Require().NoError(err)
+			},
+			sender:            func() string { return s.TestAccs[0].String() },
+			maxSupply:         5001,
+			expectedMaxSupply: 5000,
+			expectedErr:       types.ErrInvalidMaxSupply,
+		},
+		{
+			desc:        "max supply below the supply",
+			malleate:    func(denom string) {},
+			sender:      func() string { return s.TestAccs[0].String() },
+			maxSupply:   999,
+			expectedErr: types.ErrInvalidMaxSupply,
+		},
+		{
+			desc:        "not the admin",
+			malleate:    func(denom string) {},
+			sender:      func() string { return s.TestAccs[1].String() },
+			maxSupply:   5000,
+			expectedErr: types.ErrUnauthorized,
+		},
+	} {
+		s.Run(fmt.Sprintf("Case %s", tc.desc), func() {
+			s.SetupTest()
+			res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+			s.Require().NoError(err)
+			denom := res.GetNewTokenDenom()
+			_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1000)))
+			s.Require().NoError(err)
+			tc.malleate(denom)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := types.NewMsgSetMaxSupply(tc.sender(), denom, sdk.NewInt(tc.maxSupply))
+			s.Require().NoError(msg.ValidateBasic())
+			_, err = s.msgServer.SetMaxSupply(sdk.WrapSDKContext(ctx), msg)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, 0)
+			} else {
+				s.Require().NoError(err)
+				s.AssertEventEmitted(ctx, types.TypeMsgSetMaxSupply, 1)
+			}
+
+			maxSupply, found := s.App.Keepers.TokenFactoryKeeper.GetMaxSupply(s.Ctx, denom)
+			s.Require().Equal(tc.expectedMaxSupply != 0, found)
+			s.Require().Equal(sdk.NewInt(tc.expectedMaxSupply), maxSupply)
+		})
+	}
+}
+
+// TestMintOverMaxSupply tests that minting cannot take the supply of a
+// denom over its max supply
+func (s *KeeperTestSuite) TestMintOverMaxSupply() {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(s.TestAccs[0].String(), denom, sdk.NewInt(1000)))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 600)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 401)))
+	s.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintTo(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 400), s.TestAccs[1].String()))
+	s.Require().NoError(err)
+
+	s.Require().Equal(sdk.NewInt(1000), s.App.Keepers.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+
+	// burning frees room under the max supply
+	_, err = s.msgServer.Burn(sdk.WrapSDKContext(s.Ctx), types.NewMsgBurn(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(sdk.WrapSDKContext(s.Ctx), types.NewMsgMint(s.TestAccs[0].String(), sdk.NewInt64Coin(denom, 100)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestQueryDenomMaxSupply() {
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(s.TestAccs[0].String(), "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	queryRes, err := s.App.Keepers.TokenFactoryKeeper.DenomMaxSupply(s.Ctx, &types.QueryDenomMaxSupplyRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroInt(), queryRes.MaxSupply)
+
+	_, err = s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(s.TestAccs[0].String(), denom, sdk.NewInt(1000)))
+	s.Require().NoError(err)
+
+	queryRes, err = s.App.Keepers.TokenFactoryKeeper.DenomMaxSupply(s.Ctx, &types.QueryDenomMaxSupplyRequest{Denom: denom})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1000), queryRes.MaxSupply)
+}
+
+// TestInitGenesisOverMaxSupply tests that the genesis import rejects a
+// denom whose supply is over its max supply
+func (s *KeeperTestSuite) TestInitGenesisOverMaxSupply() {
+	denom := fmt.Sprintf("factory/%s/bitcoin", s.TestAccs[0])
+	s.Require().NoError(s.App.Keepers.BankKeeper.MintCoins(s.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		FactoryDenoms: []types.GenesisDenom{{
+			Denom:             denom,
+			AuthorityMetadata: types.DenomAuthorityMetadata{Admin: s.TestAccs[0].String()},
+			MaxSupply:         sdk.NewInt(999),
+		}},
+	}
+	s.Require().Panics(func() {
+		s.App.Keepers.TokenFactoryKeeper.InitGenesis(s.Ctx, genesisState)
+	})
+}
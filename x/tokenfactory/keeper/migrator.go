@@ -4,6 +4,8 @@ import (
 	"github.com/terra-money/core/v2/x/tokenfactory/exported"
 	v2 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v2"
 	v3 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v3"
+	v4 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v4"
+	v5 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v5"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -27,3 +29,11 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v3.MigrateStore(ctx, m.keeper.storeKey, m.legacySubspace, m.keeper.cdc)
 }
+
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdkerrors "cosmossdk.io/errors"
 
@@ -73,14 +74,23 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.DenomRoleMinter, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.IsRenounced(types.DenomCapabilityMint) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityMint)
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
 
+	err = server.Keeper.consumeMinterAllowance(ctx, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	err = server.Keeper.mintTo(ctx, msg.Amount, msg.MintToAddress)
 	if err != nil {
 		return nil, err
@@ -105,7 +115,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.DenomRoleBurner, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -113,6 +123,10 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		msg.BurnFromAddress = msg.Sender
 	}
 
+	if msg.BurnFromAddress != msg.Sender && authorityMetadata.IsRenounced(types.DenomCapabilityBurnFrom) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityBurnFrom)
+	}
+
 	acc, err := sdk.AccAddressFromBech32(msg.BurnFromAddress)
 	if err != nil {
 		return nil, err
@@ -147,10 +161,14 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.DenomRoleForceTransferrer, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
+	if authorityMetadata.IsRenounced(types.DenomCapabilityForceTransfer) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityForceTransfer)
+	}
+
 	err = server.Keeper.forceTransfer(ctx, msg.Amount, msg.TransferFromAddress, msg.TransferToAddress)
 	if err != nil {
 		return nil, err
@@ -209,7 +227,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.DenomRoleMetadataManager, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -234,11 +252,27 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.DenomRoleHookManager, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
-	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	if authorityMetadata.IsRenounced(types.DenomCapabilityHookChanges) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityHookChanges)
+	}
+
+	// only contracts can be called as before send hooks, which the
+	// before send hooks invariant relies on
+	if msg.CosmwasmAddress != "" {
+		cwAddr, err := sdk.AccAddressFromBech32(msg.CosmwasmAddress)
+		if err != nil {
+			return nil, err
+		}
+		if !server.Keeper.contractKeeper.HasContractInfo(ctx, cwAddr) {
+			return nil, types.ErrHookNotContract.Wrapf("address: %s", msg.CosmwasmAddress)
+		}
+	}
+
+	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress, msg.Version)
 	if err != nil {
 		return nil, err
 	}
@@ -248,8 +282,235 @@ func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgS
 			types.TypeMsgSetBeforeSendHook,
 			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
 			sdk.NewAttribute(types.AttributeBeforeSendHookAddress, msg.GetCosmwasmAddress()),
+			sdk.NewAttribute(types.AttributeBeforeSendHookVersion, msg.Version.String()),
 		),
 	})
 
 	return &types.MsgSetBeforeSendHookResponse{}, nil
 }
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) SetDenomRole(goCtx context.Context, msg *types.MsgSetDenomRole) (*types.MsgSetDenomRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomRole(ctx, msg.Denom, msg.Role, msg.Address, msg.MinterAllowance)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		sdk.NewAttribute(types.AttributeDenomRole, msg.Role.String()),
+		sdk.NewAttribute(types.AttributeRoleAddress, msg.GetAddress()),
+	}
+	if msg.MinterAllowance != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMinterAllowance, msg.MinterAllowance.String()))
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.TypeMsgSetDenomRole, attributes...),
+	})
+
+	return &types.MsgSetDenomRoleResponse{}, nil
+}
+
+func (server msgServer) RenounceCapability(goCtx context.Context, msg *types.MsgRenounceCapability) (*types.MsgRenounceCapabilityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.renounceCapability(ctx, msg.Denom, msg.Capability)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRenounceCapability,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeDenomCapability, msg.Capability.String()),
+		),
+	})
+
+	return &types.MsgRenounceCapabilityResponse{}, nil
+}
+
+func (server msgServer) SetFrozenAddress(goCtx context.Context, msg *types.MsgSetFrozenAddress) (*types.MsgSetFrozenAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setFrozen(ctx, msg.Denom, msg.Address, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetFrozenAddress,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeFrozenAddress, msg.GetAddress()),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetFrozenAddressResponse{}, nil
+}
+
+func (server msgServer) SetDenomPaused(goCtx context.Context, msg *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setPaused(ctx, msg.Denom, msg.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (server msgServer) SetBeforeSendHookGasLimits(goCtx context.Context, msg *types.MsgSetBeforeSendHookGasLimits) (*types.MsgSetBeforeSendHookGasLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.DenomRoleHookManager, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.IsRenounced(types.DenomCapabilityHookChanges) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityHookChanges)
+	}
+
+	err = server.Keeper.setBeforeSendHookGasLimits(ctx, msg.Denom, types.BeforeSendHookGasLimits{
+		TrackGasLimit: msg.TrackGasLimit,
+		BlockGasLimit: msg.BlockGasLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetHookGasLimits,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeTrackGasLimit, strconv.FormatUint(msg.TrackGasLimit, 10)),
+			sdk.NewAttribute(types.AttributeBlockGasLimit, strconv.FormatUint(msg.BlockGasLimit, 10)),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookGasLimitsResponse{}, nil
+}
+
+func (server msgServer) MintBatch(goCtx context.Context, msg *types.MsgMintBatch) (*types.MsgMintBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// pay some extra gas cost to give a better error here.
+	_, denomExists := server.bankKeeper.GetDenomMetaData(ctx, msg.Denom)
+	if !denomExists {
+		return nil, types.ErrDenomDoesNotExist.Wrapf("denom: %s", msg.Denom)
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.DenomRoleMinter, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	if authorityMetadata.IsRenounced(types.DenomCapabilityMint) {
+		return nil, types.ErrCapabilityRenounced.Wrapf("capability: %s", types.DenomCapabilityMint)
+	}
+
+	total := msg.TotalAmount()
+	err = server.Keeper.consumeMinterAllowance(ctx, total)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.mintBatch(ctx, msg.Denom, msg.Recipients)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgMintBatch,
+			sdk.NewAttribute(types.AttributeDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeAmount, total.String()),
+			sdk.NewAttribute(types.AttributeRecipientCount, strconv.Itoa(len(msg.Recipients))),
+		),
+	})
+
+	return &types.MsgMintBatchResponse{}, nil
+}
@@ -2,14 +2,20 @@ package keeper_test
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"testing"
 
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/stretchr/testify/suite"
+	custombankkeeper "github.com/terra-money/core/v2/x/bank/keeper"
+	"github.com/terra-money/core/v2/x/tokenfactory/keeper"
 	"github.com/terra-money/core/v2/x/tokenfactory/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -80,6 +86,172 @@ func (s *KeeperTestSuite) TestMintDenomMsg() {
 	}
 }
 
+// TestMintBatchMsg tests that MsgMintBatch mints to all the recipients, is
+// restricted to the minter, uses its allowance and emits a single TypeMsgMintBatch event
+func (s *KeeperTestSuite) TestMintBatchMsg() {
+	admin := s.TestAccs[0].String()
+	minter := s.TestAccs[1].String()
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	recipients := []types.MintBatchRecipient{
+		{Address: s.TestAccs[1].String(), Amount: sdk.NewInt(10)},
+		{Address: s.TestAccs[2].String(), Amount: sdk.NewInt(20)},
+	}
+
+	// only the minter mints batches, not the admin once the role is given away
+	allowance := sdk.NewInt(40)
+	_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, types.DenomRoleMinter, minter, &allowance))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(admin, denom, recipients))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(minter, "factory/osmo1t7egva48prqmzl59x5ngv4zx0dtrwewc9m7z44/evmos", recipients))
+	s.Require().ErrorIs(err, types.ErrDenomDoesNotExist)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(ctx), types.NewMsgMintBatch(minter, denom, recipients))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(ctx, types.TypeMsgMintBatch, 1)
+	s.Require().Equal(sdk.NewInt(10), s.App.Keepers.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], denom).Amount)
+	s.Require().Equal(sdk.NewInt(20), s.App.Keepers.BankKeeper.GetBalance(s.Ctx, s.TestAccs[2], denom).Amount)
+	s.Require().Equal(sdk.NewInt(30), s.App.Keepers.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+
+	// the whole batch is deducted from the minter allowance
+	metadata, err := s.App.Keepers.TokenFactoryKeeper.GetAuthorityMetadata(s.Ctx, denom)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(10), *metadata.MinterAllowance)
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(minter, denom, recipients))
+	s.Require().ErrorIs(err, types.ErrMinterAllowanceExceeded)
+	s.Require().Equal(sdk.NewInt(30), s.App.Keepers.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+
+	// the whole batch counts towards the max supply
+	_, err = s.msgServer.SetDenomRole(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetDenomRole(admin, denom, types.DenomRoleMinter, minter, nil))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetMaxSupply(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetMaxSupply(admin, denom, sdk.NewInt(50)))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(minter, denom, recipients))
+	s.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	s.Require().Equal(sdk.NewInt(30), s.App.Keepers.BankKeeper.GetSupply(s.Ctx, denom).Amount)
+
+	// renouncing mint also stops the batches
+	_, err = s.msgServer.RenounceCapability(sdk.WrapSDKContext(s.Ctx), types.NewMsgRenounceCapability(admin, denom, types.DenomCapabilityMint))
+	s.Require().NoError(err)
+	_, err = s.msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(minter, denom, recipients[:1]))
+	s.Require().ErrorIs(err, types.ErrCapabilityRenounced)
+}
+
+// TestMintBatchGasPerRecipient tests that MsgMintBatch consumes the gas per
+// recipient of the params
+func (s *KeeperTestSuite) TestMintBatchGasPerRecipient() {
+	k := s.App.Keepers.TokenFactoryKeeper
+
+	admin := s.TestAccs[0].String()
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	recipients := []types.MintBatchRecipient{
+		{Address: s.TestAccs[1].String(), Amount: sdk.NewInt(10)},
+		{Address: s.TestAccs[2].String(), Amount: sdk.NewInt(20)},
+	}
+	// mint in a cached context so that both batches start from the same state
+	mintBatchGas := func() uint64 {
+		ctx, _ := s.Ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).CacheContext()
+		_, err := s.msgServer.MintBatch(sdk.WrapSDKContext(ctx), types.NewMsgMintBatch(admin, denom, recipients))
+		s.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	gasWithoutCharge := mintBatchGas()
+
+	params := k.GetParams(s.Ctx)
+	params.MintBatchGasPerRecipient = 100_000
+	s.Require().NoError(k.SetParams(s.Ctx, params))
+
+	// reading the larger params costs a few more gas as well
+	gasWithCharge := mintBatchGas()
+	s.Require().GreaterOrEqual(gasWithCharge-gasWithoutCharge, uint64(200_000))
+	s.Require().Less(gasWithCharge-gasWithoutCharge, uint64(201_000))
+}
+
+// TestMintBatchHookCalls tests that MsgMintBatch calls the before send hook
+// of the denom once per recipient
+func (s *KeeperTestSuite) TestMintBatchHookCalls() {
+	// wire a bank keeper to a tokenfactory keeper recording the hook calls,
+	// since the hooks of the app bank keeper can not be replaced
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	bankKeeper := custombankkeeper.NewBaseKeeper(s.App.AppCodec(), s.App.GetKey(banktypes.StoreKey), s.App.Keepers.AccountKeeper, nil, authority)
+	k := keeper.NewKeeper(s.App.GetKey(types.StoreKey), nil, s.App.Keepers.AccountKeeper, &bankKeeper, s.App.Keepers.DistrKeeper, s.App.AppCodec(), authority)
+	recorder := &sudoRecorder{}
+	k.SetContractKeeper(recorder)
+	bankKeeper.SetHooks(custombankkeeper.NewMultiBankHooks(k.Hooks()))
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	admin := s.TestAccs[0].String()
+	res, err := msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	_, err = msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+
+	recipients := []types.MintBatchRecipient{
+		{Address: s.TestAccs[0].String(), Amount: sdk.NewInt(10)},
+		{Address: s.TestAccs[1].String(), Amount: sdk.NewInt(20)},
+		{Address: s.TestAccs[2].String(), Amount: sdk.NewInt(30)},
+	}
+	_, err = msgServer.MintBatch(sdk.WrapSDKContext(s.Ctx), types.NewMsgMintBatch(admin, denom, recipients))
+	s.Require().NoError(err)
+
+	// each recipient gets a block and a track before send call
+	var blockCalls, trackCalls int
+	for _, msg := range recorder.msgs {
+		switch {
+		case strings.HasPrefix(msg, `{"block_before_send"`):
+			blockCalls++
+		case strings.HasPrefix(msg, `{"track_before_send"`):
+			trackCalls++
+		}
+	}
+	s.Require().Len(recorder.msgs, 2*len(recipients))
+	s.Require().Equal(len(recipients), blockCalls)
+	s.Require().Equal(len(recipients), trackCalls)
+}
+
+// TestSetBeforeSendHookMsg tests that MsgSetBeforeSendHook only accepts the
+// addresses of contracts, so that the before send hooks invariant holds
+func (s *KeeperTestSuite) TestSetBeforeSendHookMsg() {
+	admin := s.TestAccs[0].String()
+	res, err := s.msgServer.CreateDenom(sdk.WrapSDKContext(s.Ctx), types.NewMsgCreateDenom(admin, "bitcoin"))
+	s.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	// a plain account is rejected
+	_, err = s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, s.TestAccs[1].String()))
+	s.Require().ErrorIs(err, types.ErrHookNotContract)
+	s.Require().Empty(s.App.Keepers.TokenFactoryKeeper.GetBeforeSendHook(s.Ctx, denom))
+
+	// a contract is accepted
+	wasmCode, err := os.ReadFile("./testdata/no100.wasm")
+	s.Require().NoError(err)
+	codeID, _, err := s.contractKeeper.Create(s.Ctx, s.TestAccs[0], wasmCode, &wasmtypes.AccessConfig{Permission: wasmtypes.AccessTypeEverybody})
+	s.Require().NoError(err)
+	cosmwasmAddress, _, err := s.contractKeeper.Instantiate(s.Ctx, codeID, s.TestAccs[0], s.TestAccs[0], []byte("{}"), "", sdk.NewCoins())
+	s.Require().NoError(err)
+	_, err = s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, cosmwasmAddress.String()))
+	s.Require().NoError(err)
+	s.Require().Equal(cosmwasmAddress.String(), s.App.Keepers.TokenFactoryKeeper.GetBeforeSendHook(s.Ctx, denom))
+
+	_, broken := keeper.BeforeSendHooksInvariant(s.App.Keepers.TokenFactoryKeeper)(s.Ctx)
+	s.Require().False(broken)
+
+	// the hook can still be removed
+	_, err = s.msgServer.SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin, denom, ""))
+	s.Require().NoError(err)
+	s.Require().Empty(s.App.Keepers.TokenFactoryKeeper.GetBeforeSendHook(s.Ctx, denom))
+}
+
 // TestForceTransferMsg tests MsgForceTransfer message is emitted on a successful send
 func (s *KeeperTestSuite) TestForceTransferMsg() {
 	// Create a denom
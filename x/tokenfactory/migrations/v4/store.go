@@ -0,0 +1,52 @@
+package v4
+
+import (
+	"strings"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 3 to
+// version 4. Specifically, it grants every denom role (minter, burner, force transferrer,
+// metadata manager and hook manager) to the current admin of each denom, so that the
+// admins keep the capabilities they had before the roles were introduced. Denoms without
+// an admin are left without any role.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := prefix.NewStore(ctx.KVStore(storeKey), []byte(types.DenomsPrefixKey+types.KeySeparator))
+	suffix := []byte(types.KeySeparator + types.DenomAuthorityMetadataKey)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		if strings.HasSuffix(string(iterator.Key()), string(suffix)) {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	for _, key := range keys {
+		var metadata types.DenomAuthorityMetadata
+		if err := cdc.Unmarshal(store.Get(key), &metadata); err != nil {
+			return err
+		}
+
+		metadata = types.NewAdminAuthorityMetadata(metadata.Admin)
+		if err := metadata.Validate(); err != nil {
+			return err
+		}
+
+		bz, err := cdc.Marshal(&metadata)
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+
+	return nil
+}
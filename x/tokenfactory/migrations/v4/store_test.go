@@ -0,0 +1,57 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/terra-money/core/v2/app/test_helpers"
+	v4 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v4"
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+type MigrateTestSuite struct {
+	test_helpers.AppTestSuite
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
+
+func (s *MigrateTestSuite) TestMigrateStore() {
+	s.Setup()
+	k := s.App.Keepers.TokenFactoryKeeper
+	storeKey := s.App.Keepers.GetKVStoreKey()[types.StoreKey]
+	cdc := s.App.AppCodec()
+
+	admin := s.TestAccs[0].String()
+	adminDenom, err := k.CreateDenom(s.Ctx, admin, "admin")
+	s.Require().NoError(err)
+	noAdminDenom, err := k.CreateDenom(s.Ctx, admin, "noadmin")
+	s.Require().NoError(err)
+
+	// Store the authority metadata as it was before the roles were introduced
+	for denom, metadata := range map[string]types.DenomAuthorityMetadata{
+		adminDenom:   {Admin: admin},
+		noAdminDenom: {},
+	} {
+		bz, err := cdc.Marshal(&metadata)
+		s.Require().NoError(err)
+		k.GetDenomPrefixStore(s.Ctx, denom).Set([]byte(types.DenomAuthorityMetadataKey), bz)
+	}
+
+	err = v4.MigrateStore(s.Ctx, storeKey, cdc)
+	s.Require().NoError(err)
+
+	metadata, err := k.GetAuthorityMetadata(s.Ctx, adminDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewAdminAuthorityMetadata(admin), metadata)
+	for _, role := range types.AllDenomRoles() {
+		s.Require().True(metadata.HasRole(role, admin))
+	}
+	s.Require().Nil(metadata.MinterAllowance)
+
+	metadata, err = k.GetAuthorityMetadata(s.Ctx, noAdminDenom)
+	s.Require().NoError(err)
+	s.Require().Equal(types.DenomAuthorityMetadata{}, metadata)
+}
@@ -0,0 +1,50 @@
+package v5
+
+import (
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/tokenfactory module state from the consensus version 4 to
+// version 5. Specifically, it sets the before send hook params introduced in version 5 to
+// their default values, keeping the track before send gas limit that was hard-coded until
+// then, since these params are zero in the params stored by the previous versions.
+//
+// The block before send calls were only limited by the gas of the transaction until then,
+// so the block before send and max before send gas limits are raised to the max gas of a
+// block when it is higher, for the existing hooks to keep working.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	params.TrackBeforeSendGasLimit = types.DefaultTrackBeforeSendGasLimit
+	params.BlockBeforeSendGasLimit = types.DefaultBlockBeforeSendGasLimit
+	params.MaxBeforeSendGasLimit = types.DefaultMaxBeforeSendGasLimit
+	params.MaxBeforeSendHookFailures = types.DefaultMaxBeforeSendHookFailures
+	if cp := ctx.ConsensusParams(); cp != nil && cp.Block != nil && cp.Block.MaxGas > 0 {
+		if maxBlockGas := uint64(cp.Block.MaxGas); maxBlockGas > params.MaxBeforeSendGasLimit {
+			params.BlockBeforeSendGasLimit = maxBlockGas
+			params.MaxBeforeSendGasLimit = maxBlockGas
+		}
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-money/core/v2/app/test_helpers"
+	"github.com/terra-money/core/v2/x/tokenfactory/keeper"
+	v5 "github.com/terra-money/core/v2/x/tokenfactory/migrations/v5"
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+type MigrateTestSuite struct {
+	test_helpers.AppTestSuite
+}
+
+func TestMigrateTestSuite(t *testing.T) {
+	suite.Run(t, new(MigrateTestSuite))
+}
+
+func (s *MigrateTestSuite) TestMigrateStore() {
+	s.Setup()
+	k := s.App.Keepers.TokenFactoryKeeper
+	storeKey := s.App.Keepers.GetKVStoreKey()[types.StoreKey]
+	cdc := s.App.AppCodec()
+
+	// Store the params as they were before the before send hook params were introduced
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uluna", 100))
+	bz, err := cdc.Marshal(&types.Params{DenomCreationFee: fee, DenomCreationGasConsume: 10})
+	s.Require().NoError(err)
+	s.Ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	err = v5.MigrateStore(s.Ctx, storeKey, cdc)
+	s.Require().NoError(err)
+
+	params := k.GetParams(s.Ctx)
+	s.Require().Equal(types.NewParams(fee, 10), params)
+	s.Require().Equal(uint64(100_000), params.TrackBeforeSendGasLimit)
+}
+
+// heavyHook is a contract keeper whose block before send
+// hook consumes more gas than the default gas limits.
+type heavyHook struct{}
+
+func (heavyHook) Sudo(ctx sdk.Context, _ sdk.AccAddress, _ []byte) ([]byte, error) {
+	ctx.GasMeter().ConsumeGas(5*types.DefaultMaxBeforeSendGasLimit, "heavy hook")
+	return nil, nil
+}
+
+func (heavyHook) HasContractInfo(_ sdk.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+func (s *MigrateTestSuite) TestMigrateStoreKeepsHeavyBlockHooks() {
+	s.Setup()
+	k := s.App.Keepers.TokenFactoryKeeper
+	k.SetContractKeeper(heavyHook{})
+	storeKey := s.App.Keepers.GetKVStoreKey()[types.StoreKey]
+	cdc := s.App.AppCodec()
+
+	// Set a hook that is heavier than the default block before send gas limit
+	admin := s.TestAccs[0]
+	denom, err := k.CreateDenom(s.Ctx, admin.String(), "heavy")
+	s.Require().NoError(err)
+	_, err = keeper.NewMsgServerImpl(k).SetBeforeSendHook(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBeforeSendHook(admin.String(), denom, s.TestAccs[2].String()))
+	s.Require().NoError(err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+	s.Require().ErrorIs(k.Hooks().BlockBeforeSend(s.Ctx, admin, s.TestAccs[1], coins), types.ErrTrackBeforeSendOutOfGas)
+
+	// Store the params as they were before the before send hook params were introduced
+	bz, err := cdc.Marshal(&types.Params{})
+	s.Require().NoError(err)
+	s.Ctx.KVStore(storeKey).Set(types.ParamsKey, bz)
+
+	// The hook was only limited by the gas of the transaction, which
+	// cannot exceed the max gas of a block, so the migration raises
+	// the block before send gas limit to the max gas of a block...
+	maxBlockGas := 10 * types.DefaultMaxBeforeSendGasLimit
+	ctx := s.Ctx.WithConsensusParams(&cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: int64(maxBlockGas)}})
+	s.Require().NoError(v5.MigrateStore(ctx, storeKey, cdc))
+
+	params := k.GetParams(ctx)
+	s.Require().Equal(maxBlockGas, params.BlockBeforeSendGasLimit)
+	s.Require().Equal(maxBlockGas, params.MaxBeforeSendGasLimit)
+	s.Require().Equal(types.DefaultTrackBeforeSendGasLimit, params.TrackBeforeSendGasLimit)
+
+	// ... and the heavy hook keeps working
+	s.Require().NoError(k.Hooks().BlockBeforeSend(ctx, admin, s.TestAccs[1], coins))
+}
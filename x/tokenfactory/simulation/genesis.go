@@ -0,0 +1,105 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// Simulation parameter constants
+const (
+	DenomCreationFee        = "denom_creation_fee"
+	DenomCreationGasConsume = "denom_creation_gas_consume"
+	FactoryDenoms           = "factory_denoms"
+)
+
+// GenDenomCreationFee randomized DenomCreationFee, which is empty
+// half of the time so that any account can create denoms.
+func GenDenomCreationFee(r *rand.Rand) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return sdk.NewCoins()
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(simtypes.RandIntBetween(r, 1, 1_000_000))))
+}
+
+// GenDenomCreationGasConsume randomized DenomCreationGasConsume
+func GenDenomCreationGasConsume(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 0, int(types.DefaultCreationGasFee)))
+}
+
+// GenFactoryDenoms randomized FactoryDenoms created by the simulation
+// accounts. Most of the denoms are administered by their creator, the
+// others by another account or by nobody.
+func GenFactoryDenoms(r *rand.Rand, accs []simtypes.Account) []types.GenesisDenom {
+	genDenoms := []types.GenesisDenom{}
+	seenDenoms := map[string]bool{}
+
+	numDenoms := simtypes.RandIntBetween(r, 0, 10)
+	for i := 0; i < numDenoms; i++ {
+		creator, _ := simtypes.RandomAcc(r, accs)
+		denom, err := types.GetTokenDenom(creator.Address.String(), genSubdenom(r))
+		if err != nil || seenDenoms[denom] {
+			continue
+		}
+		seenDenoms[denom] = true
+
+		admin := creator.Address.String()
+		switch r.Intn(10) {
+		case 0:
+			admin = ""
+		case 1:
+			newAdmin, _ := simtypes.RandomAcc(r, accs)
+			admin = newAdmin.Address.String()
+		}
+
+		genDenoms = append(genDenoms, types.GenesisDenom{
+			Denom:             denom,
+			AuthorityMetadata: types.NewAdminAuthorityMetadata(admin),
+		})
+	}
+	return genDenoms
+}
+
+// RandomizedGenState generates a random GenesisState for tokenfactory
+func RandomizedGenState(simState *module.SimulationState) {
+	var denomCreationFee sdk.Coins
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationFee, &denomCreationFee, simState.Rand,
+		func(r *rand.Rand) { denomCreationFee = GenDenomCreationFee(r) },
+	)
+
+	var denomCreationGasConsume uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, DenomCreationGasConsume, &denomCreationGasConsume, simState.Rand,
+		func(r *rand.Rand) { denomCreationGasConsume = GenDenomCreationGasConsume(r) },
+	)
+
+	var factoryDenoms []types.GenesisDenom
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, FactoryDenoms, &factoryDenoms, simState.Rand,
+		func(r *rand.Rand) { factoryDenoms = GenFactoryDenoms(r, simState.Accounts) },
+	)
+
+	genesis := types.GenesisState{
+		Params:        types.NewParams(denomCreationFee, denomCreationGasConsume),
+		FactoryDenoms: factoryDenoms,
+	}
+
+	bz, err := json.MarshalIndent(&genesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated tokenfactory parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+// genSubdenom returns a random subdenom
+func genSubdenom(r *rand.Rand) string {
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, 20))
+}
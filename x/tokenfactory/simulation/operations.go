@@ -0,0 +1,558 @@
+package simulation
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/keeper"
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+// Simulation operation weights constants
+//
+//nolint:gosec
+const (
+	OpWeightMsgCreateDenom        = "op_weight_msg_create_denom"
+	OpWeightMsgMint               = "op_weight_msg_mint"
+	OpWeightMsgBurn               = "op_weight_msg_burn"
+	OpWeightMsgForceTransfer      = "op_weight_msg_force_transfer"
+	OpWeightMsgChangeAdmin        = "op_weight_msg_change_admin"
+	OpWeightMsgSetDenomMetadata   = "op_weight_msg_set_denom_metadata"
+	OpWeightMsgSetMaxSupply       = "op_weight_msg_set_max_supply"
+	OpWeightMsgSetDenomRole       = "op_weight_msg_set_denom_role"
+	OpWeightMsgRenounceCapability = "op_weight_msg_renounce_capability"
+	OpWeightMsgSetFrozenAddress   = "op_weight_msg_set_frozen_address"
+	OpWeightMsgMintBatch          = "op_weight_msg_mint_batch"
+
+	DefaultWeightMsgCreateDenom        = 50
+	DefaultWeightMsgMint               = 100
+	DefaultWeightMsgBurn               = 50
+	DefaultWeightMsgForceTransfer      = 25
+	DefaultWeightMsgChangeAdmin        = 10
+	DefaultWeightMsgSetDenomMetadata   = 20
+	DefaultWeightMsgSetMaxSupply       = 10
+	DefaultWeightMsgSetDenomRole       = 20
+	DefaultWeightMsgRenounceCapability = 5
+	DefaultWeightMsgSetFrozenAddress   = 10
+	DefaultWeightMsgMintBatch          = 20
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateDenom        int
+		weightMsgMint               int
+		weightMsgBurn               int
+		weightMsgForceTransfer      int
+		weightMsgChangeAdmin        int
+		weightMsgSetDenomMetadata   int
+		weightMsgSetMaxSupply       int
+		weightMsgSetDenomRole       int
+		weightMsgRenounceCapability int
+		weightMsgSetFrozenAddress   int
+		weightMsgMintBatch          int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateDenom, &weightMsgCreateDenom, nil,
+		func(_ *rand.Rand) { weightMsgCreateDenom = DefaultWeightMsgCreateDenom },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgMint, &weightMsgMint, nil,
+		func(_ *rand.Rand) { weightMsgMint = DefaultWeightMsgMint },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurn, &weightMsgBurn, nil,
+		func(_ *rand.Rand) { weightMsgBurn = DefaultWeightMsgBurn },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgForceTransfer, &weightMsgForceTransfer, nil,
+		func(_ *rand.Rand) { weightMsgForceTransfer = DefaultWeightMsgForceTransfer },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgChangeAdmin, &weightMsgChangeAdmin, nil,
+		func(_ *rand.Rand) { weightMsgChangeAdmin = DefaultWeightMsgChangeAdmin },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDenomMetadata, &weightMsgSetDenomMetadata, nil,
+		func(_ *rand.Rand) { weightMsgSetDenomMetadata = DefaultWeightMsgSetDenomMetadata },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetMaxSupply, &weightMsgSetMaxSupply, nil,
+		func(_ *rand.Rand) { weightMsgSetMaxSupply = DefaultWeightMsgSetMaxSupply },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetDenomRole, &weightMsgSetDenomRole, nil,
+		func(_ *rand.Rand) { weightMsgSetDenomRole = DefaultWeightMsgSetDenomRole },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgRenounceCapability, &weightMsgRenounceCapability, nil,
+		func(_ *rand.Rand) { weightMsgRenounceCapability = DefaultWeightMsgRenounceCapability },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetFrozenAddress, &weightMsgSetFrozenAddress, nil,
+		func(_ *rand.Rand) { weightMsgSetFrozenAddress = DefaultWeightMsgSetFrozenAddress },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintBatch, &weightMsgMintBatch, nil,
+		func(_ *rand.Rand) { weightMsgMintBatch = DefaultWeightMsgMintBatch },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgCreateDenom, SimulateMsgCreateDenom(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgMint, SimulateMsgMint(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgBurn, SimulateMsgBurn(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgForceTransfer, SimulateMsgForceTransfer(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgChangeAdmin, SimulateMsgChangeAdmin(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetDenomMetadata, SimulateMsgSetDenomMetadata(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetMaxSupply, SimulateMsgSetMaxSupply(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetDenomRole, SimulateMsgSetDenomRole(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRenounceCapability, SimulateMsgRenounceCapability(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgSetFrozenAddress, SimulateMsgSetFrozenAddress(ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgMintBatch, SimulateMsgMintBatch(ak, bk, k)),
+	}
+}
+
+// SimulateMsgCreateDenom generates a MsgCreateDenom with a random subdenom
+// from an account that can pay the denom creation fee.
+func SimulateMsgCreateDenom(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		sender, _ := simtypes.RandomAcc(r, accs)
+
+		denomCreationFee := k.GetParams(ctx).DenomCreationFee
+		if !bk.SpendableCoins(ctx, sender.Address).IsAllGTE(denomCreationFee) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, "cannot pay the denom creation fee"), nil, nil
+		}
+
+		subdenom := genSubdenom(r)
+		denom, err := types.GetTokenDenom(sender.Address.String(), subdenom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, "invalid subdenom"), nil, nil
+		}
+		if _, found := bk.GetDenomMetaData(ctx, denom); found || bk.HasSupply(ctx, subdenom) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateDenom, "denom already exists"), nil, nil
+		}
+
+		msg := types.NewMsgCreateDenom(sender.Address.String(), subdenom)
+		return deliverTx(r, app, ctx, ak, bk, sender, msg, denomCreationFee)
+	}
+}
+
+// SimulateMsgMint generates a MsgMint of a random amount of a denom
+// whose minter is a simulation account to a random account.
+func SimulateMsgMint(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, minter, found := randomDenomWithRole(r, ctx, k, accs, types.DenomRoleMinter)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "no denom minted by an account"), nil, nil
+		}
+
+		if isRenounced(ctx, k, denom, types.DenomCapabilityMint) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "mint renounced"), nil, nil
+		}
+
+		amount := sdk.NewInt64Coin(denom, int64(simtypes.RandIntBetween(r, 1, 1_000_000_000)))
+		if authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom); err == nil && authorityMetadata.MinterAllowance != nil {
+			if !authorityMetadata.MinterAllowance.IsPositive() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "minter allowance exhausted"), nil, nil
+			}
+			amount.Amount = sdk.MinInt(amount.Amount, *authorityMetadata.MinterAllowance)
+		}
+		if maxSupply, found := k.GetMaxSupply(ctx, denom); found {
+			room := maxSupply.Sub(bk.GetSupply(ctx, denom).Amount)
+			if !room.IsPositive() {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMint, "max supply reached"), nil, nil
+			}
+			amount.Amount = sdk.MinInt(amount.Amount, room)
+		}
+
+		recipient, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgMintTo(minter.Address.String(), amount, recipient.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, minter, msg, nil)
+	}
+}
+
+// SimulateMsgMintBatch generates a MsgMintBatch of random amounts of a denom
+// whose minter is a simulation account to some random accounts.
+func SimulateMsgMintBatch(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, minter, found := randomDenomWithRole(r, ctx, k, accs, types.DenomRoleMinter)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "no denom minted by an account"), nil, nil
+		}
+
+		if isRenounced(ctx, k, denom, types.DenomCapabilityMint) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "mint renounced"), nil, nil
+		}
+
+		var recipients []types.MintBatchRecipient
+		seen := make(map[string]bool)
+		for i := simtypes.RandIntBetween(r, 1, 10); i > 0; i-- {
+			recipient, _ := simtypes.RandomAcc(r, accs)
+			if seen[recipient.Address.String()] {
+				continue
+			}
+			seen[recipient.Address.String()] = true
+			recipients = append(recipients, types.MintBatchRecipient{
+				Address: recipient.Address.String(),
+				Amount:  sdk.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000))),
+			})
+		}
+
+		msg := types.NewMsgMintBatch(minter.Address.String(), denom, recipients)
+		total := msg.TotalAmount()
+		if authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom); err == nil && authorityMetadata.MinterAllowance != nil {
+			if authorityMetadata.MinterAllowance.LT(total.Amount) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "minter allowance exceeded"), nil, nil
+			}
+		}
+		if maxSupply, found := k.GetMaxSupply(ctx, denom); found {
+			if bk.GetSupply(ctx, denom).Amount.Add(total.Amount).GT(maxSupply) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgMintBatch, "max supply exceeded"), nil, nil
+			}
+		}
+
+		return deliverTx(r, app, ctx, ak, bk, minter, msg, nil)
+	}
+}
+
+// SimulateMsgBurn generates a MsgBurn of a random part of the balance
+// that an account holds of a denom whose burner is a simulation account.
+func SimulateMsgBurn(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, burner, found := randomDenomWithRole(r, ctx, k, accs, types.DenomRoleBurner)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no denom burned by an account"), nil, nil
+		}
+
+		burnFrom, amount, found := randomHolder(r, ctx, bk, accs, denom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "no account holds the denom"), nil, nil
+		}
+		if !burner.Equals(burnFrom) && isRenounced(ctx, k, denom, types.DenomCapabilityBurnFrom) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgBurn, "burn from renounced"), nil, nil
+		}
+
+		msg := types.NewMsgBurnFrom(burner.Address.String(), amount, burnFrom.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, burner, msg, coinsSpentBySigner(burner, burnFrom, amount))
+	}
+}
+
+// SimulateMsgForceTransfer generates a MsgForceTransfer of a random part
+// of the balance that an account holds of a denom to a random account.
+func SimulateMsgForceTransfer(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, forceTransferrer, found := randomDenomWithRole(r, ctx, k, accs, types.DenomRoleForceTransferrer)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgForceTransfer, "no denom force transferred by an account"), nil, nil
+		}
+		if isRenounced(ctx, k, denom, types.DenomCapabilityForceTransfer) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgForceTransfer, "force transfer renounced"), nil, nil
+		}
+
+		from, amount, found := randomHolder(r, ctx, bk, accs, denom)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgForceTransfer, "no account holds the denom"), nil, nil
+		}
+		to, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgForceTransfer(forceTransferrer.Address.String(), amount, from.Address.String(), to.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, forceTransferrer, msg, coinsSpentBySigner(forceTransferrer, from, amount))
+	}
+}
+
+// SimulateMsgChangeAdmin generates a MsgChangeAdmin handing a denom over
+// to a random account.
+func SimulateMsgChangeAdmin(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgChangeAdmin, "no denom administered by an account"), nil, nil
+		}
+
+		newAdmin, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgChangeAdmin(admin.Address.String(), denom, newAdmin.Address.String())
+		return deliverTx(r, app, ctx, ak, bk, admin, msg, nil)
+	}
+}
+
+// SimulateMsgSetDenomMetadata generates a MsgSetDenomMetadata with a
+// random description for a denom whose metadata manager is a simulation
+// account.
+func SimulateMsgSetDenomMetadata(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, metadataManager, found := randomDenomWithRole(r, ctx, k, accs, types.DenomRoleMetadataManager)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDenomMetadata, "no denom managed by an account"), nil, nil
+		}
+
+		_, subdenom, err := types.DeconstructDenom(denom)
+		if err != nil || subdenom == "" {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDenomMetadata, "denom without subdenom"), nil, nil
+		}
+
+		metadata := banktypes.Metadata{
+			Description: simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 0, 50)),
+			DenomUnits: []*banktypes.DenomUnit{{
+				Denom:    denom,
+				Exponent: 0,
+			}},
+			Base:    denom,
+			Display: denom,
+			Name:    subdenom,
+			Symbol:  strings.ToUpper(subdenom),
+		}
+
+		msg := types.NewMsgSetDenomMetadata(metadataManager.Address.String(), metadata)
+		return deliverTx(r, app, ctx, ak, bk, metadataManager, msg, nil)
+	}
+}
+
+// SimulateMsgSetMaxSupply generates a MsgSetMaxSupply between the current
+// supply and the max supply of a denom administered by a simulation account.
+func SimulateMsgSetMaxSupply(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetMaxSupply, "no denom administered by an account"), nil, nil
+		}
+
+		supply := bk.GetSupply(ctx, denom).Amount
+		maxSupply := supply.Add(sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 1_000_000_000))))
+		if current, found := k.GetMaxSupply(ctx, denom); found {
+			if !current.GT(supply) {
+				return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetMaxSupply, "max supply reached"), nil, nil
+			}
+			maxSupply = supply.Add(simtypes.RandomAmount(r, current.Sub(supply)))
+		}
+		if !maxSupply.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetMaxSupply, "denom has no supply to cap"), nil, nil
+		}
+
+		msg := types.NewMsgSetMaxSupply(admin.Address.String(), denom, maxSupply)
+		return deliverTx(r, app, ctx, ak, bk, admin, msg, nil)
+	}
+}
+
+// SimulateMsgSetDenomRole generates a MsgSetDenomRole granting a random
+// role of a denom administered by a simulation account to a random account,
+// or revoking it.
+func SimulateMsgSetDenomRole(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetDenomRole, "no denom administered by an account"), nil, nil
+		}
+
+		roles := types.AllDenomRoles()
+		role := roles[r.Intn(len(roles))]
+
+		var address string
+		if r.Intn(10) != 0 {
+			holder, _ := simtypes.RandomAcc(r, accs)
+			address = holder.Address.String()
+		}
+
+		var minterAllowance *sdk.Int
+		if role == types.DenomRoleMinter && r.Intn(2) == 0 {
+			allowance := sdk.NewInt(int64(simtypes.RandIntBetween(r, 0, 1_000_000_000)))
+			minterAllowance = &allowance
+		}
+
+		msg := types.NewMsgSetDenomRole(admin.Address.String(), denom, role, address, minterAllowance)
+		return deliverTx(r, app, ctx, ak, bk, admin, msg, nil)
+	}
+}
+
+// SimulateMsgRenounceCapability generates a MsgRenounceCapability for a
+// random capability that has not been renounced yet over a denom
+// administered by a simulation account.
+func SimulateMsgRenounceCapability(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRenounceCapability, "no denom administered by an account"), nil, nil
+		}
+
+		capability := types.DenomCapability(simtypes.RandIntBetween(r, 1, len(types.DenomCapability_name)))
+		if isRenounced(ctx, k, denom, capability) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgRenounceCapability, "capability already renounced"), nil, nil
+		}
+
+		msg := types.NewMsgRenounceCapability(admin.Address.String(), denom, capability)
+		return deliverTx(r, app, ctx, ak, bk, admin, msg, nil)
+	}
+}
+
+// SimulateMsgSetFrozenAddress generates a MsgSetFrozenAddress freezing or
+// unfreezing a random address for a denom administered by a simulation
+// account. The address is never a simulation account and the denoms are never
+// paused, since the frozen transfers would fail the operations of the other
+// modules.
+func SimulateMsgSetFrozenAddress(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		denom, admin, found := randomAdministeredDenom(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetFrozenAddress, "no denom administered by an account"), nil, nil
+		}
+
+		address := simtypes.RandomAccounts(r, 1)[0].Address
+		if _, isSimAccount := simtypes.FindAccount(accs, address); isSimAccount {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgSetFrozenAddress, "address is a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgSetFrozenAddress(admin.Address.String(), denom, address.String(), r.Intn(2) == 0)
+		return deliverTx(r, app, ctx, ak, bk, admin, msg, nil)
+	}
+}
+
+// isRenounced returns whether the capability over the denom has been renounced.
+func isRenounced(ctx sdk.Context, k keeper.Keeper, denom string, capability types.DenomCapability) bool {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	return err == nil && authorityMetadata.IsRenounced(capability)
+}
+
+// randomAdministeredDenom returns a random denom whose admin is one of the
+// simulation accounts, so that the account can sign the admin messages.
+func randomAdministeredDenom(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+) (string, simtypes.Account, bool) {
+	return randomDenomWithAuthority(r, ctx, k, accs, func(authorityMetadata types.DenomAuthorityMetadata) string {
+		return authorityMetadata.Admin
+	})
+}
+
+// randomDenomWithRole returns a random denom whose role is held by one of
+// the simulation accounts, so that the account can sign the role messages.
+func randomDenomWithRole(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account, role types.DenomRole,
+) (string, simtypes.Account, bool) {
+	return randomDenomWithAuthority(r, ctx, k, accs, func(authorityMetadata types.DenomAuthorityMetadata) string {
+		return authorityMetadata.RoleAddress(role)
+	})
+}
+
+// randomDenomWithAuthority returns a random denom whose authority, as
+// returned by holder, is one of the simulation accounts.
+func randomDenomWithAuthority(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account,
+	holder func(types.DenomAuthorityMetadata) string,
+) (string, simtypes.Account, bool) {
+	var (
+		denoms      []string
+		authorities []simtypes.Account
+	)
+
+	iterator := k.GetAllDenomsIterator(ctx)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Value())
+		authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+		if err != nil || holder(authorityMetadata) == "" {
+			continue
+		}
+		authorityAddr, err := sdk.AccAddressFromBech32(holder(authorityMetadata))
+		if err != nil {
+			continue
+		}
+		if authority, found := simtypes.FindAccount(accs, authorityAddr); found {
+			denoms = append(denoms, denom)
+			authorities = append(authorities, authority)
+		}
+	}
+
+	if len(denoms) == 0 {
+		return "", simtypes.Account{}, false
+	}
+	i := r.Intn(len(denoms))
+	return denoms[i], authorities[i], true
+}
+
+// randomHolder returns a random simulation account holding the denom,
+// along with a random part of its balance.
+func randomHolder(
+	r *rand.Rand, ctx sdk.Context, bk types.BankKeeper, accs []simtypes.Account, denom string,
+) (simtypes.Account, sdk.Coin, bool) {
+	holders := []simtypes.Account{}
+	for _, acc := range accs {
+		if bk.SpendableCoins(ctx, acc.Address).AmountOf(denom).IsPositive() {
+			holders = append(holders, acc)
+		}
+	}
+	if len(holders) == 0 {
+		return simtypes.Account{}, sdk.Coin{}, false
+	}
+
+	holder, _ := simtypes.RandomAcc(r, holders)
+	amount, err := simtypes.RandPositiveInt(r, bk.SpendableCoins(ctx, holder.Address).AmountOf(denom))
+	if err != nil {
+		return simtypes.Account{}, sdk.Coin{}, false
+	}
+	return holder, sdk.NewCoin(denom, amount), true
+}
+
+// coinsSpentBySigner returns the coins taken from the balance of the signer,
+// which pays the fees, so that the fees leave these coins untouched.
+func coinsSpentBySigner(signer, holder simtypes.Account, amount sdk.Coin) sdk.Coins {
+	if !signer.Equals(holder) {
+		return nil
+	}
+	return sdk.NewCoins(amount)
+}
+
+// deliverTx signs the message with the simulation account and delivers it
+// with random fees, leaving the coins spent by the message out of the fees.
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	simAccount simtypes.Account,
+	msg legacytx.LegacyMsg,
+	coinsSpentInMsg sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           moduletestutil.MakeTestEncodingConfig().TxConfig,
+		Cdc:             nil,
+		Msg:             msg,
+		MsgType:         msg.Type(),
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: coinsSpentInMsg,
+	}
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
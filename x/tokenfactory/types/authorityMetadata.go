@@ -1,15 +1,161 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (metadata DenomAuthorityMetadata) Validate() error {
-	if metadata.Admin != "" {
-		_, err := sdk.AccAddressFromBech32(metadata.Admin)
-		if err != nil {
-			return err
+	for _, addr := range []string{
+		metadata.Admin,
+		metadata.Minter,
+		metadata.Burner,
+		metadata.ForceTransferrer,
+		metadata.MetadataManager,
+		metadata.HookManager,
+	} {
+		if addr != "" {
+			_, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				return err
+			}
 		}
 	}
+
+	if metadata.MinterAllowance != nil && metadata.MinterAllowance.IsNegative() {
+		return fmt.Errorf("minter allowance cannot be negative: %s", metadata.MinterAllowance)
+	}
 	return nil
 }
+
+// RoleAddress returns the address holding the role, which is empty when
+// the role is not granted.
+func (metadata DenomAuthorityMetadata) RoleAddress(role DenomRole) string {
+	switch role {
+	case DenomRoleMinter:
+		return metadata.Minter
+	case DenomRoleBurner:
+		return metadata.Burner
+	case DenomRoleForceTransferrer:
+		return metadata.ForceTransferrer
+	case DenomRoleMetadataManager:
+		return metadata.MetadataManager
+	case DenomRoleHookManager:
+		return metadata.HookManager
+	default:
+		return ""
+	}
+}
+
+// HasRole returns whether the address holds the role.
+func (metadata DenomAuthorityMetadata) HasRole(role DenomRole, address string) bool {
+	holder := metadata.RoleAddress(role)
+	return holder != "" && holder == address
+}
+
+// SetRole grants the role to the address, or revokes it when the address
+// is empty.
+func (metadata *DenomAuthorityMetadata) SetRole(role DenomRole, address string) error {
+	switch role {
+	case DenomRoleMinter:
+		metadata.Minter = address
+	case DenomRoleBurner:
+		metadata.Burner = address
+	case DenomRoleForceTransferrer:
+		metadata.ForceTransferrer = address
+	case DenomRoleMetadataManager:
+		metadata.MetadataManager = address
+	case DenomRoleHookManager:
+		metadata.HookManager = address
+	default:
+		return ErrInvalidDenomRole.Wrapf("role: %s", role)
+	}
+	return nil
+}
+
+// AllDenomRoles returns every role that can be granted over a denom.
+func AllDenomRoles() []DenomRole {
+	return []DenomRole{
+		DenomRoleMinter,
+		DenomRoleBurner,
+		DenomRoleForceTransferrer,
+		DenomRoleMetadataManager,
+		DenomRoleHookManager,
+	}
+}
+
+// DenomRoleFromString parses a role from its short name, such as "minter",
+// or from its full enum name, such as "DENOM_ROLE_MINTER".
+func DenomRoleFromString(str string) (DenomRole, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "DENOM_ROLE_") {
+		name = "DENOM_ROLE_" + name
+	}
+	role, ok := DenomRole_value[name]
+	if !ok || DenomRole(role) == DenomRoleUnspecified {
+		return DenomRoleUnspecified, ErrInvalidDenomRole.Wrapf("role: %s", str)
+	}
+	return DenomRole(role), nil
+}
+
+// IsRenounced returns whether the capability has been renounced.
+func (metadata DenomAuthorityMetadata) IsRenounced(capability DenomCapability) bool {
+	switch capability {
+	case DenomCapabilityMint:
+		return metadata.MintRenounced
+	case DenomCapabilityBurnFrom:
+		return metadata.BurnFromRenounced
+	case DenomCapabilityForceTransfer:
+		return metadata.ForceTransferRenounced
+	case DenomCapabilityHookChanges:
+		return metadata.HookChangesRenounced
+	default:
+		return false
+	}
+}
+
+// Renounce permanently renounces the capability.
+func (metadata *DenomAuthorityMetadata) Renounce(capability DenomCapability) error {
+	switch capability {
+	case DenomCapabilityMint:
+		metadata.MintRenounced = true
+	case DenomCapabilityBurnFrom:
+		metadata.BurnFromRenounced = true
+	case DenomCapabilityForceTransfer:
+		metadata.ForceTransferRenounced = true
+	case DenomCapabilityHookChanges:
+		metadata.HookChangesRenounced = true
+	default:
+		return ErrInvalidDenomCapability.Wrapf("capability: %s", capability)
+	}
+	return nil
+}
+
+// DenomCapabilityFromString parses a capability from its short name, such as
+// "mint", or from its full enum name, such as "DENOM_CAPABILITY_MINT".
+func DenomCapabilityFromString(str string) (DenomCapability, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "DENOM_CAPABILITY_") {
+		name = "DENOM_CAPABILITY_" + name
+	}
+	capability, ok := DenomCapability_value[name]
+	if !ok || DenomCapability(capability) == DenomCapabilityUnspecified {
+		return DenomCapabilityUnspecified, ErrInvalidDenomCapability.Wrapf("capability: %s", str)
+	}
+	return DenomCapability(capability), nil
+}
+
+// NewAdminAuthorityMetadata returns the authority metadata of a denom whose
+// admin holds every role, which is how denoms are created.
+func NewAdminAuthorityMetadata(admin string) DenomAuthorityMetadata {
+	return DenomAuthorityMetadata{
+		Admin:            admin,
+		Minter:           admin,
+		Burner:           admin,
+		ForceTransferrer: admin,
+		MetadataManager:  admin,
+		HookManager:      admin,
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -24,12 +25,126 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomRole defines the roles that can be granted over a token factory denom.
+type DenomRole int32
+
+const (
+	// DENOM_ROLE_UNSPECIFIED is not a valid role.
+	DenomRoleUnspecified DenomRole = 0
+	// DENOM_ROLE_MINTER allows to mint the denom, up to the minter allowance.
+	DenomRoleMinter DenomRole = 1
+	// DENOM_ROLE_BURNER allows to burn the denom.
+	DenomRoleBurner DenomRole = 2
+	// DENOM_ROLE_FORCE_TRANSFERRER allows to force transfer the denom.
+	DenomRoleForceTransferrer DenomRole = 3
+	// DENOM_ROLE_METADATA_MANAGER allows to set the bank metadata of the denom.
+	DenomRoleMetadataManager DenomRole = 4
+	// DENOM_ROLE_HOOK_MANAGER allows to set the before send hook of the denom.
+	DenomRoleHookManager DenomRole = 5
+)
+
+var DenomRole_name = map[int32]string{
+	0: "DENOM_ROLE_UNSPECIFIED",
+	1: "DENOM_ROLE_MINTER",
+	2: "DENOM_ROLE_BURNER",
+	3: "DENOM_ROLE_FORCE_TRANSFERRER",
+	4: "DENOM_ROLE_METADATA_MANAGER",
+	5: "DENOM_ROLE_HOOK_MANAGER",
+}
+
+var DenomRole_value = map[string]int32{
+	"DENOM_ROLE_UNSPECIFIED":       0,
+	"DENOM_ROLE_MINTER":            1,
+	"DENOM_ROLE_BURNER":            2,
+	"DENOM_ROLE_FORCE_TRANSFERRER": 3,
+	"DENOM_ROLE_METADATA_MANAGER":  4,
+	"DENOM_ROLE_HOOK_MANAGER":      5,
+}
+
+func (x DenomRole) String() string {
+	return proto.EnumName(DenomRole_name, int32(x))
+}
+
+func (DenomRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{0}
+}
+
+// DenomCapability defines the capabilities over a token factory denom that
+// the admin can permanently renounce.
+type DenomCapability int32
+
+const (
+	// DENOM_CAPABILITY_UNSPECIFIED is not a valid capability.
+	DenomCapabilityUnspecified DenomCapability = 0
+	// DENOM_CAPABILITY_MINT is the capability to mint the denom.
+	DenomCapabilityMint DenomCapability = 1
+	// DENOM_CAPABILITY_BURN_FROM is the capability to burn the denom from other
+	// accounts than the burner.
+	DenomCapabilityBurnFrom DenomCapability = 2
+	// DENOM_CAPABILITY_FORCE_TRANSFER is the capability to force transfer the
+	// denom.
+	DenomCapabilityForceTransfer DenomCapability = 3
+	// DENOM_CAPABILITY_HOOK_CHANGES is the capability to change the before send
+	// hook of the denom.
+	DenomCapabilityHookChanges DenomCapability = 4
+)
+
+var DenomCapability_name = map[int32]string{
+	0: "DENOM_CAPABILITY_UNSPECIFIED",
+	1: "DENOM_CAPABILITY_MINT",
+	2: "DENOM_CAPABILITY_BURN_FROM",
+	3: "DENOM_CAPABILITY_FORCE_TRANSFER",
+	4: "DENOM_CAPABILITY_HOOK_CHANGES",
+}
+
+var DenomCapability_value = map[string]int32{
+	"DENOM_CAPABILITY_UNSPECIFIED":    0,
+	"DENOM_CAPABILITY_MINT":           1,
+	"DENOM_CAPABILITY_BURN_FROM":      2,
+	"DENOM_CAPABILITY_FORCE_TRANSFER": 3,
+	"DENOM_CAPABILITY_HOOK_CHANGES":   4,
+}
+
+func (x DenomCapability) String() string {
+	return proto.EnumName(DenomCapability_name, int32(x))
+}
+
+func (DenomCapability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_99435de88ae175f7, []int{1}
+}
+
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. The admin manages the roles and the
+// max supply of the denom, while each role can be granted to a different
+// address.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid osmosis address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Address allowed to mint the denom, can be empty for no minter
+	Minter string `protobuf:"bytes,2,opt,name=minter,proto3" json:"minter,omitempty" yaml:"minter"`
+	// Address allowed to burn the denom, can be empty for no burner
+	Burner string `protobuf:"bytes,3,opt,name=burner,proto3" json:"burner,omitempty" yaml:"burner"`
+	// Address allowed to force transfer the denom, can be empty for no
+	// force transferrer
+	ForceTransferrer string `protobuf:"bytes,4,opt,name=force_transferrer,json=forceTransferrer,proto3" json:"force_transferrer,omitempty" yaml:"force_transferrer"`
+	// Address allowed to set the bank metadata of the denom, can be empty for
+	// no metadata manager
+	MetadataManager string `protobuf:"bytes,5,opt,name=metadata_manager,json=metadataManager,proto3" json:"metadata_manager,omitempty" yaml:"metadata_manager"`
+	// Address allowed to set the before send hook of the denom, can be empty
+	// for no hook manager
+	HookManager string `protobuf:"bytes,6,opt,name=hook_manager,json=hookManager,proto3" json:"hook_manager,omitempty" yaml:"hook_manager"`
+	// Amount the minter is still allowed to mint, unlimited when empty
+	MinterAllowance *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=minter_allowance,json=minterAllowance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minter_allowance,omitempty" yaml:"minter_allowance"`
+	// Whether minting the denom has been permanently renounced
+	MintRenounced bool `protobuf:"varint,8,opt,name=mint_renounced,json=mintRenounced,proto3" json:"mint_renounced,omitempty" yaml:"mint_renounced"`
+	// Whether burning the denom from other accounts than the burner has been
+	// permanently renounced
+	BurnFromRenounced bool `protobuf:"varint,9,opt,name=burn_from_renounced,json=burnFromRenounced,proto3" json:"burn_from_renounced,omitempty" yaml:"burn_from_renounced"`
+	// Whether force transferring the denom has been permanently renounced
+	ForceTransferRenounced bool `protobuf:"varint,10,opt,name=force_transfer_renounced,json=forceTransferRenounced,proto3" json:"force_transfer_renounced,omitempty" yaml:"force_transfer_renounced"`
+	// Whether changing the before send hook of the denom has been permanently
+	// renounced
+	HookChangesRenounced bool `protobuf:"varint,11,opt,name=hook_changes_renounced,json=hookChangesRenounced,proto3" json:"hook_changes_renounced,omitempty" yaml:"hook_changes_renounced"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,7 +187,72 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetMinter() string {
+	if m != nil {
+		return m.Minter
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetBurner() string {
+	if m != nil {
+		return m.Burner
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferrer() string {
+	if m != nil {
+		return m.ForceTransferrer
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetMetadataManager() string {
+	if m != nil {
+		return m.MetadataManager
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetHookManager() string {
+	if m != nil {
+		return m.HookManager
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetMintRenounced() bool {
+	if m != nil {
+		return m.MintRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetBurnFromRenounced() bool {
+	if m != nil {
+		return m.BurnFromRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetForceTransferRenounced() bool {
+	if m != nil {
+		return m.ForceTransferRenounced
+	}
+	return false
+}
+
+func (m *DenomAuthorityMetadata) GetHookChangesRenounced() bool {
+	if m != nil {
+		return m.HookChangesRenounced
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.DenomRole", DenomRole_name, DenomRole_value)
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.DenomCapability", DenomCapability_name, DenomCapability_value)
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "osmosis.tokenfactory.v1beta1.DenomAuthorityMetadata")
 }
 
@@ -81,22 +261,62 @@ func init() {
 }
 
 var fileDescriptor_99435de88ae175f7 = []byte{
-	// 239 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca,
-	0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x81, 0xea, 0xd2, 0x43, 0xd6, 0xa5, 0x07, 0xd5, 0x25, 0x25, 0x92, 0x9e, 0x9f, 0x9e,
-	0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0xc9, 0x25, 0x83, 0x35, 0xe9, 0x27, 0x25, 0x16,
-	0xa7, 0xc2, 0x2d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71, 0x89, 0xb9, 0xa4, 0xe6,
-	0xe5, 0xe7, 0x3a, 0xa2, 0xdb, 0x29, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92, 0x9b, 0x99, 0x27, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c, 0xa3, 0x53, 0xd0, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x59, 0xa4, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x43, 0x3d, 0xa0, 0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97,
-	0x19, 0x9a, 0xeb, 0x57, 0xa0, 0x86, 0x44, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xa0, 0x40, 0xce, 0x2e, 0x01, 0x00, 0x00,
+	// 866 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x55, 0x51, 0x6f, 0xdb, 0x54,
+	0x18, 0x8d, 0xd3, 0xb4, 0xac, 0x77, 0x1b, 0x73, 0xdd, 0xae, 0xf1, 0xdc, 0xd4, 0x36, 0x46, 0x9a,
+	0x46, 0xa5, 0xc5, 0x2a, 0x0c, 0x81, 0x8a, 0x10, 0x73, 0x52, 0x67, 0x8d, 0x58, 0x92, 0xe9, 0x36,
+	0x15, 0x02, 0x09, 0x59, 0x37, 0xce, 0x4d, 0x6a, 0x35, 0xf6, 0xad, 0x6c, 0x67, 0x90, 0x7f, 0x80,
+	0xf2, 0xc4, 0x1f, 0xb0, 0x84, 0xc4, 0x23, 0x7f, 0x64, 0x8f, 0x7b, 0x40, 0x08, 0xf1, 0x60, 0xa1,
+	0xf6, 0x85, 0x67, 0xff, 0x02, 0xe4, 0x6b, 0x27, 0x76, 0x9c, 0x3e, 0x25, 0xf7, 0xdc, 0x73, 0x8e,
+	0xbf, 0xfb, 0x1d, 0x7f, 0xbe, 0xe0, 0x05, 0xf1, 0x6c, 0xe2, 0x59, 0x9e, 0xea, 0x93, 0x2b, 0xec,
+	0x8c, 0x90, 0xe9, 0x13, 0x77, 0xa6, 0xbe, 0x3d, 0x1e, 0x60, 0x1f, 0x1d, 0xab, 0x68, 0xea, 0x5f,
+	0x12, 0xd7, 0xf2, 0x67, 0x1d, 0xec, 0xa3, 0x21, 0xf2, 0x51, 0xfd, 0xda, 0x25, 0x3e, 0xe1, 0x6a,
+	0xa9, 0xaa, 0x9e, 0x57, 0xd5, 0x53, 0x95, 0xb0, 0x37, 0x26, 0x63, 0x42, 0x89, 0x6a, 0xfc, 0x2f,
+	0xd1, 0x08, 0xa2, 0x49, 0x45, 0xea, 0x00, 0x79, 0x78, 0xf9, 0x00, 0x93, 0x58, 0x4e, 0xb2, 0xaf,
+	0xfc, 0xb1, 0x05, 0xf6, 0x4f, 0xb1, 0x43, 0x6c, 0xad, 0xf8, 0x50, 0xee, 0x29, 0xd8, 0x44, 0x43,
+	0xdb, 0x72, 0x78, 0x46, 0x66, 0x9e, 0x6d, 0x37, 0xd8, 0x28, 0x94, 0x1e, 0xcc, 0x90, 0x3d, 0x39,
+	0x51, 0x28, 0xac, 0xc0, 0x64, 0x9b, 0xfb, 0x04, 0x6c, 0xd9, 0x96, 0xe3, 0x63, 0x97, 0x2f, 0x53,
+	0xe2, 0x4e, 0x14, 0x4a, 0x0f, 0x13, 0x62, 0x82, 0x2b, 0x30, 0x25, 0xc4, 0xd4, 0xc1, 0xd4, 0x75,
+	0xb0, 0xcb, 0x6f, 0x14, 0xa9, 0x09, 0xae, 0xc0, 0x94, 0xc0, 0xb5, 0xc1, 0xce, 0x88, 0xb8, 0x26,
+	0x36, 0x7c, 0x17, 0x39, 0xde, 0x08, 0xbb, 0x2e, 0x76, 0xf9, 0x0a, 0x55, 0xd5, 0xa2, 0x50, 0xe2,
+	0x13, 0xd5, 0x1a, 0x45, 0x81, 0x2c, 0xc5, 0xfa, 0x19, 0xc4, 0xb5, 0x00, 0x6b, 0xa7, 0x87, 0x32,
+	0x6c, 0xe4, 0xa0, 0x31, 0x76, 0xf9, 0x4d, 0xea, 0x74, 0x10, 0x85, 0x52, 0x35, 0x2d, 0xb5, 0xc0,
+	0x50, 0xe0, 0xa3, 0x05, 0xd4, 0x49, 0x10, 0xee, 0x04, 0x3c, 0xb8, 0x24, 0xe4, 0x6a, 0xe9, 0xb1,
+	0x45, 0x3d, 0xaa, 0x51, 0x28, 0xed, 0x26, 0x1e, 0xf9, 0x5d, 0x05, 0xde, 0x8f, 0x97, 0x0b, 0xad,
+	0x0f, 0xd8, 0xa4, 0x07, 0x06, 0x9a, 0x4c, 0xc8, 0x4f, 0xc8, 0x31, 0x31, 0xff, 0x01, 0xd5, 0xb7,
+	0xdf, 0x85, 0x12, 0xf3, 0x4f, 0x28, 0x3d, 0x1d, 0x5b, 0xfe, 0xe5, 0x74, 0x50, 0x37, 0x89, 0xad,
+	0xa6, 0xa1, 0x25, 0x3f, 0xcf, 0xbd, 0xe1, 0x95, 0xea, 0xcf, 0xae, 0xb1, 0x57, 0x6f, 0x3b, 0x7e,
+	0xae, 0xe2, 0x82, 0x5f, 0x5c, 0x31, 0x85, 0xb4, 0x05, 0xc2, 0xbd, 0x04, 0x1f, 0xc6, 0x90, 0xe1,
+	0x62, 0x87, 0x4c, 0x1d, 0x13, 0x0f, 0xf9, 0x7b, 0x32, 0xf3, 0xec, 0x5e, 0xe3, 0x49, 0x14, 0x4a,
+	0x8f, 0x33, 0x97, 0x6c, 0x5f, 0x81, 0x0f, 0x63, 0x00, 0x2e, 0xd6, 0x5c, 0x17, 0xec, 0xc6, 0x81,
+	0x18, 0x23, 0x97, 0xd8, 0x39, 0x9b, 0x6d, 0x6a, 0x23, 0x46, 0xa1, 0x24, 0x64, 0xf1, 0x15, 0x48,
+	0x0a, 0xdc, 0x89, 0xd1, 0x96, 0x4b, 0xec, 0xcc, 0xef, 0x47, 0xc0, 0xaf, 0x66, 0x96, 0x33, 0x05,
+	0xd4, 0xf4, 0xe3, 0x28, 0x94, 0xa4, 0xbb, 0xd2, 0xcd, 0x3b, 0xef, 0xaf, 0x84, 0x9c, 0xd9, 0x7f,
+	0x07, 0xf6, 0x69, 0x08, 0xe6, 0x25, 0x72, 0xc6, 0xd8, 0xcb, 0x99, 0xdf, 0xa7, 0xe6, 0x1f, 0x45,
+	0xa1, 0x74, 0x98, 0x0b, 0x6b, 0x8d, 0xa7, 0xc0, 0xbd, 0x78, 0xa3, 0x99, 0xe0, 0x4b, 0xe3, 0x93,
+	0xca, 0x7f, 0xbf, 0x49, 0xcc, 0xd1, 0x5f, 0x65, 0xb0, 0x4d, 0xa7, 0x05, 0x92, 0x09, 0xe6, 0x5e,
+	0x80, 0xfd, 0x53, 0xbd, 0xdb, 0xeb, 0x18, 0xb0, 0xf7, 0x5a, 0x37, 0x2e, 0xba, 0xe7, 0x6f, 0xf4,
+	0x66, 0xbb, 0xd5, 0xd6, 0x4f, 0xd9, 0x92, 0xc0, 0xcf, 0x03, 0x79, 0x6f, 0x49, 0xbd, 0x70, 0xbc,
+	0x6b, 0x6c, 0x5a, 0x23, 0x0b, 0x0f, 0xb9, 0x23, 0xb0, 0x93, 0x53, 0x75, 0xda, 0xdd, 0xbe, 0x0e,
+	0x59, 0x46, 0xd8, 0x9d, 0x07, 0xf2, 0xa3, 0xa5, 0xa0, 0x93, 0xcc, 0xcb, 0x2a, 0xb7, 0x71, 0x01,
+	0xbb, 0x3a, 0x64, 0xcb, 0x05, 0x6e, 0x23, 0x19, 0x98, 0x6f, 0x40, 0x2d, 0xc7, 0x6d, 0xf5, 0x60,
+	0x53, 0x37, 0xfa, 0x50, 0xeb, 0x9e, 0xb7, 0x74, 0x08, 0x75, 0xc8, 0x6e, 0x08, 0x87, 0xf3, 0x40,
+	0x7e, 0xb2, 0x94, 0xb5, 0x8a, 0x63, 0xf2, 0x35, 0x38, 0xc8, 0x17, 0xa6, 0xf7, 0xb5, 0x53, 0xad,
+	0xaf, 0x19, 0x1d, 0xad, 0xab, 0xbd, 0xd2, 0x21, 0x5b, 0x11, 0x6a, 0xf3, 0x40, 0xe6, 0xb3, 0x12,
+	0x0b, 0xd3, 0xf1, 0x39, 0xa8, 0xe6, 0xe4, 0x67, 0xbd, 0xde, 0xb7, 0x4b, 0xe9, 0x66, 0xa1, 0x1d,
+	0x67, 0xd9, 0x60, 0x08, 0x95, 0x5f, 0x7e, 0x17, 0x4b, 0x47, 0x7f, 0x96, 0x41, 0x72, 0xa0, 0x26,
+	0xba, 0x46, 0x03, 0x6b, 0x62, 0xf9, 0x33, 0xee, 0xe5, 0xe2, 0x40, 0x4d, 0xed, 0x8d, 0xd6, 0x68,
+	0xbf, 0x6e, 0xf7, 0xbf, 0x2f, 0x34, 0x59, 0x9c, 0x07, 0xb2, 0x50, 0x90, 0xe5, 0x5b, 0xfd, 0x29,
+	0x78, 0xbc, 0xe6, 0x10, 0x37, 0x9c, 0x65, 0x84, 0xea, 0x3c, 0x90, 0x77, 0x0b, 0xd2, 0xb8, 0xe9,
+	0xdc, 0x57, 0x40, 0x58, 0xd3, 0xc4, 0x8d, 0x37, 0x5a, 0xb0, 0xd7, 0x61, 0xcb, 0xc2, 0xc1, 0x3c,
+	0x90, 0xab, 0x05, 0x61, 0x23, 0x7d, 0xcd, 0x39, 0x1d, 0x48, 0x6b, 0xe2, 0xd5, 0x24, 0xd8, 0x0d,
+	0x41, 0x9e, 0x07, 0x72, 0xad, 0xe0, 0xb0, 0x12, 0x06, 0xa7, 0x81, 0xc3, 0x35, 0x1b, 0xda, 0xd0,
+	0xe6, 0x99, 0xd6, 0x7d, 0xa5, 0x9f, 0xb3, 0x95, 0x3b, 0x8f, 0x7e, 0x96, 0xbd, 0xb8, 0x49, 0x5b,
+	0x1b, 0xf0, 0xdd, 0x8d, 0xc8, 0xbc, 0xbf, 0x11, 0x99, 0x7f, 0x6f, 0x44, 0xe6, 0xd7, 0x5b, 0xb1,
+	0xf4, 0xfe, 0x56, 0x2c, 0xfd, 0x7d, 0x2b, 0x96, 0x7e, 0xf8, 0x32, 0xf7, 0xb5, 0x49, 0xaf, 0x95,
+	0xe7, 0x13, 0x34, 0xf0, 0x16, 0x0b, 0xf5, 0xed, 0xf1, 0x17, 0xea, 0xcf, 0xab, 0xf7, 0x13, 0xfd,
+	0x06, 0x0d, 0xb6, 0xe8, 0xc5, 0xf1, 0xd9, 0xff, 0x03, 0x00, 0x92, 0xf3, 0x02, 0x73, 0xc4, 0x06,
+	0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +341,40 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if this.Minter != that1.Minter {
+		return false
+	}
+	if this.Burner != that1.Burner {
+		return false
+	}
+	if this.ForceTransferrer != that1.ForceTransferrer {
+		return false
+	}
+	if this.MetadataManager != that1.MetadataManager {
+		return false
+	}
+	if this.HookManager != that1.HookManager {
+		return false
+	}
+	if that1.MinterAllowance == nil {
+		if this.MinterAllowance != nil {
+			return false
+		}
+	} else if !this.MinterAllowance.Equal(*that1.MinterAllowance) {
+		return false
+	}
+	if this.MintRenounced != that1.MintRenounced {
+		return false
+	}
+	if this.BurnFromRenounced != that1.BurnFromRenounced {
+		return false
+	}
+	if this.ForceTransferRenounced != that1.ForceTransferRenounced {
+		return false
+	}
+	if this.HookChangesRenounced != that1.HookChangesRenounced {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +397,93 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.HookChangesRenounced {
+		i--
+		if m.HookChangesRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ForceTransferRenounced {
+		i--
+		if m.ForceTransferRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BurnFromRenounced {
+		i--
+		if m.BurnFromRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MintRenounced {
+		i--
+		if m.MintRenounced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinterAllowance != nil {
+		{
+			size := m.MinterAllowance.Size()
+			i -= size
+			if _, err := m.MinterAllowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.HookManager) > 0 {
+		i -= len(m.HookManager)
+		copy(dAtA[i:], m.HookManager)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.HookManager)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MetadataManager) > 0 {
+		i -= len(m.MetadataManager)
+		copy(dAtA[i:], m.MetadataManager)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.MetadataManager)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ForceTransferrer) > 0 {
+		i -= len(m.ForceTransferrer)
+		copy(dAtA[i:], m.ForceTransferrer)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.ForceTransferrer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Burner) > 0 {
+		i -= len(m.Burner)
+		copy(dAtA[i:], m.Burner)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Burner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Minter) > 0 {
+		i -= len(m.Minter)
+		copy(dAtA[i:], m.Minter)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Minter)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -174,6 +515,42 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	l = len(m.Minter)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.Burner)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.ForceTransferrer)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.MetadataManager)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.HookManager)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MinterAllowance != nil {
+		l = m.MinterAllowance.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MintRenounced {
+		n += 2
+	}
+	if m.BurnFromRenounced {
+		n += 2
+	}
+	if m.ForceTransferRenounced {
+		n += 2
+	}
+	if m.HookChangesRenounced {
+		n += 2
+	}
 	return n
 }
 
@@ -244,6 +621,282 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForceTransferrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinterAllowance = &v
+			if err := m.MinterAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MintRenounced = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFromRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnFromRenounced = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTransferRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceTransferRenounced = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookChangesRenounced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HookChangesRenounced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
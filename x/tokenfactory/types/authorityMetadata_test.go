@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/terra-money/core/v2/x/tokenfactory/types"
 )
 
@@ -23,3 +25,83 @@ func TestAuthorityMetadata(t *testing.T) {
 
 	require.Error(t, data.Validate())
 }
+
+func TestAuthorityMetadataRoles(t *testing.T) {
+	admin := sdk.AccAddress("admin").String()
+	minter := sdk.AccAddress("minter").String()
+
+	data := types.NewAdminAuthorityMetadata(admin)
+	require.NoError(t, data.Validate())
+	for _, role := range types.AllDenomRoles() {
+		require.True(t, data.HasRole(role, admin))
+		require.False(t, data.HasRole(role, minter))
+	}
+
+	require.NoError(t, data.SetRole(types.DenomRoleMinter, minter))
+	require.True(t, data.HasRole(types.DenomRoleMinter, minter))
+	require.False(t, data.HasRole(types.DenomRoleMinter, admin))
+
+	require.NoError(t, data.SetRole(types.DenomRoleBurner, ""))
+	require.False(t, data.HasRole(types.DenomRoleBurner, ""))
+	require.False(t, data.HasRole(types.DenomRoleBurner, admin))
+
+	require.ErrorIs(t, data.SetRole(types.DenomRoleUnspecified, admin), types.ErrInvalidDenomRole)
+
+	data.ForceTransferrer = "satoshi"
+	require.Error(t, data.Validate())
+
+	data.ForceTransferrer = admin
+	allowance := sdk.NewInt(-1)
+	data.MinterAllowance = &allowance
+	require.Error(t, data.Validate())
+}
+
+func TestDenomRoleFromString(t *testing.T) {
+	for str, expected := range map[string]types.DenomRole{
+		"minter":                       types.DenomRoleMinter,
+		"burner":                       types.DenomRoleBurner,
+		"force_transferrer":            types.DenomRoleForceTransferrer,
+		"metadata_manager":             types.DenomRoleMetadataManager,
+		"HOOK_MANAGER":                 types.DenomRoleHookManager,
+		"DENOM_ROLE_FORCE_TRANSFERRER": types.DenomRoleForceTransferrer,
+	} {
+		role, err := types.DenomRoleFromString(str)
+		require.NoError(t, err, str)
+		require.Equal(t, expected, role, str)
+	}
+
+	for _, str := range []string{"", "unspecified", "admin"} {
+		_, err := types.DenomRoleFromString(str)
+		require.ErrorIs(t, err, types.ErrInvalidDenomRole, str)
+	}
+}
+
+func TestAuthorityMetadataCapabilities(t *testing.T) {
+	data := types.NewAdminAuthorityMetadata(sdk.AccAddress("admin").String())
+	for _, capability := range []types.DenomCapability{
+		types.DenomCapabilityMint,
+		types.DenomCapabilityBurnFrom,
+		types.DenomCapabilityForceTransfer,
+		types.DenomCapabilityHookChanges,
+	} {
+		require.False(t, data.IsRenounced(capability))
+		require.NoError(t, data.Renounce(capability))
+		require.True(t, data.IsRenounced(capability))
+	}
+	require.ErrorIs(t, data.Renounce(types.DenomCapabilityUnspecified), types.ErrInvalidDenomCapability)
+
+	for str, expected := range map[string]types.DenomCapability{
+		"mint":                          types.DenomCapabilityMint,
+		"burn_from":                     types.DenomCapabilityBurnFrom,
+		"FORCE_TRANSFER":                types.DenomCapabilityForceTransfer,
+		"DENOM_CAPABILITY_HOOK_CHANGES": types.DenomCapabilityHookChanges,
+	} {
+		capability, err := types.DenomCapabilityFromString(str)
+		require.NoError(t, err, str)
+		require.Equal(t, expected, capability, str)
+	}
+	for _, str := range []string{"", "unspecified", "burn"} {
+		_, err := types.DenomCapabilityFromString(str)
+		require.ErrorIs(t, err, types.ErrInvalidDenomCapability, str)
+	}
+}
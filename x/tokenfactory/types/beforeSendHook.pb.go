@@ -0,0 +1,411 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/tokenfactory/v1beta1/beforeSendHook.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BeforeSendHookVersion defines the format of the sudo messages sent to the
+// before send hook contract of a denom.
+type BeforeSendHookVersion int32
+
+const (
+	// BEFORE_SEND_HOOK_VERSION_V1 is the legacy format, which sudo-calls the
+	// contract once per coin of the transfer with a block_before_send or
+	// track_before_send message carrying that coin.
+	BeforeSendHookVersionV1 BeforeSendHookVersion = 0
+	// BEFORE_SEND_HOOK_VERSION_V2 sudo-calls the contract once per transfer
+	// with a block_before_send_v2 or track_before_send_v2 message carrying all
+	// the coins of the transfer whose denoms are hooked to the contract.
+	BeforeSendHookVersionV2 BeforeSendHookVersion = 1
+)
+
+var BeforeSendHookVersion_name = map[int32]string{
+	0: "BEFORE_SEND_HOOK_VERSION_V1",
+	1: "BEFORE_SEND_HOOK_VERSION_V2",
+}
+
+var BeforeSendHookVersion_value = map[string]int32{
+	"BEFORE_SEND_HOOK_VERSION_V1": 0,
+	"BEFORE_SEND_HOOK_VERSION_V2": 1,
+}
+
+func (x BeforeSendHookVersion) String() string {
+	return proto.EnumName(BeforeSendHookVersion_name, int32(x))
+}
+
+func (BeforeSendHookVersion) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10e6a7d3956f6eea, []int{0}
+}
+
+// BeforeSendHookGasLimits defines the gas limits of the before send hook calls
+// of a denom, overriding the gas limits set in the module params. Zero means
+// that the gas limit of the module params is used.
+type BeforeSendHookGasLimits struct {
+	TrackGasLimit uint64 `protobuf:"varint,1,opt,name=track_gas_limit,json=trackGasLimit,proto3" json:"track_gas_limit,omitempty" yaml:"track_gas_limit"`
+	BlockGasLimit uint64 `protobuf:"varint,2,opt,name=block_gas_limit,json=blockGasLimit,proto3" json:"block_gas_limit,omitempty" yaml:"block_gas_limit"`
+}
+
+func (m *BeforeSendHookGasLimits) Reset()         { *m = BeforeSendHookGasLimits{} }
+func (m *BeforeSendHookGasLimits) String() string { return proto.CompactTextString(m) }
+func (*BeforeSendHookGasLimits) ProtoMessage()    {}
+func (*BeforeSendHookGasLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10e6a7d3956f6eea, []int{0}
+}
+func (m *BeforeSendHookGasLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BeforeSendHookGasLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BeforeSendHookGasLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BeforeSendHookGasLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeforeSendHookGasLimits.Merge(m, src)
+}
+func (m *BeforeSendHookGasLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *BeforeSendHookGasLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeforeSendHookGasLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeforeSendHookGasLimits proto.InternalMessageInfo
+
+func (m *BeforeSendHookGasLimits) GetTrackGasLimit() uint64 {
+	if m != nil {
+		return m.TrackGasLimit
+	}
+	return 0
+}
+
+func (m *BeforeSendHookGasLimits) GetBlockGasLimit() uint64 {
+	if m != nil {
+		return m.BlockGasLimit
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("osmosis.tokenfactory.v1beta1.BeforeSendHookVersion", BeforeSendHookVersion_name, BeforeSendHookVersion_value)
+	proto.RegisterType((*BeforeSendHookGasLimits)(nil), "osmosis.tokenfactory.v1beta1.BeforeSendHookGasLimits")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/tokenfactory/v1beta1/beforeSendHook.proto", fileDescriptor_10e6a7d3956f6eea)
+}
+
+var fileDescriptor_10e6a7d3956f6eea = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x18, 0x86, 0x73, 0x52, 0x1c, 0x02, 0xc5, 0x52, 0xd4, 0x4a, 0x2a, 0xd7, 0x92, 0x49, 0x04, 0x73,
+	0xa4, 0x0e, 0x4a, 0x71, 0x0a, 0x46, 0x2b, 0x4a, 0x03, 0x29, 0x64, 0x70, 0x09, 0x77, 0xf5, 0x1a,
+	0x43, 0x93, 0x7e, 0x25, 0x77, 0x16, 0xfb, 0x0f, 0xc4, 0xc9, 0xd5, 0x41, 0x10, 0x1c, 0xfc, 0x2b,
+	0x8e, 0x1d, 0x9d, 0x44, 0xda, 0xc5, 0xd9, 0x5f, 0x20, 0x8d, 0x2d, 0x98, 0x22, 0xdd, 0xee, 0x5e,
+	0xde, 0xe7, 0xe1, 0x83, 0x57, 0x35, 0x41, 0xc4, 0x20, 0x42, 0x41, 0x24, 0x74, 0x79, 0xaf, 0x43,
+	0xdb, 0x12, 0x92, 0x21, 0x19, 0x98, 0x8c, 0x4b, 0x6a, 0x12, 0xc6, 0x3b, 0x90, 0xf0, 0x16, 0xef,
+	0x5d, 0x35, 0x00, 0xba, 0x46, 0x3f, 0x01, 0x09, 0xc5, 0xed, 0x19, 0x62, 0xfc, 0x45, 0x8c, 0x19,
+	0xa2, 0xad, 0x07, 0x10, 0x40, 0x5a, 0x24, 0xd3, 0xd7, 0x2f, 0xa3, 0xbf, 0x22, 0xb5, 0x64, 0x65,
+	0x64, 0xa7, 0x54, 0x5c, 0x84, 0x71, 0x28, 0x45, 0xd1, 0x52, 0xd7, 0x64, 0x42, 0xdb, 0x5d, 0x3f,
+	0xa0, 0xc2, 0x8f, 0xa6, 0xd9, 0x16, 0xaa, 0xa2, 0x9d, 0x9c, 0xa5, 0x7d, 0x7f, 0x54, 0x36, 0x87,
+	0x34, 0x8e, 0xea, 0xfa, 0x42, 0x41, 0x77, 0xf3, 0x69, 0x32, 0x97, 0x4c, 0x1d, 0x2c, 0x82, 0x8c,
+	0x63, 0x65, 0xd1, 0xb1, 0x50, 0xd0, 0xdd, 0x7c, 0x9a, 0xcc, 0x1d, 0xf5, 0xdc, 0xd7, 0x73, 0x05,
+	0xed, 0x3e, 0x22, 0x75, 0x23, 0x7b, 0xa9, 0xc7, 0x13, 0x11, 0x42, 0xaf, 0x78, 0xa4, 0x96, 0x2d,
+	0xfb, 0xc4, 0x71, 0x6d, 0xbf, 0x65, 0x37, 0x8f, 0xfd, 0x86, 0xe3, 0x9c, 0xfb, 0x9e, 0xed, 0xb6,
+	0xce, 0x9c, 0xa6, 0xef, 0x99, 0x05, 0x45, 0x2b, 0xdf, 0x3f, 0x55, 0x4b, 0xff, 0xb2, 0x9e, 0xb9,
+	0x9c, 0xae, 0x15, 0xd0, 0x32, 0xba, 0xa6, 0xe5, 0xee, 0x5e, 0xb0, 0x62, 0xb9, 0x6f, 0x63, 0x8c,
+	0x46, 0x63, 0x8c, 0x3e, 0xc7, 0x18, 0x3d, 0x4c, 0xb0, 0x32, 0x9a, 0x60, 0xe5, 0x7d, 0x82, 0x95,
+	0xcb, 0xc3, 0x20, 0x94, 0xd7, 0x37, 0xcc, 0x68, 0x43, 0x4c, 0x66, 0xf3, 0xec, 0x45, 0x94, 0x89,
+	0xf9, 0x87, 0x0c, 0xcc, 0x03, 0x72, 0x9b, 0x1d, 0x59, 0x0e, 0xfb, 0x5c, 0xb0, 0xd5, 0x74, 0xa0,
+	0xfd, 0x9f, 0x01, 0x00, 0xb0, 0x50, 0x6e, 0x4e, 0x09, 0x02, 0x00, 0x00,
+}
+
+func (this *BeforeSendHookGasLimits) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BeforeSendHookGasLimits)
+	if !ok {
+		that2, ok := that.(BeforeSendHookGasLimits)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TrackGasLimit != that1.TrackGasLimit {
+		return false
+	}
+	if this.BlockGasLimit != that1.BlockGasLimit {
+		return false
+	}
+	return true
+}
+func (m *BeforeSendHookGasLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BeforeSendHookGasLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BeforeSendHookGasLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasLimit != 0 {
+		i = encodeVarintBeforeSendHook(dAtA, i, uint64(m.BlockGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TrackGasLimit != 0 {
+		i = encodeVarintBeforeSendHook(dAtA, i, uint64(m.TrackGasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBeforeSendHook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBeforeSendHook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BeforeSendHookGasLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrackGasLimit != 0 {
+		n += 1 + sovBeforeSendHook(uint64(m.TrackGasLimit))
+	}
+	if m.BlockGasLimit != 0 {
+		n += 1 + sovBeforeSendHook(uint64(m.BlockGasLimit))
+	}
+	return n
+}
+
+func sovBeforeSendHook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBeforeSendHook(x uint64) (n int) {
+	return sovBeforeSendHook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BeforeSendHookGasLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeforeSendHook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BeforeSendHookGasLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BeforeSendHookGasLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackGasLimit", wireType)
+			}
+			m.TrackGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSendHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasLimit", wireType)
+			}
+			m.BlockGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeforeSendHook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeforeSendHook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBeforeSendHook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBeforeSendHook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBeforeSendHook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeforeSendHook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBeforeSendHook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBeforeSendHook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBeforeSendHook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBeforeSendHook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBeforeSendHook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBeforeSendHook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBeforeSendHook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,9 +1,26 @@
 package types
 
 import (
+	"strings"
+
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 )
 
+// BeforeSendHookVersionFromString parses a before send hook version from its
+// short name, such as "v2", or from its full enum name, such as
+// "BEFORE_SEND_HOOK_VERSION_V2".
+func BeforeSendHookVersionFromString(str string) (BeforeSendHookVersion, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "BEFORE_SEND_HOOK_VERSION_") {
+		name = "BEFORE_SEND_HOOK_VERSION_" + name
+	}
+	version, ok := BeforeSendHookVersion_value[name]
+	if !ok {
+		return BeforeSendHookVersionV1, ErrInvalidHookVersion.Wrapf("version: %s", str)
+	}
+	return BeforeSendHookVersion(version), nil
+}
+
 type BlockBeforeSendSudoMsg struct {
 	BlockBeforeSend BlockBeforeSendMsg `json:"block_before_send,omitempty"`
 }
@@ -23,3 +40,23 @@ type BlockBeforeSendMsg struct {
 	To     string           `json:"to"`
 	Amount wasmvmtypes.Coin `json:"amount"`
 }
+
+// BlockBeforeSendV2SudoMsg is sent once per transfer to the contracts
+// registered with BeforeSendHookVersionV2, along with all the coins of the
+// transfer whose denoms are hooked to the contract.
+type BlockBeforeSendV2SudoMsg struct {
+	BlockBeforeSendV2 BeforeSendV2Msg `json:"block_before_send_v2"`
+}
+
+// TrackBeforeSendV2SudoMsg is sent once per transfer to the contracts
+// registered with BeforeSendHookVersionV2, along with all the coins of the
+// transfer whose denoms are hooked to the contract.
+type TrackBeforeSendV2SudoMsg struct {
+	TrackBeforeSendV2 BeforeSendV2Msg `json:"track_before_send_v2"`
+}
+
+type BeforeSendV2Msg struct {
+	From   string            `json:"from"`
+	To     string            `json:"to"`
+	Amount wasmvmtypes.Coins `json:"amount"`
+}
@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/terra-money/core/v2/x/tokenfactory/types"
+)
+
+func TestBeforeSendHookVersionFromString(t *testing.T) {
+	for str, expected := range map[string]types.BeforeSendHookVersion{
+		"v1":                          types.BeforeSendHookVersionV1,
+		"V2":                          types.BeforeSendHookVersionV2,
+		"BEFORE_SEND_HOOK_VERSION_V2": types.BeforeSendHookVersionV2,
+	} {
+		version, err := types.BeforeSendHookVersionFromString(str)
+		require.NoError(t, err, str)
+		require.Equal(t, expected, version, str)
+	}
+
+	for _, str := range []string{"", "2", "v3"} {
+		_, err := types.BeforeSendHookVersionFromString(str)
+		require.ErrorIs(t, err, types.ErrInvalidHookVersion, str)
+	}
+}
@@ -17,6 +17,13 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgForceTransfer{}, "osmosis/tokenfactory/force-transfer", nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "osmosis/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, "osmosis/tokenfactory/set-beforesend-hook", nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, "osmosis/tokenfactory/set-max-supply", nil)
+	cdc.RegisterConcrete(&MsgSetDenomRole{}, "osmosis/tokenfactory/set-denom-role", nil)
+	cdc.RegisterConcrete(&MsgRenounceCapability{}, "osmosis/tokenfactory/renounce-capability", nil)
+	cdc.RegisterConcrete(&MsgSetFrozenAddress{}, "osmosis/tokenfactory/set-frozen-address", nil)
+	cdc.RegisterConcrete(&MsgSetDenomPaused{}, "osmosis/tokenfactory/set-denom-paused", nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHookGasLimits{}, "osmosis/tokenfactory/hook-gas-limits", nil)
+	cdc.RegisterConcrete(&MsgMintBatch{}, "osmosis/tokenfactory/mint-batch", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -27,6 +34,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgChangeAdmin{},
 		&MsgSetBeforeSendHook{},
+		&MsgSetMaxSupply{},
+		&MsgSetDenomRole{},
+		&MsgRenounceCapability{},
+		&MsgSetFrozenAddress{},
+		&MsgSetDenomPaused{},
+		&MsgSetBeforeSendHookGasLimits{},
+		&MsgMintBatch{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -23,7 +23,7 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(8, len(impls))
+	suite.Require().Equal(15, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/osmosis.tokenfactory.v1beta1.MsgForceTransfer",
 		"/osmosis.tokenfactory.v1beta1.MsgCreateDenom",
@@ -33,5 +33,12 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 		"/osmosis.tokenfactory.v1beta1.MsgSetBeforeSendHook",
 		"/osmosis.tokenfactory.v1beta1.MsgSetDenomMetadata",
 		"/osmosis.tokenfactory.v1beta1.MsgUpdateParams",
+		"/osmosis.tokenfactory.v1beta1.MsgSetMaxSupply",
+		"/osmosis.tokenfactory.v1beta1.MsgSetDenomRole",
+		"/osmosis.tokenfactory.v1beta1.MsgRenounceCapability",
+		"/osmosis.tokenfactory.v1beta1.MsgSetFrozenAddress",
+		"/osmosis.tokenfactory.v1beta1.MsgSetDenomPaused",
+		"/osmosis.tokenfactory.v1beta1.MsgSetBeforeSendHookGasLimits",
+		"/osmosis.tokenfactory.v1beta1.MsgMintBatch",
 	}, impls)
 }
@@ -1,5 +0,0 @@
-package types
-
-var (
-	TrackBeforeSendGasLimit = uint64(100_000)
-)
@@ -21,4 +21,16 @@ var (
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrBurnFromModuleAccount    = errorsmod.Register(ModuleName, 11, "burning from Module Account is not allowed")
 	ErrTrackBeforeSendOutOfGas  = errorsmod.Register(ModuleName, 12, "gas meter hit maximum limit")
+	ErrInvalidMaxSupply         = errorsmod.Register(ModuleName, 13, "invalid max supply")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 14, "max supply exceeded")
+	ErrInvalidDenomRole         = errorsmod.Register(ModuleName, 15, "invalid denom role")
+	ErrMinterAllowanceExceeded  = errorsmod.Register(ModuleName, 16, "minter allowance exceeded")
+	ErrInvalidDenomCapability   = errorsmod.Register(ModuleName, 17, "invalid denom capability")
+	ErrCapabilityRenounced      = errorsmod.Register(ModuleName, 18, "capability has been renounced")
+	ErrAddressFrozen            = errorsmod.Register(ModuleName, 19, "address is frozen")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 20, "denom transfers are paused")
+	ErrInvalidHookVersion       = errorsmod.Register(ModuleName, 21, "invalid before send hook version")
+	ErrInvalidHookGasLimit      = errorsmod.Register(ModuleName, 22, "invalid before send hook gas limit")
+	ErrInvalidMintBatch         = errorsmod.Register(ModuleName, 23, "invalid mint batch")
+	ErrHookNotContract          = errorsmod.Register(ModuleName, 24, "before send hook is not a contract")
 )
@@ -14,4 +14,24 @@ const (
 	AttributeNewAdmin              = "new_admin"
 	AttributeDenomMetadata         = "denom_metadata"
 	AttributeBeforeSendHookAddress = "before_send_hook_address"
+	AttributeBeforeSendHookVersion = "before_send_hook_version"
+	AttributeMaxSupply             = "max_supply"
+	AttributeDenomRole             = "role"
+	AttributeRoleAddress           = "address"
+	AttributeMinterAllowance       = "minter_allowance"
+	AttributeDenomCapability       = "capability"
+	AttributeFrozenAddress         = "frozen_address"
+	AttributeFrozen                = "frozen"
+	AttributePaused                = "paused"
+	AttributeTrackGasLimit         = "track_gas_limit"
+	AttributeBlockGasLimit         = "block_gas_limit"
+	AttributeConsecutiveFailures   = "consecutive_failures"
+	AttributeError                 = "error"
+	AttributeRecipientCount        = "recipient_count"
+)
+
+// event types emitted by the before send hooks
+const (
+	EventTypeBeforeSendHookFailed        = "before_send_hook_failed"
+	EventTypeBeforeSendHookTrackDisabled = "before_send_hook_track_disabled"
 )
@@ -40,11 +40,46 @@ func (gs GenesisState) Validate() error {
 			return err
 		}
 
-		if denom.AuthorityMetadata.Admin != "" {
-			_, err = sdk.AccAddressFromBech32(denom.AuthorityMetadata.Admin)
-			if err != nil {
-				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
+		err = denom.AuthorityMetadata.Validate()
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid authority metadata (%s)", err)
+		}
+
+		if !denom.MaxSupply.IsNil() && denom.MaxSupply.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidMaxSupply, "negative max supply for denom %s", denom.GetDenom())
+		}
+
+		seenFrozenAddresses := map[string]bool{}
+		for _, address := range denom.GetFrozenAddresses() {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid frozen address %s for denom %s", address, denom.GetDenom())
+			}
+			if seenFrozenAddresses[address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen address %s for denom %s", address, denom.GetDenom())
+			}
+			seenFrozenAddresses[address] = true
+		}
+
+		if denom.GetBeforeSendHookAddress() != "" {
+			if _, err := sdk.AccAddressFromBech32(denom.GetBeforeSendHookAddress()); err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "invalid before send hook address %s for denom %s", denom.GetBeforeSendHookAddress(), denom.GetDenom())
 			}
+		} else if denom.BeforeSendHookVersion != BeforeSendHookVersionV1 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "before send hook version without a before send hook for denom %s", denom.GetDenom())
+		} else if denom.BeforeSendHookTrackDisabled {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "before send hook track disabled without a before send hook for denom %s", denom.GetDenom())
+		}
+		if _, ok := BeforeSendHookVersion_name[int32(denom.BeforeSendHookVersion)]; !ok {
+			return errorsmod.Wrapf(ErrInvalidHookVersion, "version %s of denom %s", denom.BeforeSendHookVersion, denom.GetDenom())
+		}
+
+		gasLimits := denom.BeforeSendHookGasLimits
+		if gasLimits.TrackGasLimit > gs.Params.MaxBeforeSendGasLimit || gasLimits.BlockGasLimit > gs.Params.MaxBeforeSendGasLimit {
+			return errorsmod.Wrapf(ErrInvalidHookGasLimit, "gas limits of denom %s must not exceed the max before send gas limit: %d", denom.GetDenom(), gs.Params.MaxBeforeSendGasLimit)
+		}
+
+		if gs.Params.MaxBeforeSendHookFailures != 0 && denom.BeforeSendHookFailures >= gs.Params.MaxBeforeSendHookFailures {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "before send hook failures of denom %s must be lower than the max before send hook failures: %d", denom.GetDenom(), gs.Params.MaxBeforeSendHookFailures)
 		}
 	}
 
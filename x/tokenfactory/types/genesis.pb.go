@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -79,10 +80,21 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 
 // GenesisDenom defines a tokenfactory denom that is defined within genesis
 // state. The structure contains DenomAuthorityMetadata which defines the
-// denom's admin.
+// denom's admin, the denom's max supply, which is zero when the denom
+// has no max supply, the frozen addresses and paused state of the denom,
+// and the contract, version, gas limits, consecutive failures and disabled
+// track calls of its before send hook.
 type GenesisDenom struct {
-	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
-	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	Denom                       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	AuthorityMetadata           DenomAuthorityMetadata                 `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	MaxSupply                   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	FrozenAddresses             []string                               `protobuf:"bytes,4,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+	Paused                      bool                                   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	BeforeSendHookAddress       string                                 `protobuf:"bytes,6,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	BeforeSendHookVersion       BeforeSendHookVersion                  `protobuf:"varint,7,opt,name=before_send_hook_version,json=beforeSendHookVersion,proto3,enum=osmosis.tokenfactory.v1beta1.BeforeSendHookVersion" json:"before_send_hook_version,omitempty" yaml:"before_send_hook_version"`
+	BeforeSendHookGasLimits     BeforeSendHookGasLimits                `protobuf:"bytes,8,opt,name=before_send_hook_gas_limits,json=beforeSendHookGasLimits,proto3" json:"before_send_hook_gas_limits" yaml:"before_send_hook_gas_limits"`
+	BeforeSendHookFailures      uint64                                 `protobuf:"varint,9,opt,name=before_send_hook_failures,json=beforeSendHookFailures,proto3" json:"before_send_hook_failures,omitempty" yaml:"before_send_hook_failures"`
+	BeforeSendHookTrackDisabled bool                                   `protobuf:"varint,10,opt,name=before_send_hook_track_disabled,json=beforeSendHookTrackDisabled,proto3" json:"before_send_hook_track_disabled,omitempty" yaml:"before_send_hook_track_disabled"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +144,55 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetBeforeSendHookVersion() BeforeSendHookVersion {
+	if m != nil {
+		return m.BeforeSendHookVersion
+	}
+	return BeforeSendHookVersionV1
+}
+
+func (m *GenesisDenom) GetBeforeSendHookGasLimits() BeforeSendHookGasLimits {
+	if m != nil {
+		return m.BeforeSendHookGasLimits
+	}
+	return BeforeSendHookGasLimits{}
+}
+
+func (m *GenesisDenom) GetBeforeSendHookFailures() uint64 {
+	if m != nil {
+		return m.BeforeSendHookFailures
+	}
+	return 0
+}
+
+func (m *GenesisDenom) GetBeforeSendHookTrackDisabled() bool {
+	if m != nil {
+		return m.BeforeSendHookTrackDisabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "osmosis.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +203,50 @@ func init() {
 }
 
 var fileDescriptor_5749c3f71850298b = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f, 0xaa,
-	0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x81, 0xaa, 0xd5, 0x43, 0x56, 0xab, 0x07, 0x55,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa8, 0x0f, 0x62, 0x41, 0xf4, 0x48, 0x99, 0xe0,
-	0x35, 0x3f, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31, 0x25,
-	0xb1, 0x24, 0x11, 0xaa, 0x4b, 0x13, 0xaf, 0xae, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xa3, 0x94,
-	0x8e, 0x30, 0x72, 0xf1, 0xb8, 0x43, 0x9c, 0x19, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xc4, 0xc5,
-	0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xa2, 0x87, 0xcf, 0xd9, 0x7a, 0x01,
-	0x60, 0xb5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x75, 0x0a, 0x15, 0x70, 0xf1, 0x41,
-	0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x69,
-	0xe1, 0x37, 0x0b, 0xea, 0x0e, 0x17, 0x90, 0x16, 0x27, 0x59, 0x90, 0x89, 0x9f, 0xee, 0xc9, 0x8b,
-	0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x9a, 0xa7, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81, 0xf0,
-	0x8f, 0x22, 0xbc, 0x01, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x82, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x1b, 0x23,
-	0x97, 0x10, 0x3c, 0x18, 0xe3, 0x73, 0xa1, 0xe1, 0x28, 0xc1, 0x04, 0xf6, 0xbb, 0x09, 0x7e, 0xf7,
-	0x82, 0x6d, 0x72, 0x44, 0x8f, 0x03, 0x27, 0x45, 0xa8, 0xcb, 0x25, 0x21, 0xf6, 0x61, 0x9a, 0xae,
-	0x14, 0x24, 0x88, 0x11, 0x73, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x05, 0x9d, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x45, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1,
-	0xb9, 0x7e, 0x05, 0x6a, 0x8c, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x63, 0xda, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0x3e, 0x86, 0xb1, 0xa7, 0xac, 0x02, 0x00, 0x00,
+	// 684 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xd4, 0x4e,
+	0x18, 0xdf, 0xc2, 0xc2, 0x9f, 0x1d, 0x5e, 0xfe, 0x30, 0x11, 0x29, 0xa0, 0xdb, 0xb5, 0x12, 0xb2,
+	0x6c, 0x42, 0x9b, 0x05, 0x8c, 0x86, 0x1b, 0x95, 0x80, 0x26, 0x9a, 0x98, 0x62, 0x3c, 0x18, 0x93,
+	0x66, 0xba, 0x9d, 0x5d, 0x9a, 0xdd, 0x76, 0x9a, 0xce, 0x2c, 0x61, 0xbd, 0x9a, 0x78, 0xd6, 0x4f,
+	0xa0, 0x1f, 0xc6, 0x03, 0x47, 0x8e, 0xc6, 0x43, 0x63, 0xe0, 0xe2, 0xb9, 0x9f, 0xc0, 0xec, 0xcc,
+	0x2c, 0x50, 0x96, 0x6d, 0xe2, 0xa9, 0xed, 0x33, 0xbf, 0xb7, 0x67, 0xe6, 0xe9, 0x80, 0x1a, 0xa1,
+	0x01, 0xa1, 0x3e, 0x35, 0x19, 0x69, 0xe3, 0xb0, 0x89, 0x1a, 0x8c, 0xc4, 0x3d, 0xf3, 0xa4, 0xee,
+	0x62, 0x86, 0xea, 0x66, 0x0b, 0x87, 0x98, 0xfa, 0xd4, 0x88, 0x62, 0xc2, 0x08, 0x7c, 0x20, 0xb1,
+	0xc6, 0x4d, 0xac, 0x21, 0xb1, 0x2b, 0xf7, 0x5a, 0xa4, 0x45, 0x38, 0xd0, 0xec, 0xbf, 0x09, 0xce,
+	0xca, 0x4e, 0xae, 0x3e, 0xea, 0xb2, 0x63, 0x12, 0xfb, 0xac, 0xf7, 0x1a, 0x33, 0xe4, 0x21, 0x86,
+	0x24, 0xab, 0x9e, 0xcb, 0x72, 0x71, 0x93, 0xc4, 0xf8, 0x08, 0x87, 0xde, 0x0b, 0x42, 0xda, 0x92,
+	0xb2, 0x91, 0x4b, 0x89, 0x50, 0x8c, 0x02, 0xd9, 0x87, 0xfe, 0x43, 0x01, 0x33, 0x87, 0xa2, 0xb3,
+	0x23, 0x86, 0x18, 0x86, 0x16, 0x98, 0x14, 0x00, 0x55, 0xa9, 0x28, 0xd5, 0xe9, 0xad, 0x35, 0x23,
+	0xaf, 0x53, 0xe3, 0x0d, 0xc7, 0x5a, 0xc5, 0xb3, 0x44, 0x2b, 0xd8, 0x92, 0x09, 0x23, 0x30, 0x27,
+	0x71, 0x8e, 0x87, 0x43, 0x12, 0x50, 0x75, 0xac, 0x32, 0x5e, 0x9d, 0xde, 0xaa, 0xe5, 0x6b, 0xc9,
+	0x1c, 0xfb, 0x7d, 0x8a, 0xf5, 0xb0, 0xaf, 0x98, 0x26, 0xda, 0x62, 0x0f, 0x05, 0x9d, 0x5d, 0x3d,
+	0xab, 0xa7, 0xdb, 0xb3, 0xb2, 0xb0, 0x2f, 0xbe, 0x3f, 0x4d, 0x5d, 0xb5, 0xc1, 0x2b, 0x70, 0x1d,
+	0x4c, 0x70, 0x28, 0xef, 0xa2, 0x64, 0xcd, 0xa7, 0x89, 0x36, 0x23, 0x94, 0x78, 0x59, 0xb7, 0xc5,
+	0x32, 0xfc, 0xac, 0x00, 0x78, 0xb5, 0xf3, 0x4e, 0x20, 0xb7, 0x5e, 0x1d, 0xe3, 0xbd, 0xef, 0xe4,
+	0xe7, 0xe5, 0x4e, 0x7b, 0xb7, 0x8f, 0xcd, 0x7a, 0x24, 0x93, 0x2f, 0x0b, 0xbf, 0x61, 0x75, 0xdd,
+	0x5e, 0x18, 0x3a, 0x6c, 0xe8, 0x02, 0x10, 0xa0, 0x53, 0x87, 0x76, 0xa3, 0xa8, 0xd3, 0x53, 0xc7,
+	0x79, 0xea, 0xe7, 0x7d, 0xa5, 0x5f, 0x89, 0xb6, 0xde, 0xf2, 0xd9, 0x71, 0xd7, 0x35, 0x1a, 0x24,
+	0x30, 0x1b, 0x3c, 0x92, 0x7c, 0x6c, 0x52, 0xaf, 0x6d, 0xb2, 0x5e, 0x84, 0xa9, 0xf1, 0x32, 0x64,
+	0x69, 0xa2, 0x2d, 0x08, 0xcf, 0x6b, 0x25, 0xdd, 0x2e, 0x05, 0xe8, 0xf4, 0x88, 0xbf, 0xc3, 0x03,
+	0x30, 0xdf, 0x8c, 0xc9, 0x47, 0x1c, 0x3a, 0xc8, 0xf3, 0x62, 0x4c, 0x29, 0xa6, 0x6a, 0xb1, 0x32,
+	0x5e, 0x2d, 0x59, 0xab, 0x69, 0xa2, 0x2d, 0xc9, 0x9d, 0xbe, 0x85, 0xd0, 0xed, 0xff, 0x45, 0x69,
+	0x6f, 0x50, 0x81, 0x1b, 0xfd, 0x19, 0xe9, 0x52, 0xec, 0xa9, 0x13, 0x15, 0xa5, 0x3a, 0x65, 0x2d,
+	0xa4, 0x89, 0x36, 0x2b, 0xd8, 0xa2, 0xae, 0xdb, 0x12, 0x00, 0x3f, 0x00, 0x55, 0x8c, 0xa8, 0x43,
+	0x71, 0xe8, 0x39, 0xc7, 0x84, 0xb4, 0x07, 0xd2, 0xea, 0x24, 0x6f, 0xf2, 0x71, 0x9a, 0x68, 0x9a,
+	0x20, 0x8f, 0x42, 0xea, 0xf6, 0x62, 0x76, 0xce, 0x65, 0x14, 0xf8, 0x55, 0xb9, 0x43, 0xfe, 0x04,
+	0xc7, 0xd4, 0x27, 0xa1, 0xfa, 0x5f, 0x45, 0xa9, 0xce, 0x6d, 0x6d, 0xe7, 0x9f, 0xa1, 0x95, 0xd1,
+	0x7d, 0x27, 0xa8, 0xb9, 0x99, 0xa4, 0xfc, 0x50, 0x26, 0xc9, 0x85, 0xdf, 0x14, 0xb0, 0x3a, 0x44,
+	0x6a, 0x21, 0xea, 0x74, 0xfc, 0xc0, 0x67, 0x54, 0x9d, 0xe2, 0xa3, 0xf5, 0xe4, 0x5f, 0x62, 0x1d,
+	0x22, 0xfa, 0x8a, 0x93, 0xad, 0x9a, 0x9c, 0x2d, 0x7d, 0x44, 0xb8, 0x6b, 0x1f, 0xdd, 0x5e, 0x72,
+	0xef, 0x16, 0x81, 0x0e, 0x58, 0x1e, 0x22, 0x36, 0x91, 0xdf, 0xe9, 0xc6, 0x98, 0xaa, 0xa5, 0x8a,
+	0x52, 0x2d, 0x5a, 0x6b, 0x69, 0xa2, 0x55, 0x46, 0x78, 0x0c, 0xa0, 0xba, 0x7d, 0x3f, 0xeb, 0x70,
+	0x20, 0x17, 0x60, 0x04, 0xb4, 0x21, 0x16, 0x8b, 0x51, 0xa3, 0xed, 0x78, 0x3e, 0x45, 0x6e, 0x07,
+	0x7b, 0x2a, 0xe0, 0x83, 0x53, 0x4b, 0x13, 0x6d, 0x7d, 0x84, 0x4d, 0x96, 0xa0, 0xdb, 0xab, 0x59,
+	0xb3, 0xb7, 0xfd, 0xe5, 0x7d, 0xb9, 0xba, 0x5b, 0xfc, 0xf3, 0x5d, 0x53, 0x2c, 0xfb, 0xec, 0xa2,
+	0xac, 0x9c, 0x5f, 0x94, 0x95, 0xdf, 0x17, 0x65, 0xe5, 0xcb, 0x65, 0xb9, 0x70, 0x7e, 0x59, 0x2e,
+	0xfc, 0xbc, 0x2c, 0x17, 0xde, 0x3f, 0xbb, 0xf1, 0x07, 0xc9, 0x8d, 0xdf, 0xec, 0x20, 0x97, 0x0e,
+	0x3e, 0xcc, 0x93, 0xfa, 0x53, 0xf3, 0x34, 0x7b, 0x5f, 0xf2, 0xff, 0xca, 0x9d, 0xe4, 0xf7, 0xe4,
+	0xf6, 0xdf, 0x01, 0x00, 0x67, 0xad, 0x4d, 0x25, 0x1d, 0x06, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +274,35 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	if len(this.FrozenAddresses) != len(that1.FrozenAddresses) {
+		return false
+	}
+	for i := range this.FrozenAddresses {
+		if this.FrozenAddresses[i] != that1.FrozenAddresses[i] {
+			return false
+		}
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if this.BeforeSendHookVersion != that1.BeforeSendHookVersion {
+		return false
+	}
+	if !this.BeforeSendHookGasLimits.Equal(&that1.BeforeSendHookGasLimits) {
+		return false
+	}
+	if this.BeforeSendHookFailures != that1.BeforeSendHookFailures {
+		return false
+	}
+	if this.BeforeSendHookTrackDisabled != that1.BeforeSendHookTrackDisabled {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +372,72 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BeforeSendHookTrackDisabled {
+		i--
+		if m.BeforeSendHookTrackDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BeforeSendHookFailures != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BeforeSendHookFailures))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.BeforeSendHookGasLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.BeforeSendHookVersion != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BeforeSendHookVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +498,32 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BeforeSendHookVersion != 0 {
+		n += 1 + sovGenesis(uint64(m.BeforeSendHookVersion))
+	}
+	l = m.BeforeSendHookGasLimits.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BeforeSendHookFailures != 0 {
+		n += 1 + sovGenesis(uint64(m.BeforeSendHookFailures))
+	}
+	if m.BeforeSendHookTrackDisabled {
+		n += 2
+	}
 	return n
 }
 
@@ -542,6 +744,215 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookVersion", wireType)
+			}
+			m.BeforeSendHookVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookVersion |= BeforeSendHookVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BeforeSendHookGasLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookFailures", wireType)
+			}
+			m.BeforeSendHookFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeforeSendHookFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookTrackDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BeforeSendHookTrackDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	appparams "github.com/terra-money/core/v2/app/params"
 	"github.com/terra-money/core/v2/x/tokenfactory/types"
 )
@@ -110,6 +112,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "valid max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						MaxSupply: sdk.NewInt(21_000_000),
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "negative max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						MaxSupply: sdk.NewInt(-1),
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "duplicate denoms",
 			genState: &types.GenesisState{
@@ -130,6 +162,173 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						FrozenAddresses: []string{"terra16jpsrgl423fqg6n0e9edllew9z0gm7rhl5300u"},
+						Paused:          true,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid frozen address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						FrozenAddresses: []string{"terra1invalid"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate frozen addresses",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						FrozenAddresses: []string{
+							"terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+							"terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook gas limits and failures",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookGasLimits: types.BeforeSendHookGasLimits{
+							TrackGasLimit: types.DefaultMaxBeforeSendGasLimit,
+							BlockGasLimit: types.DefaultMaxBeforeSendGasLimit,
+						},
+						BeforeSendHookFailures:      types.DefaultMaxBeforeSendHookFailures - 1,
+						BeforeSendHookAddress:       "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						BeforeSendHookVersion:       types.BeforeSendHookVersionV2,
+						BeforeSendHookTrackDisabled: true,
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid before send hook address",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookAddress: "terra1invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook version without before send hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookVersion: types.BeforeSendHookVersionV2,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook track disabled without before send hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookTrackDisabled: true,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown before send hook version",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookAddress: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						BeforeSendHookVersion: types.BeforeSendHookVersion(2),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook gas limit above max gas limit",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookGasLimits: types.BeforeSendHookGasLimits{
+							BlockGasLimit: types.DefaultMaxBeforeSendGasLimit + 1,
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "before send hook failures reaching max failures",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "terra19hukvr8hppdwqnx7tkaslarz5s449qahu5kp2g",
+						},
+						BeforeSendHookFailures: types.DefaultMaxBeforeSendHookFailures,
+					},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -30,6 +30,13 @@ var (
 	CreatorPrefixKey               = "creator"
 	AdminPrefixKey                 = "admin"
 	BeforeSendHookAddressPrefixKey = "beforesendhook"
+	BeforeSendHookVersionKey       = "beforesendhookversion"
+	BeforeSendHookGasLimitsKey     = "beforesendhookgaslimits"
+	BeforeSendHookFailuresKey      = "beforesendhookfailures"
+	BeforeSendHookTrackDisabledKey = "beforesendhooktrackdisabled"
+	DenomMaxSupplyKey              = "maxsupply"
+	FrozenAddressPrefixKey         = "frozen"
+	DenomPausedKey                 = "paused"
 )
 
 var ParamsKey = []byte{0x00}
@@ -40,6 +47,13 @@ func GetDenomPrefixStore(denom string) []byte {
 	return []byte(strings.Join([]string{DenomsPrefixKey, denom, ""}, KeySeparator))
 }
 
+// GetFrozenAddressesPrefix returns the store prefix where the frozen addresses
+// of a specific denom are stored. It is kept out of the denom prefix store so
+// that the keys under the denom prefix store remain a fixed set.
+func GetFrozenAddressesPrefix(denom string) []byte {
+	return []byte(strings.Join([]string{FrozenAddressPrefixKey, denom, ""}, KeySeparator))
+}
+
 // GetCreatorsPrefix returns the store prefix where the list of the denoms created by a specific
 // creator are stored
 func GetCreatorPrefix(creator string) []byte {
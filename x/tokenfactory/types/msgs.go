@@ -10,14 +10,21 @@ import (
 
 // constants
 const (
-	TypeMsgCreateDenom       = "create_denom"
-	TypeMsgMint              = "tf_mint"
-	TypeMsgBurn              = "tf_burn"
-	TypeMsgForceTransfer     = "force_transfer"
-	TypeMsgChangeAdmin       = "change_admin"
-	TypeMsgSetDenomMetadata  = "set_denom_metadata"
-	TypeMsgSetBeforeSendHook = "set_before_send_hook"
-	TypeMsgUpdateParams      = "update_params"
+	TypeMsgCreateDenom        = "create_denom"
+	TypeMsgMint               = "tf_mint"
+	TypeMsgBurn               = "tf_burn"
+	TypeMsgForceTransfer      = "force_transfer"
+	TypeMsgChangeAdmin        = "change_admin"
+	TypeMsgSetDenomMetadata   = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook  = "set_before_send_hook"
+	TypeMsgUpdateParams       = "update_params"
+	TypeMsgSetMaxSupply       = "set_max_supply"
+	TypeMsgSetDenomRole       = "set_denom_role"
+	TypeMsgRenounceCapability = "renounce_capability"
+	TypeMsgSetFrozenAddress   = "set_frozen_address"
+	TypeMsgSetDenomPaused     = "set_denom_paused"
+	TypeMsgSetHookGasLimits   = "set_before_send_hook_gas_limits"
+	TypeMsgMintBatch          = "tf_mint_batch"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -283,6 +290,17 @@ func NewMsgSetBeforeSendHook(sender string, denom string, cosmwasmAddress string
 	}
 }
 
+// NewMsgSetVersionedBeforeSendHook creates a message to set a new before send
+// hook receiving the sudo messages of the given version
+func NewMsgSetVersionedBeforeSendHook(sender string, denom string, cosmwasmAddress string, version BeforeSendHookVersion) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+		Version:         version,
+	}
+}
+
 func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
 func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
 func (m MsgSetBeforeSendHook) ValidateBasic() error {
@@ -303,6 +321,10 @@ func (m MsgSetBeforeSendHook) ValidateBasic() error {
 		return ErrInvalidDenom
 	}
 
+	if _, ok := BeforeSendHookVersion_name[int32(m.Version)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidHookVersion, "version: %s", m.Version)
+	}
+
 	return nil
 }
 
@@ -316,6 +338,337 @@ func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to set the max supply of a denom
+func NewMsgSetMaxSupply(sender string, denom string, maxSupply sdk.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidMaxSupply, "max supply must be positive")
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomRole{}
+
+// NewMsgSetDenomRole creates a message to grant a role over a denom, or to
+// revoke it when the address is empty
+func NewMsgSetDenomRole(sender string, denom string, role DenomRole, address string, minterAllowance *sdk.Int) *MsgSetDenomRole {
+	return &MsgSetDenomRole{
+		Sender:          sender,
+		Denom:           denom,
+		Role:            role,
+		Address:         address,
+		MinterAllowance: minterAllowance,
+	}
+}
+
+func (m MsgSetDenomRole) Route() string { return RouterKey }
+func (m MsgSetDenomRole) Type() string  { return TypeMsgSetDenomRole }
+func (m MsgSetDenomRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := DenomRole_name[int32(m.Role)]; !ok || m.Role == DenomRoleUnspecified {
+		return errorsmod.Wrapf(ErrInvalidDenomRole, "role: %s", m.Role)
+	}
+
+	if m.Address != "" {
+		_, err = sdk.AccAddressFromBech32(m.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid role address (%s)", err)
+		}
+	}
+
+	if m.MinterAllowance != nil {
+		if m.Role != DenomRoleMinter {
+			return errorsmod.Wrap(ErrInvalidDenomRole, "minter allowance can only be set along with the minter role")
+		}
+		if m.MinterAllowance.IsNil() || m.MinterAllowance.IsNegative() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minter allowance cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomRole) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRenounceCapability{}
+
+// NewMsgRenounceCapability creates a message to permanently renounce a
+// capability over a denom
+func NewMsgRenounceCapability(sender string, denom string, capability DenomCapability) *MsgRenounceCapability {
+	return &MsgRenounceCapability{
+		Sender:     sender,
+		Denom:      denom,
+		Capability: capability,
+	}
+}
+
+func (m MsgRenounceCapability) Route() string { return RouterKey }
+func (m MsgRenounceCapability) Type() string  { return TypeMsgRenounceCapability }
+func (m MsgRenounceCapability) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := DenomCapability_name[int32(m.Capability)]; !ok || m.Capability == DenomCapabilityUnspecified {
+		return errorsmod.Wrapf(ErrInvalidDenomCapability, "capability: %s", m.Capability)
+	}
+
+	return nil
+}
+
+func (m MsgRenounceCapability) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRenounceCapability) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetFrozenAddress{}
+
+// NewMsgSetFrozenAddress creates a message to freeze or unfreeze an address
+// for a denom
+func NewMsgSetFrozenAddress(sender string, denom string, address string, frozen bool) *MsgSetFrozenAddress {
+	return &MsgSetFrozenAddress{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetFrozenAddress) Route() string { return RouterKey }
+func (m MsgSetFrozenAddress) Type() string  { return TypeMsgSetFrozenAddress }
+func (m MsgSetFrozenAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetFrozenAddress) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetFrozenAddress) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomPaused{}
+
+// NewMsgSetDenomPaused creates a message to pause or resume the transfers
+// of a denom
+func NewMsgSetDenomPaused(sender string, denom string, paused bool) *MsgSetDenomPaused {
+	return &MsgSetDenomPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPaused) Route() string { return RouterKey }
+func (m MsgSetDenomPaused) Type() string  { return TypeMsgSetDenomPaused }
+func (m MsgSetDenomPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomPaused) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetBeforeSendHookGasLimits{}
+
+// NewMsgSetBeforeSendHookGasLimits creates a message to set the gas limits of
+// the before send hook calls of a denom
+func NewMsgSetBeforeSendHookGasLimits(sender string, denom string, trackGasLimit uint64, blockGasLimit uint64) *MsgSetBeforeSendHookGasLimits {
+	return &MsgSetBeforeSendHookGasLimits{
+		Sender:        sender,
+		Denom:         denom,
+		TrackGasLimit: trackGasLimit,
+		BlockGasLimit: blockGasLimit,
+	}
+}
+
+func (m MsgSetBeforeSendHookGasLimits) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHookGasLimits) Type() string  { return TypeMsgSetHookGasLimits }
+func (m MsgSetBeforeSendHookGasLimits) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHookGasLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHookGasLimits) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMintBatch{}
+
+// NewMsgMintBatch creates a message to mint tokens of a denom to many recipients
+func NewMsgMintBatch(sender string, denom string, recipients []MintBatchRecipient) *MsgMintBatch {
+	return &MsgMintBatch{
+		Sender:     sender,
+		Denom:      denom,
+		Recipients: recipients,
+	}
+}
+
+func (m MsgMintBatch) Route() string { return RouterKey }
+func (m MsgMintBatch) Type() string  { return TypeMsgMintBatch }
+func (m MsgMintBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if len(m.Recipients) == 0 {
+		return errorsmod.Wrap(ErrInvalidMintBatch, "no recipients")
+	}
+
+	seen := make(map[string]bool, len(m.Recipients))
+	for _, recipient := range m.Recipients {
+		_, err = sdk.AccAddressFromBech32(recipient.Address)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recipient address (%s)", err)
+		}
+
+		if seen[recipient.Address] {
+			return errorsmod.Wrapf(ErrInvalidMintBatch, "duplicate recipient: %s", recipient.Address)
+		}
+		seen[recipient.Address] = true
+
+		if recipient.Amount.IsNil() || !recipient.Amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "recipient %s: %s", recipient.Address, recipient.Amount)
+		}
+	}
+
+	return nil
+}
+
+// TotalAmount returns the sum of the amounts minted to the recipients.
+func (m MsgMintBatch) TotalAmount() sdk.Coin {
+	total := sdk.ZeroInt()
+	for _, recipient := range m.Recipients {
+		total = total.Add(recipient.Amount)
+	}
+	return sdk.NewCoin(m.Denom, total)
+}
+
+func (m MsgMintBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgMintBatch) GetSigners() []sdk.AccAddress {
+	/* #nosec */
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
@@ -402,3 +402,727 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetMaxSupply tests if valid/invalid set max supply messages are properly validated/invalidated
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(
+		addr1.String(),
+		tokenFactoryDenom,
+		sdk.NewInt(1000),
+	)
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.NewInt(-1)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdk.Int{}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetDenomRole tests if valid/invalid set denom role messages are properly validated/invalidated
+func TestMsgSetDenomRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	allowance := sdk.NewInt(1000)
+
+	// make a proper setDenomRole message
+	baseMsg := types.NewMsgSetDenomRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.DenomRoleMinter,
+		addr2.String(),
+		&allowance,
+	)
+
+	// validate setDenomRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_denom_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetDenomRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "revoke role",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Role = types.DenomRoleBurner
+				msg.Address = ""
+				msg.MinterAllowance = nil
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unspecified role",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Role = types.DenomRoleUnspecified
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Role = types.DenomRole(100)
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Address = "satoshi"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "minter allowance for another role",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				msg.Role = types.DenomRoleBurner
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative minter allowance",
+			msg: func() *types.MsgSetDenomRole {
+				msg := *baseMsg
+				negative := sdk.NewInt(-1)
+				msg.MinterAllowance = &negative
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgRenounceCapability tests if valid/invalid renounce capability messages are properly validated/invalidated
+func TestMsgRenounceCapability(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper renounceCapability message
+	baseMsg := types.NewMsgRenounceCapability(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.DenomCapabilityForceTransfer,
+	)
+
+	// validate renounceCapability message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "renounce_capability")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRenounceCapability
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRenounceCapability {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgRenounceCapability {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgRenounceCapability {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unspecified capability",
+			msg: func() *types.MsgRenounceCapability {
+				msg := *baseMsg
+				msg.Capability = types.DenomCapabilityUnspecified
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown capability",
+			msg: func() *types.MsgRenounceCapability {
+				msg := *baseMsg
+				msg.Capability = types.DenomCapability(100)
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetBeforeSendHook tests if valid/invalid set before send hook messages are properly validated/invalidated
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetVersionedBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		types.BeforeSendHookVersionV2,
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "legacy version",
+			msg: func() *types.MsgSetBeforeSendHook {
+				return types.NewMsgSetBeforeSendHook(addr1.String(), tokenFactoryDenom, addr2.String())
+			},
+			expectPass: true,
+		},
+		{
+			name: "hook removal",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown version",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Version = types.BeforeSendHookVersion(100)
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetFrozenAddress tests if valid/invalid set frozen address messages are properly validated/invalidated
+func TestMsgSetFrozenAddress(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setFrozenAddress message
+	baseMsg := types.NewMsgSetFrozenAddress(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		true,
+	)
+
+	// validate setFrozenAddress message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_frozen_address")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetFrozenAddress
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetFrozenAddress {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unfreeze",
+			msg: func() *types.MsgSetFrozenAddress {
+				msg := *baseMsg
+				msg.Frozen = false
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetFrozenAddress {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty address",
+			msg: func() *types.MsgSetFrozenAddress {
+				msg := *baseMsg
+				msg.Address = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetFrozenAddress {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetDenomPaused tests if valid/invalid set denom paused messages are properly validated/invalidated
+func TestMsgSetDenomPaused(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setDenomPaused message
+	baseMsg := types.NewMsgSetDenomPaused(
+		addr1.String(),
+		tokenFactoryDenom,
+		true,
+	)
+
+	// validate setDenomPaused message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_denom_paused")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetDenomPaused
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// TestMsgSetBeforeSendHookGasLimits tests if valid/invalid set before send hook gas limits messages are properly validated/invalidated
+func TestMsgSetBeforeSendHookGasLimits(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHookGasLimits message
+	baseMsg := types.NewMsgSetBeforeSendHookGasLimits(
+		addr1.String(),
+		tokenFactoryDenom,
+		200_000,
+		500_000,
+	)
+
+	// validate setBeforeSendHookGasLimits message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook_gas_limits")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHookGasLimits
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHookGasLimits {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "gas limits of the params",
+			msg: func() *types.MsgSetBeforeSendHookGasLimits {
+				msg := *baseMsg
+				msg.TrackGasLimit = 0
+				msg.BlockGasLimit = 0
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHookGasLimits {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHookGasLimits {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgMintBatch(t *testing.T) {
+	// generate private/public key pairs and get the respective addresses
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper mintBatch message
+	baseMsg := types.NewMsgMintBatch(
+		addr1.String(),
+		tokenFactoryDenom,
+		[]types.MintBatchRecipient{
+			{Address: addr1.String(), Amount: sdk.NewInt(100)},
+			{Address: addr2.String(), Amount: sdk.NewInt(50)},
+		},
+	)
+
+	// validate mintBatch message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "tf_mint_batch")
+	require.Equal(t, sdk.NewInt64Coin(tokenFactoryDenom, 150), baseMsg.TotalAmount())
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgMintBatch
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "no recipients",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Recipients = nil
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid recipient address",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Recipients = []types.MintBatchRecipient{{Address: "invalid", Amount: sdk.NewInt(100)}}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate recipient",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Recipients = []types.MintBatchRecipient{
+					{Address: addr2.String(), Amount: sdk.NewInt(100)},
+					{Address: addr2.String(), Amount: sdk.NewInt(50)},
+				}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Recipients = []types.MintBatchRecipient{{Address: addr2.String(), Amount: sdk.ZeroInt()}}
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: func() *types.MsgMintBatch {
+				msg := *baseMsg
+				msg.Recipients = []types.MintBatchRecipient{{Address: addr2.String(), Amount: sdk.NewInt(-1)}}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -14,6 +14,15 @@ var (
 
 	// chosen as an arbitrary large number, less than the max_gas_wanted_per_tx in config.
 	DefaultCreationGasFee = uint64(1_000_000)
+
+	DefaultTrackBeforeSendGasLimit   = uint64(100_000)
+	DefaultBlockBeforeSendGasLimit   = uint64(1_000_000)
+	DefaultMaxBeforeSendGasLimit     = uint64(2_000_000)
+	DefaultMaxBeforeSendHookFailures = uint64(10)
+
+	// MaxMintBatchGasPerRecipient bounds the gas charged per recipient of a
+	// batch mint, as much as a single transaction can reasonably consume.
+	MaxMintBatchGasPerRecipient = uint64(10_000_000)
 )
 
 // ParamTable for gamm module.
@@ -23,8 +32,12 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 func NewParams(denomCreationFee sdk.Coins, denomCreationGasConsume uint64) Params {
 	return Params{
-		DenomCreationFee:        denomCreationFee,
-		DenomCreationGasConsume: denomCreationGasConsume,
+		DenomCreationFee:          denomCreationFee,
+		DenomCreationGasConsume:   denomCreationGasConsume,
+		TrackBeforeSendGasLimit:   DefaultTrackBeforeSendGasLimit,
+		BlockBeforeSendGasLimit:   DefaultBlockBeforeSendGasLimit,
+		MaxBeforeSendGasLimit:     DefaultMaxBeforeSendGasLimit,
+		MaxBeforeSendHookFailures: DefaultMaxBeforeSendHookFailures,
 	}
 }
 
@@ -34,7 +47,11 @@ func DefaultParams() Params {
 		// For choice, see: https://github.com/osmosis-labs/osmosis/pull/4983
 		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("uluna", 10000000)),
 		/* #nosec */
-		DenomCreationGasConsume: DefaultCreationGasFee,
+		DenomCreationGasConsume:   DefaultCreationGasFee,
+		TrackBeforeSendGasLimit:   DefaultTrackBeforeSendGasLimit,
+		BlockBeforeSendGasLimit:   DefaultBlockBeforeSendGasLimit,
+		MaxBeforeSendGasLimit:     DefaultMaxBeforeSendGasLimit,
+		MaxBeforeSendHookFailures: DefaultMaxBeforeSendHookFailures,
 	}
 }
 
@@ -44,6 +61,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if p.TrackBeforeSendGasLimit > p.MaxBeforeSendGasLimit || p.BlockBeforeSendGasLimit > p.MaxBeforeSendGasLimit {
+		return fmt.Errorf("before send gas limits must not exceed the max before send gas limit: %d", p.MaxBeforeSendGasLimit)
+	}
+
+	if p.MintBatchGasPerRecipient > MaxMintBatchGasPerRecipient {
+		return fmt.Errorf("mint batch gas per recipient must not exceed %d: %d", MaxMintBatchGasPerRecipient, p.MintBatchGasPerRecipient)
+	}
+
 	return nil
 }
 
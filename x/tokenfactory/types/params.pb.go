@@ -37,6 +37,30 @@ type Params struct {
 	//
 	// See: https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// TrackBeforeSendGasLimit defines the gas limit of each track before send
+	// hook call. Track before send hooks are called on module to module sends,
+	// which are not otherwise gas metered.
+	TrackBeforeSendGasLimit uint64 `protobuf:"varint,3,opt,name=track_before_send_gas_limit,json=trackBeforeSendGasLimit,proto3" json:"track_before_send_gas_limit,omitempty" yaml:"track_before_send_gas_limit"`
+	// BlockBeforeSendGasLimit defines the gas limit of each block before send
+	// hook call. The block before send calls were only limited by the gas of
+	// the transaction before this param was introduced, so the upgrade raises
+	// it to the max gas of a block when it is higher than the default.
+	BlockBeforeSendGasLimit uint64 `protobuf:"varint,4,opt,name=block_before_send_gas_limit,json=blockBeforeSendGasLimit,proto3" json:"block_before_send_gas_limit,omitempty" yaml:"block_before_send_gas_limit"`
+	// MaxBeforeSendGasLimit defines the ceiling of the gas limits that can be
+	// set for the before send hooks of a denom, overriding the gas limits above.
+	MaxBeforeSendGasLimit uint64 `protobuf:"varint,5,opt,name=max_before_send_gas_limit,json=maxBeforeSendGasLimit,proto3" json:"max_before_send_gas_limit,omitempty" yaml:"max_before_send_gas_limit"`
+	// MaxBeforeSendHookFailures defines the number of consecutive failed track
+	// before send hook calls after which the track before send calls of the hook
+	// of a denom are disabled. The block before send calls are never disabled.
+	// Zero means that the track before send calls are never disabled.
+	MaxBeforeSendHookFailures uint64 `protobuf:"varint,6,opt,name=max_before_send_hook_failures,json=maxBeforeSendHookFailures,proto3" json:"max_before_send_hook_failures,omitempty" yaml:"max_before_send_hook_failures"`
+	// MintBatchGasPerRecipient defines the gas charged for each recipient of a
+	// MsgMintBatch, on top of the gas of the mints themselves. The before send
+	// hooks of the denom are called once per recipient and their gas is charged
+	// as well, so the cost of a batch still grows with the hook execution of each
+	// recipient. Zero means that no extra gas is charged. It must not exceed
+	// 10,000,000.
+	MintBatchGasPerRecipient uint64 `protobuf:"varint,7,opt,name=mint_batch_gas_per_recipient,json=mintBatchGasPerRecipient,proto3" json:"mint_batch_gas_per_recipient,omitempty" yaml:"mint_batch_gas_per_recipient"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -86,6 +110,41 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetTrackBeforeSendGasLimit() uint64 {
+	if m != nil {
+		return m.TrackBeforeSendGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetBlockBeforeSendGasLimit() uint64 {
+	if m != nil {
+		return m.BlockBeforeSendGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBeforeSendGasLimit() uint64 {
+	if m != nil {
+		return m.MaxBeforeSendGasLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBeforeSendHookFailures() uint64 {
+	if m != nil {
+		return m.MaxBeforeSendHookFailures
+	}
+	return 0
+}
+
+func (m *Params) GetMintBatchGasPerRecipient() uint64 {
+	if m != nil {
+		return m.MintBatchGasPerRecipient
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.tokenfactory.v1beta1.Params")
 }
@@ -95,30 +154,40 @@ func init() {
 }
 
 var fileDescriptor_cc8299d306f3ff47 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0x86, 0x3b, 0xdc, 0x1b, 0x16, 0xbd, 0x9b, 0x9b, 0xc6, 0x44, 0x20, 0x66, 0x8a, 0x5d, 0xc1,
-	0x82, 0x4e, 0x50, 0x13, 0x8d, 0x4b, 0x48, 0x74, 0x45, 0x62, 0x58, 0xba, 0x69, 0x4e, 0xcb, 0x50,
-	0x1a, 0x68, 0x0f, 0xe9, 0x0c, 0xc4, 0x3e, 0x82, 0x3b, 0x57, 0x3e, 0x84, 0x4f, 0xc2, 0x92, 0xa5,
-	0xab, 0x6a, 0xe0, 0x0d, 0x78, 0x02, 0xc3, 0x74, 0x30, 0xa0, 0xc6, 0xd5, 0xcc, 0xc9, 0xf9, 0xff,
-	0x6f, 0xfe, 0x33, 0xc7, 0x6c, 0xa2, 0x88, 0x51, 0x44, 0x82, 0x49, 0x1c, 0xf3, 0x64, 0x08, 0x81,
-	0xc4, 0x34, 0x63, 0xf3, 0xb6, 0xcf, 0x25, 0xb4, 0xd9, 0x14, 0x52, 0x88, 0x85, 0x3b, 0x4d, 0x51,
-	0xa2, 0x75, 0xa2, 0xa5, 0xee, 0xbe, 0xd4, 0xd5, 0xd2, 0xda, 0x51, 0x88, 0x21, 0x2a, 0x21, 0xdb,
-	0xde, 0x0a, 0x4f, 0xed, 0xe2, 0x57, 0x3c, 0xcc, 0xe4, 0x08, 0xd3, 0x48, 0x66, 0x3d, 0x2e, 0x61,
-	0x00, 0x12, 0xb4, 0xab, 0x1a, 0x28, 0x9b, 0x57, 0xe0, 0x8a, 0x42, 0xb7, 0x68, 0x51, 0x31, 0x1f,
-	0x04, 0xff, 0xe4, 0x04, 0x18, 0x25, 0x45, 0xdf, 0x79, 0x2c, 0x99, 0xe5, 0x3b, 0x95, 0xda, 0x7a,
-	0x26, 0xa6, 0x35, 0xe0, 0x09, 0xc6, 0x5e, 0x90, 0x72, 0x90, 0x11, 0x26, 0xde, 0x90, 0xf3, 0x0a,
-	0xa9, 0xff, 0x69, 0xfc, 0x3b, 0xab, 0xba, 0x1a, 0xbb, 0x05, 0xed, 0x86, 0x70, 0xbb, 0x18, 0x25,
-	0x9d, 0xde, 0x22, 0xb7, 0x8d, 0x4d, 0x6e, 0x57, 0x33, 0x88, 0x27, 0xd7, 0xce, 0x77, 0x84, 0xf3,
-	0xf2, 0x66, 0x37, 0xc2, 0x48, 0x8e, 0x66, 0xbe, 0x1b, 0x60, 0xac, 0x03, 0xea, 0xa3, 0x25, 0x06,
-	0x63, 0x26, 0xb3, 0x29, 0x17, 0x8a, 0x26, 0xfa, 0xff, 0x15, 0xa0, 0xab, 0xfd, 0x37, 0x9c, 0x5b,
-	0x43, 0xb3, 0xf6, 0x05, 0x1a, 0x82, 0xf0, 0x02, 0x4c, 0xc4, 0x2c, 0xe6, 0x95, 0x52, 0x9d, 0x34,
-	0xfe, 0x76, 0x9a, 0x8b, 0xdc, 0x26, 0x9b, 0xdc, 0x3e, 0xfd, 0x31, 0xc4, 0x9e, 0xde, 0xe9, 0x1f,
-	0x1f, 0x3c, 0x70, 0x0b, 0xa2, 0x5b, 0x74, 0x3a, 0xfd, 0xc5, 0x8a, 0x92, 0xe5, 0x8a, 0x92, 0xf7,
-	0x15, 0x25, 0x4f, 0x6b, 0x6a, 0x2c, 0xd7, 0xd4, 0x78, 0x5d, 0x53, 0xe3, 0xfe, 0x6a, 0x2f, 0xbd,
-	0xde, 0x50, 0x6b, 0x02, 0xbe, 0xd8, 0x15, 0x6c, 0xde, 0xbe, 0x64, 0x0f, 0x87, 0x4b, 0x53, 0x33,
-	0xf9, 0x65, 0xf5, 0xcd, 0xe7, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x24, 0x1f, 0x6c, 0xe0, 0x38,
-	0x02, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0xcf, 0x8e, 0xd2, 0x5e,
+	0x14, 0xc7, 0xe9, 0x6f, 0xe6, 0x87, 0x49, 0xdd, 0x98, 0x46, 0x43, 0xc1, 0xb1, 0xc5, 0x3a, 0x51,
+	0x66, 0x31, 0x6d, 0x50, 0x13, 0x8d, 0x4b, 0x48, 0x06, 0x17, 0x4e, 0x32, 0xa9, 0x3b, 0x17, 0x36,
+	0xb7, 0xed, 0x01, 0xae, 0xd0, 0x1e, 0x72, 0xef, 0x65, 0x02, 0xaf, 0xe0, 0xca, 0x95, 0x0f, 0xe1,
+	0x93, 0xb0, 0x9c, 0xa5, 0xab, 0x6a, 0xe0, 0x0d, 0xfa, 0x04, 0xa6, 0xb7, 0x17, 0x03, 0x08, 0xac,
+	0xe0, 0xe4, 0xfb, 0xe7, 0x73, 0x9a, 0xdc, 0xa3, 0x5f, 0x20, 0x4f, 0x90, 0x53, 0xee, 0x09, 0x1c,
+	0x41, 0xda, 0x27, 0x91, 0x40, 0x36, 0xf7, 0x6e, 0xdb, 0x21, 0x08, 0xd2, 0xf6, 0x26, 0x84, 0x91,
+	0x84, 0xbb, 0x13, 0x86, 0x02, 0x8d, 0x33, 0x65, 0x75, 0x37, 0xad, 0xae, 0xb2, 0x36, 0x1e, 0x0e,
+	0x70, 0x80, 0xd2, 0xe8, 0x15, 0xff, 0xca, 0x4c, 0xe3, 0xf5, 0xd1, 0x7a, 0x32, 0x15, 0x43, 0x64,
+	0x54, 0xcc, 0xaf, 0x41, 0x90, 0x98, 0x08, 0xa2, 0x52, 0xf5, 0x48, 0xc6, 0x82, 0xb2, 0xae, 0x1c,
+	0x94, 0x64, 0x95, 0x93, 0x17, 0x12, 0x0e, 0x7f, 0x7b, 0x22, 0xa4, 0x69, 0xa9, 0x3b, 0x5f, 0xab,
+	0x7a, 0xf5, 0x46, 0x6e, 0x6d, 0x7c, 0xd7, 0x74, 0x23, 0x86, 0x14, 0x93, 0x20, 0x62, 0x40, 0x04,
+	0xc5, 0x34, 0xe8, 0x03, 0x98, 0x5a, 0xf3, 0xa4, 0x75, 0xff, 0x65, 0xdd, 0x55, 0xb5, 0x45, 0xd1,
+	0xfa, 0x23, 0xdc, 0x2e, 0xd2, 0xb4, 0x73, 0xbd, 0xc8, 0xec, 0x4a, 0x9e, 0xd9, 0xf5, 0x39, 0x49,
+	0xc6, 0xef, 0x9c, 0x7f, 0x2b, 0x9c, 0x1f, 0xbf, 0xec, 0xd6, 0x80, 0x8a, 0xe1, 0x34, 0x74, 0x23,
+	0x4c, 0xd4, 0x82, 0xea, 0xe7, 0x92, 0xc7, 0x23, 0x4f, 0xcc, 0x27, 0xc0, 0x65, 0x1b, 0xf7, 0x1f,
+	0xc8, 0x82, 0xae, 0xca, 0x5f, 0x01, 0x18, 0x7d, 0xbd, 0xb1, 0x53, 0x3a, 0x20, 0x3c, 0x88, 0x30,
+	0xe5, 0xd3, 0x04, 0xcc, 0xff, 0x9a, 0x5a, 0xeb, 0xb4, 0x73, 0xb1, 0xc8, 0x6c, 0x2d, 0xcf, 0xec,
+	0xa7, 0x7b, 0x97, 0xd8, 0xf0, 0x3b, 0x7e, 0x6d, 0x0b, 0xd0, 0x23, 0xbc, 0x5b, 0x2a, 0x46, 0xac,
+	0x3f, 0x16, 0x8c, 0x44, 0xa3, 0x20, 0x84, 0x3e, 0x32, 0x08, 0x38, 0xa4, 0xb1, 0x8c, 0x8e, 0x69,
+	0x42, 0x85, 0x79, 0x22, 0x41, 0xcf, 0xf3, 0xcc, 0x76, 0x4a, 0xc8, 0x11, 0xb3, 0xe3, 0xd7, 0xa4,
+	0xda, 0x91, 0xe2, 0x47, 0x48, 0xe3, 0x1e, 0xe1, 0x1f, 0x0a, 0xa5, 0xa0, 0x84, 0x63, 0x3c, 0x48,
+	0x39, 0xdd, 0xa5, 0x1c, 0x31, 0x3b, 0x7e, 0x4d, 0xaa, 0x7b, 0x28, 0x9f, 0xf5, 0x7a, 0x42, 0x66,
+	0x07, 0x18, 0xff, 0x4b, 0xc6, 0x79, 0x9e, 0xd9, 0xcd, 0x92, 0x71, 0xd0, 0xea, 0xf8, 0x8f, 0x12,
+	0x32, 0xdb, 0xd3, 0xff, 0x45, 0x7f, 0xb2, 0x1b, 0x1a, 0x22, 0x8e, 0x82, 0x3e, 0xa1, 0xe3, 0x29,
+	0x03, 0x6e, 0x56, 0x25, 0xa3, 0x95, 0x67, 0xf6, 0xf9, 0x7e, 0xc6, 0x96, 0xdd, 0xf1, 0xeb, 0x5b,
+	0x9c, 0xf7, 0x88, 0xa3, 0x2b, 0xa5, 0x19, 0x03, 0xfd, 0x2c, 0xa1, 0xa9, 0x08, 0x42, 0x22, 0xa2,
+	0xa1, 0xdc, 0x6d, 0x02, 0x2c, 0x60, 0x10, 0xd1, 0x09, 0x85, 0x54, 0x98, 0xf7, 0x24, 0xea, 0x45,
+	0x9e, 0xd9, 0xcf, 0x14, 0xea, 0x88, 0xdb, 0xf1, 0xcd, 0x42, 0xee, 0x14, 0x6a, 0x8f, 0xf0, 0x1b,
+	0x60, 0xfe, 0x5a, 0xea, 0xf8, 0x8b, 0xa5, 0xa5, 0xdd, 0x2d, 0x2d, 0xed, 0xf7, 0xd2, 0xd2, 0xbe,
+	0xad, 0xac, 0xca, 0xdd, 0xca, 0xaa, 0xfc, 0x5c, 0x59, 0x95, 0x4f, 0x6f, 0x37, 0x9e, 0xaf, 0x3a,
+	0xd1, 0xcb, 0x31, 0x09, 0xf9, 0x7a, 0xf0, 0x6e, 0xdb, 0x6f, 0xbc, 0xd9, 0xf6, 0xd5, 0xca, 0x47,
+	0x1d, 0x56, 0xe5, 0x9d, 0xbd, 0xfa, 0x33, 0x00, 0xf1, 0x69, 0xc0, 0x02, 0x39, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +210,31 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintBatchGasPerRecipient != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MintBatchGasPerRecipient))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxBeforeSendHookFailures != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBeforeSendHookFailures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxBeforeSendGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBeforeSendGasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlockBeforeSendGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BlockBeforeSendGasLimit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TrackBeforeSendGasLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TrackBeforeSendGasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -189,6 +283,21 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.TrackBeforeSendGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.TrackBeforeSendGasLimit))
+	}
+	if m.BlockBeforeSendGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.BlockBeforeSendGasLimit))
+	}
+	if m.MaxBeforeSendGasLimit != 0 {
+		n += 1 + sovParams(uint64(m.MaxBeforeSendGasLimit))
+	}
+	if m.MaxBeforeSendHookFailures != 0 {
+		n += 1 + sovParams(uint64(m.MaxBeforeSendHookFailures))
+	}
+	if m.MintBatchGasPerRecipient != 0 {
+		n += 1 + sovParams(uint64(m.MintBatchGasPerRecipient))
+	}
 	return n
 }
 
@@ -280,6 +389,101 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackBeforeSendGasLimit", wireType)
+			}
+			m.TrackBeforeSendGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackBeforeSendGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBeforeSendGasLimit", wireType)
+			}
+			m.BlockBeforeSendGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockBeforeSendGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBeforeSendGasLimit", wireType)
+			}
+			m.MaxBeforeSendGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBeforeSendGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBeforeSendHookFailures", wireType)
+			}
+			m.MaxBeforeSendHookFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBeforeSendHookFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintBatchGasPerRecipient", wireType)
+			}
+			m.MintBatchGasPerRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintBatchGasPerRecipient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -15,8 +15,12 @@ func TestCreateNewParms(t *testing.T) {
 	// Creaate new params
 	params := types.NewParams(sdk.NewCoins(sdk.NewCoin("uluna", math.NewInt(100000))), 10)
 	new_expected_params := types.Params{
-		DenomCreationFee:        sdk.NewCoins(sdk.NewCoin("uluna", math.NewInt(100000))),
-		DenomCreationGasConsume: 10,
+		DenomCreationFee:          sdk.NewCoins(sdk.NewCoin("uluna", math.NewInt(100000))),
+		DenomCreationGasConsume:   10,
+		TrackBeforeSendGasLimit:   types.DefaultTrackBeforeSendGasLimit,
+		BlockBeforeSendGasLimit:   types.DefaultBlockBeforeSendGasLimit,
+		MaxBeforeSendGasLimit:     types.DefaultMaxBeforeSendGasLimit,
+		MaxBeforeSendHookFailures: types.DefaultMaxBeforeSendHookFailures,
 	}
 	require.Equal(t, new_expected_params, params)
 
@@ -26,3 +30,61 @@ func TestCreateNewParms(t *testing.T) {
 	defaultKeyTable := types.ParamKeyTable()
 	require.NotEqual(t, defaultKeyTable, paramSetPairs)
 }
+
+func TestValidateBeforeSendParams(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(*types.Params)
+		valid  bool
+	}{
+		{
+			desc:   "default params",
+			modify: func(*types.Params) {},
+			valid:  true,
+		},
+		{
+			desc:   "hooks never disabled",
+			modify: func(p *types.Params) { p.MaxBeforeSendHookFailures = 0 },
+			valid:  true,
+		},
+		{
+			desc: "zero gas limits",
+			modify: func(p *types.Params) {
+				p.TrackBeforeSendGasLimit = 0
+				p.BlockBeforeSendGasLimit = 0
+				p.MaxBeforeSendGasLimit = 0
+			},
+			valid: true,
+		},
+		{
+			desc:   "track gas limit above max gas limit",
+			modify: func(p *types.Params) { p.TrackBeforeSendGasLimit = p.MaxBeforeSendGasLimit + 1 },
+			valid:  false,
+		},
+		{
+			desc:   "block gas limit above max gas limit",
+			modify: func(p *types.Params) { p.BlockBeforeSendGasLimit = p.MaxBeforeSendGasLimit + 1 },
+			valid:  false,
+		},
+		{
+			desc:   "max mint batch gas per recipient",
+			modify: func(p *types.Params) { p.MintBatchGasPerRecipient = types.MaxMintBatchGasPerRecipient },
+			valid:  true,
+		},
+		{
+			desc:   "mint batch gas per recipient above max",
+			modify: func(p *types.Params) { p.MintBatchGasPerRecipient = types.MaxMintBatchGasPerRecipient + 1 },
+			valid:  false,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.modify(&params)
+			if tc.valid {
+				require.NoError(t, params.Validate())
+			} else {
+				require.Error(t, params.Validate())
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -343,7 +344,17 @@ func (m *QueryBeforeSendHookAddressRequest) GetDenom() string {
 // QueryBeforeSendHookAddressResponse defines the response structure for the
 // DenomBeforeSendHook gRPC query.
 type QueryBeforeSendHookAddressResponse struct {
-	CosmwasmAddress string `protobuf:"bytes,1,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
+	CosmwasmAddress string                `protobuf:"bytes,1,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
+	Version         BeforeSendHookVersion `protobuf:"varint,2,opt,name=version,proto3,enum=osmosis.tokenfactory.v1beta1.BeforeSendHookVersion" json:"version,omitempty" yaml:"version"`
+	// gas_limits are the gas limits set for the hook calls of the denom, which
+	// are zero when the gas limits of the module params are used.
+	GasLimits BeforeSendHookGasLimits `protobuf:"bytes,3,opt,name=gas_limits,json=gasLimits,proto3" json:"gas_limits" yaml:"gas_limits"`
+	// consecutive_failures is the number of consecutive failed track before
+	// send hook calls of the denom.
+	ConsecutiveFailures uint64 `protobuf:"varint,4,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty" yaml:"consecutive_failures"`
+	// track_disabled is true when the track before send hook calls of the denom
+	// were disabled after too many consecutive failures.
+	TrackDisabled bool `protobuf:"varint,5,opt,name=track_disabled,json=trackDisabled,proto3" json:"track_disabled,omitempty" yaml:"track_disabled"`
 }
 
 func (m *QueryBeforeSendHookAddressResponse) Reset()         { *m = QueryBeforeSendHookAddressResponse{} }
@@ -386,6 +397,320 @@ func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
 	return ""
 }
 
+func (m *QueryBeforeSendHookAddressResponse) GetVersion() BeforeSendHookVersion {
+	if m != nil {
+		return m.Version
+	}
+	return BeforeSendHookVersionV1
+}
+
+func (m *QueryBeforeSendHookAddressResponse) GetGasLimits() BeforeSendHookGasLimits {
+	if m != nil {
+		return m.GasLimits
+	}
+	return BeforeSendHookGasLimits{}
+}
+
+func (m *QueryBeforeSendHookAddressResponse) GetConsecutiveFailures() uint64 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *QueryBeforeSendHookAddressResponse) GetTrackDisabled() bool {
+	if m != nil {
+		return m.TrackDisabled
+	}
+	return false
+}
+
+// QueryDenomMaxSupplyRequest defines the request structure for the
+// DenomMaxSupply gRPC query.
+type QueryDenomMaxSupplyRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomMaxSupplyRequest) Reset()         { *m = QueryDenomMaxSupplyRequest{} }
+func (m *QueryDenomMaxSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMaxSupplyRequest) ProtoMessage()    {}
+func (*QueryDenomMaxSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{8}
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMaxSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMaxSupplyRequest.Merge(m, src)
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMaxSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMaxSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMaxSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryDenomMaxSupplyRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomMaxSupplyResponse defines the response structure for the
+// DenomMaxSupply gRPC query. The max supply is zero when the denom has no
+// max supply.
+type QueryDenomMaxSupplyResponse struct {
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+}
+
+func (m *QueryDenomMaxSupplyResponse) Reset()         { *m = QueryDenomMaxSupplyResponse{} }
+func (m *QueryDenomMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomMaxSupplyResponse) ProtoMessage()    {}
+func (*QueryDenomMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{9}
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomMaxSupplyResponse.Merge(m, src)
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomMaxSupplyResponse proto.InternalMessageInfo
+
+// QueryDenomFreezeListRequest defines the request structure for the
+// DenomFreezeList gRPC query.
+type QueryDenomFreezeListRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *QueryDenomFreezeListRequest) Reset()         { *m = QueryDenomFreezeListRequest{} }
+func (m *QueryDenomFreezeListRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomFreezeListRequest) ProtoMessage()    {}
+func (*QueryDenomFreezeListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{10}
+}
+func (m *QueryDenomFreezeListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomFreezeListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomFreezeListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomFreezeListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomFreezeListRequest.Merge(m, src)
+}
+func (m *QueryDenomFreezeListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomFreezeListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomFreezeListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomFreezeListRequest proto.InternalMessageInfo
+
+func (m *QueryDenomFreezeListRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// QueryDenomFreezeListResponse defines the response structure for the
+// DenomFreezeList gRPC query.
+type QueryDenomFreezeListResponse struct {
+	Paused          bool     `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	FrozenAddresses []string `protobuf:"bytes,2,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty" yaml:"frozen_addresses"`
+}
+
+func (m *QueryDenomFreezeListResponse) Reset()         { *m = QueryDenomFreezeListResponse{} }
+func (m *QueryDenomFreezeListResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomFreezeListResponse) ProtoMessage()    {}
+func (*QueryDenomFreezeListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{11}
+}
+func (m *QueryDenomFreezeListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomFreezeListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomFreezeListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomFreezeListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomFreezeListResponse.Merge(m, src)
+}
+func (m *QueryDenomFreezeListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomFreezeListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomFreezeListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomFreezeListResponse proto.InternalMessageInfo
+
+func (m *QueryDenomFreezeListResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *QueryDenomFreezeListResponse) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
+// QueryFrozenAddressRequest defines the request structure for the
+// FrozenAddress gRPC query.
+type QueryFrozenAddressRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+}
+
+func (m *QueryFrozenAddressRequest) Reset()         { *m = QueryFrozenAddressRequest{} }
+func (m *QueryFrozenAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressRequest) ProtoMessage()    {}
+func (*QueryFrozenAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{12}
+}
+func (m *QueryFrozenAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressRequest.Merge(m, src)
+}
+func (m *QueryFrozenAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryFrozenAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryFrozenAddressResponse defines the response structure for the
+// FrozenAddress gRPC query.
+type QueryFrozenAddressResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+}
+
+func (m *QueryFrozenAddressResponse) Reset()         { *m = QueryFrozenAddressResponse{} }
+func (m *QueryFrozenAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAddressResponse) ProtoMessage()    {}
+func (*QueryFrozenAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f22013ad0f72e3f, []int{13}
+}
+func (m *QueryFrozenAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFrozenAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAddressResponse.Merge(m, src)
+}
+func (m *QueryFrozenAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenAddressResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAddressResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryParamsResponse")
@@ -395,6 +720,12 @@ func init() {
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
 	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
 	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
+	proto.RegisterType((*QueryDenomMaxSupplyRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMaxSupplyRequest")
+	proto.RegisterType((*QueryDenomMaxSupplyResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomMaxSupplyResponse")
+	proto.RegisterType((*QueryDenomFreezeListRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomFreezeListRequest")
+	proto.RegisterType((*QueryDenomFreezeListResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryDenomFreezeListResponse")
+	proto.RegisterType((*QueryFrozenAddressRequest)(nil), "osmosis.tokenfactory.v1beta1.QueryFrozenAddressRequest")
+	proto.RegisterType((*QueryFrozenAddressResponse)(nil), "osmosis.tokenfactory.v1beta1.QueryFrozenAddressResponse")
 }
 
 func init() {
@@ -402,48 +733,75 @@ func init() {
 }
 
 var fileDescriptor_6f22013ad0f72e3f = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcd, 0x4e, 0x13, 0x51,
-	0x14, 0xc7, 0x3b, 0x2a, 0x35, 0x5c, 0xbf, 0xe0, 0x8a, 0x5f, 0x15, 0xa7, 0x72, 0x25, 0x04, 0x12,
-	0xec, 0x58, 0x24, 0xd1, 0x88, 0x04, 0x3a, 0x28, 0x9a, 0x20, 0x89, 0x8e, 0x2b, 0xdd, 0x34, 0xb7,
-	0x9d, 0x4b, 0x69, 0xe8, 0xcc, 0x29, 0x73, 0x6f, 0xd1, 0x86, 0xb0, 0x71, 0xe1, 0xda, 0xc4, 0xa5,
-	0xef, 0xe0, 0x73, 0xb0, 0x24, 0x61, 0xe3, 0x6a, 0xa2, 0x60, 0x7c, 0x80, 0x3e, 0x81, 0xe9, 0x9d,
-	0x53, 0x04, 0x5a, 0x26, 0x2d, 0xae, 0x3a, 0x39, 0x1f, 0xff, 0x73, 0x7e, 0xf7, 0x9c, 0x93, 0x92,
-	0x71, 0x90, 0x1e, 0xc8, 0xb2, 0xb4, 0x14, 0xac, 0x09, 0x7f, 0x85, 0x17, 0x15, 0x04, 0x75, 0x6b,
-	0x23, 0x5b, 0x10, 0x8a, 0x67, 0xad, 0xf5, 0x9a, 0x08, 0xea, 0x99, 0x6a, 0x00, 0x0a, 0xe8, 0x30,
-	0x46, 0x66, 0x0e, 0x47, 0x66, 0x30, 0x32, 0x35, 0x54, 0x82, 0x12, 0xe8, 0x40, 0xab, 0xf9, 0x15,
-	0xe5, 0xa4, 0x86, 0x4b, 0x00, 0xa5, 0x8a, 0xb0, 0x78, 0xb5, 0x6c, 0x71, 0xdf, 0x07, 0xc5, 0x55,
-	0x19, 0x7c, 0x89, 0xde, 0xe9, 0xd8, 0xda, 0xbc, 0xa6, 0x56, 0x21, 0x28, 0xab, 0xfa, 0xb2, 0x50,
-	0xdc, 0xe5, 0x8a, 0x63, 0xd6, 0x44, 0x6c, 0x56, 0x95, 0x07, 0xdc, 0xc3, 0x02, 0x6c, 0x88, 0xd0,
-	0x37, 0x4d, 0x82, 0xd7, 0xda, 0xe8, 0x88, 0xf5, 0x9a, 0x90, 0x8a, 0xbd, 0x23, 0x57, 0x8f, 0x58,
-	0x65, 0x15, 0x7c, 0x29, 0xa8, 0x4d, 0x92, 0x51, 0xf2, 0x4d, 0xe3, 0xae, 0x31, 0x7e, 0x61, 0x6a,
-	0x34, 0x13, 0x07, 0x9c, 0x89, 0xb2, 0xed, 0x73, 0xdb, 0x61, 0x3a, 0xe1, 0x60, 0x26, 0x7b, 0x45,
-	0x98, 0x96, 0x7e, 0x26, 0x7c, 0xf0, 0x72, 0xc7, 0x01, 0xb0, 0x01, 0x3a, 0x46, 0xfa, 0xdc, 0x66,
-	0x80, 0x2e, 0xd4, 0x6f, 0x0f, 0x34, 0xc2, 0xf4, 0xc5, 0x3a, 0xf7, 0x2a, 0x4f, 0x98, 0x36, 0x33,
-	0x27, 0x72, 0xb3, 0xef, 0x06, 0xb9, 0x17, 0x2b, 0x87, 0x9d, 0x7f, 0x36, 0x08, 0x3d, 0x78, 0xad,
-	0xbc, 0x87, 0x6e, 0xc4, 0x98, 0x8e, 0xc7, 0xe8, 0x2c, 0x6d, 0x8f, 0x34, 0xb1, 0x1a, 0x61, 0xfa,
-	0x56, 0xd4, 0x57, 0xbb, 0x3a, 0x73, 0x06, 0xdb, 0x06, 0xc4, 0x96, 0xc9, 0x9d, 0x7f, 0xfd, 0xca,
-	0xc5, 0x00, 0xbc, 0x85, 0x40, 0x70, 0x05, 0x41, 0x8b, 0x7c, 0x92, 0x9c, 0x2f, 0x46, 0x16, 0x64,
-	0xa7, 0x8d, 0x30, 0x7d, 0x39, 0xaa, 0x81, 0x0e, 0xe6, 0xb4, 0x42, 0xd8, 0x12, 0x31, 0x4f, 0x92,
-	0x43, 0xf2, 0x09, 0x92, 0xd4, 0x4f, 0xd5, 0x9c, 0xd9, 0xd9, 0xf1, 0x7e, 0x7b, 0xb0, 0x11, 0xa6,
-	0x2f, 0x1d, 0x7a, 0x4a, 0xc9, 0x1c, 0x0c, 0x60, 0x4b, 0x64, 0x44, 0x8b, 0xd9, 0x62, 0x05, 0x02,
-	0xf1, 0x56, 0xf8, 0xee, 0x4b, 0x80, 0xb5, 0x9c, 0xeb, 0x06, 0x42, 0xca, 0x5e, 0x27, 0x53, 0xc1,
-	0x39, 0x9f, 0x20, 0x86, 0xdd, 0x2d, 0x92, 0x81, 0x22, 0x48, 0xef, 0x03, 0x97, 0x5e, 0x9e, 0x47,
-	0x3e, 0x14, 0xbe, 0xdd, 0x08, 0xd3, 0x37, 0x10, 0xfb, 0x58, 0x04, 0x73, 0xae, 0xb4, 0x4c, 0xa8,
-	0x37, 0xb5, 0x9d, 0x24, 0x7d, 0xba, 0x1c, 0xfd, 0x66, 0x90, 0x64, 0xb4, 0x78, 0xf4, 0x41, 0xfc,
-	0x5c, 0xdb, 0xf7, 0x3e, 0x95, 0xed, 0x21, 0x23, 0x22, 0x60, 0x93, 0x9f, 0x76, 0x7f, 0x7f, 0x3d,
-	0x33, 0x46, 0x47, 0xad, 0x2e, 0x8e, 0x8e, 0xfe, 0x31, 0xc8, 0xf5, 0xce, 0xfb, 0x44, 0xe7, 0xbb,
-	0xa8, 0x1d, 0x7b, 0x34, 0xa9, 0xdc, 0x7f, 0x28, 0x20, 0xcd, 0x0b, 0x4d, 0x93, 0xa3, 0x73, 0xf1,
-	0x34, 0xd1, 0xc2, 0x58, 0x9b, 0xfa, 0x77, 0xcb, 0x6a, 0xdf, 0x7d, 0xba, 0x6b, 0x90, 0xc1, 0xb6,
-	0xa5, 0xa4, 0x33, 0xdd, 0x76, 0xd8, 0xe1, 0x32, 0x52, 0x4f, 0x4f, 0x97, 0x8c, 0x64, 0x0b, 0x9a,
-	0x6c, 0x96, 0xce, 0x74, 0x43, 0x96, 0x5f, 0x09, 0xc0, 0xcb, 0xe3, 0x91, 0x59, 0x9b, 0xf8, 0xb1,
-	0x45, 0x7f, 0x19, 0xe4, 0x5a, 0xc7, 0x85, 0xa6, 0x73, 0x5d, 0x34, 0x17, 0x77, 0x57, 0xa9, 0xf9,
-	0xd3, 0x0b, 0x20, 0xe1, 0x73, 0x4d, 0x38, 0x47, 0x67, 0x7b, 0x9a, 0x5d, 0x41, 0x6b, 0xe6, 0xa5,
-	0xf0, 0xdd, 0xfc, 0x2a, 0xc0, 0x9a, 0xed, 0x6c, 0xef, 0x99, 0xc6, 0xce, 0x9e, 0x69, 0xfc, 0xdc,
-	0x33, 0x8d, 0x2f, 0xfb, 0x66, 0x62, 0x67, 0xdf, 0x4c, 0xfc, 0xd8, 0x37, 0x13, 0xef, 0x1f, 0x97,
-	0xca, 0x6a, 0xb5, 0x56, 0xc8, 0x14, 0xc1, 0x6b, 0x95, 0xb8, 0x5f, 0xe1, 0x05, 0x79, 0x50, 0x6f,
-	0x23, 0xfb, 0xc8, 0xfa, 0x78, 0xb4, 0xaa, 0xaa, 0x57, 0x85, 0x2c, 0x24, 0xf5, 0x9f, 0xcd, 0xc3,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x44, 0xcc, 0x09, 0x4b, 0x07, 0x00, 0x00,
+	// 1081 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xdf, 0x4e, 0x1b, 0xc7,
+	0x17, 0x66, 0xf9, 0x97, 0x30, 0xf9, 0x01, 0x61, 0x42, 0x7e, 0x35, 0x0b, 0xf5, 0x92, 0x69, 0x84,
+	0x1c, 0x29, 0xf1, 0x16, 0x92, 0x0a, 0x42, 0x1a, 0x81, 0x17, 0x62, 0x5a, 0x05, 0xa4, 0x76, 0x23,
+	0x55, 0x6a, 0x6f, 0x56, 0x63, 0xef, 0xd8, 0xac, 0xec, 0xdd, 0x31, 0x3b, 0x6b, 0x8a, 0x83, 0xb8,
+	0x68, 0x2f, 0x7a, 0xdd, 0xaa, 0x97, 0x7d, 0x83, 0x5e, 0xf4, 0x31, 0xaa, 0xb4, 0x57, 0x91, 0x22,
+	0x55, 0x55, 0x2f, 0xac, 0x16, 0xaa, 0x3e, 0x80, 0x9f, 0xa0, 0xda, 0x99, 0x59, 0xfc, 0xb7, 0x2b,
+	0x2f, 0xb9, 0xb2, 0x39, 0x73, 0xce, 0x77, 0xbe, 0xef, 0xcc, 0x99, 0x73, 0x30, 0xc8, 0x50, 0xe6,
+	0x52, 0xe6, 0x30, 0x3d, 0xa0, 0x15, 0xe2, 0x95, 0x70, 0x31, 0xa0, 0x7e, 0x43, 0x3f, 0x5e, 0x2d,
+	0x90, 0x00, 0xaf, 0xea, 0x47, 0x75, 0xe2, 0x37, 0xb2, 0x35, 0x9f, 0x06, 0x14, 0x2e, 0x49, 0xcf,
+	0x6c, 0xa7, 0x67, 0x56, 0x7a, 0xaa, 0xf3, 0x65, 0x5a, 0xa6, 0xdc, 0x51, 0x0f, 0xbf, 0x89, 0x18,
+	0x75, 0xa9, 0x4c, 0x69, 0xb9, 0x4a, 0x74, 0x5c, 0x73, 0x74, 0xec, 0x79, 0x34, 0xc0, 0x81, 0x43,
+	0x3d, 0x26, 0x4f, 0x1f, 0xc5, 0xe6, 0xc6, 0xf5, 0xe0, 0x90, 0xfa, 0x4e, 0xd0, 0x38, 0x20, 0x01,
+	0xb6, 0x71, 0x80, 0x65, 0xd4, 0x6a, 0x6c, 0x54, 0x81, 0x94, 0xa8, 0x4f, 0x5e, 0x10, 0xcf, 0xfe,
+	0x88, 0xd2, 0x8a, 0x0c, 0xb9, 0x17, 0x1b, 0x52, 0xc3, 0x3e, 0x76, 0x25, 0x27, 0x34, 0x0f, 0xe0,
+	0xa7, 0xa1, 0xe8, 0x4f, 0xb8, 0xd1, 0x24, 0x47, 0x75, 0xc2, 0x02, 0xf4, 0x39, 0xb8, 0xd5, 0x65,
+	0x65, 0x35, 0xea, 0x31, 0x02, 0x0d, 0x30, 0x29, 0x82, 0x53, 0xca, 0xb2, 0x92, 0xb9, 0xb1, 0x76,
+	0x37, 0x1b, 0x57, 0xa3, 0xac, 0x88, 0x36, 0xc6, 0x5f, 0x35, 0xb5, 0x11, 0x53, 0x46, 0xa2, 0x7d,
+	0x80, 0x38, 0xf4, 0x2e, 0xf1, 0xa8, 0x9b, 0xeb, 0xd5, 0x2c, 0x09, 0xc0, 0x15, 0x30, 0x61, 0x87,
+	0x0e, 0x3c, 0xd1, 0x94, 0x71, 0xb3, 0xd5, 0xd4, 0xfe, 0xd7, 0xc0, 0x6e, 0x75, 0x13, 0x71, 0x33,
+	0x32, 0xc5, 0x31, 0xfa, 0x49, 0x01, 0xef, 0xc5, 0xc2, 0x49, 0xe6, 0xdf, 0x28, 0x00, 0x5e, 0x16,
+	0xd8, 0x72, 0xe5, 0xb1, 0x94, 0xf1, 0x28, 0x5e, 0xc6, 0x60, 0x68, 0xe3, 0x4e, 0x28, 0xab, 0xd5,
+	0xd4, 0x16, 0x04, 0xaf, 0x7e, 0x74, 0x64, 0xce, 0xf5, 0xdd, 0x29, 0x3a, 0x00, 0xef, 0xb6, 0xf9,
+	0xb2, 0xbc, 0x4f, 0xdd, 0x1d, 0x9f, 0xe0, 0x80, 0xfa, 0x91, 0xf2, 0xfb, 0xe0, 0x5a, 0x51, 0x58,
+	0xa4, 0x76, 0xd8, 0x6a, 0x6a, 0x33, 0x22, 0x87, 0x3c, 0x40, 0x66, 0xe4, 0x82, 0x9e, 0x83, 0xf4,
+	0x7f, 0xc1, 0x49, 0xe5, 0xf7, 0xc0, 0x24, 0x2f, 0x55, 0x78, 0x67, 0x63, 0x99, 0x29, 0x63, 0xae,
+	0xd5, 0xd4, 0xa6, 0x3b, 0x4a, 0xc9, 0x90, 0x29, 0x1d, 0xd0, 0x73, 0x70, 0x87, 0x83, 0x19, 0x5d,
+	0x3d, 0x95, 0xb3, 0x6d, 0x9f, 0x30, 0x96, 0xf4, 0x66, 0x7e, 0x1b, 0x03, 0x28, 0x0e, 0x4d, 0xd2,
+	0xcb, 0x83, 0x9b, 0x45, 0xca, 0xdc, 0x2f, 0x31, 0x73, 0x2d, 0x2c, 0xce, 0x24, 0xf2, 0x62, 0xab,
+	0xa9, 0xbd, 0x23, 0x75, 0xf7, 0x78, 0x20, 0x73, 0x36, 0x32, 0x49, 0x3c, 0x88, 0xc1, 0xb5, 0x63,
+	0xe2, 0x33, 0x87, 0x7a, 0xa9, 0xd1, 0x65, 0x25, 0x33, 0xb3, 0xf6, 0x30, 0xfe, 0x52, 0xbb, 0x59,
+	0x7d, 0x26, 0x42, 0x3b, 0x6b, 0x2d, 0xd1, 0x90, 0x19, 0xe1, 0x42, 0x0a, 0x40, 0x19, 0x33, 0xab,
+	0xea, 0xb8, 0x4e, 0xc0, 0x52, 0x63, 0xbc, 0x75, 0x3e, 0x48, 0x92, 0x65, 0x0f, 0xb3, 0x7d, 0x1e,
+	0x6c, 0x2c, 0xc8, 0xde, 0x99, 0x13, 0xb9, 0xda, 0xb0, 0xc8, 0x9c, 0x2a, 0x47, 0x5e, 0xd0, 0x04,
+	0xf3, 0xc5, 0xb0, 0x48, 0xc5, 0x7a, 0xe0, 0x1c, 0x13, 0xab, 0x84, 0x9d, 0x6a, 0xdd, 0x27, 0x2c,
+	0x35, 0xbe, 0xac, 0x64, 0xc6, 0x0d, 0xad, 0xd5, 0xd4, 0x16, 0xa3, 0xfa, 0xf4, 0x7b, 0x21, 0xf3,
+	0x56, 0x87, 0x39, 0x2f, 0xad, 0x70, 0x1b, 0xcc, 0x04, 0x3e, 0x2e, 0x56, 0x2c, 0xdb, 0x61, 0xb8,
+	0x50, 0x25, 0x76, 0x6a, 0x62, 0x59, 0xc9, 0x5c, 0x37, 0x16, 0x5a, 0x4d, 0xed, 0xb6, 0x40, 0xeb,
+	0x3e, 0x47, 0xe6, 0x34, 0x37, 0xec, 0x46, 0x7f, 0xef, 0x02, 0xb5, 0xdd, 0x72, 0x07, 0xf8, 0xe4,
+	0x45, 0xbd, 0x56, 0xab, 0x36, 0x92, 0xb6, 0xc7, 0x57, 0x0a, 0x58, 0x1c, 0x08, 0x23, 0xfb, 0xa2,
+	0x00, 0x80, 0x8b, 0x4f, 0x2c, 0xc6, 0xad, 0x12, 0x6c, 0x27, 0xac, 0xda, 0x1f, 0x4d, 0x6d, 0xa5,
+	0xec, 0x04, 0x87, 0xf5, 0x42, 0xb6, 0x48, 0x5d, 0xbd, 0xc8, 0xeb, 0x2f, 0x3f, 0x1e, 0x30, 0xbb,
+	0xa2, 0x07, 0x8d, 0x1a, 0x61, 0xd9, 0x8f, 0xbd, 0xa0, 0x5d, 0xdf, 0x36, 0x12, 0x32, 0xa7, 0xdc,
+	0x28, 0x17, 0x7a, 0xd6, 0x49, 0x21, 0xef, 0x13, 0xf2, 0x92, 0xec, 0x3b, 0x2c, 0x48, 0x2a, 0xe5,
+	0x3b, 0x05, 0x2c, 0x0d, 0xc6, 0x69, 0x3f, 0xc1, 0x1a, 0xae, 0x33, 0x62, 0x73, 0xa4, 0xeb, 0x9d,
+	0x4f, 0x50, 0xd8, 0x91, 0x29, 0x1d, 0xc2, 0xe7, 0x50, 0xf2, 0xe9, 0x4b, 0xe2, 0x45, 0xad, 0x4e,
+	0x58, 0x6a, 0x74, 0x79, 0xac, 0xfb, 0x39, 0xf4, 0x7a, 0x20, 0x73, 0x56, 0x98, 0x72, 0x97, 0x96,
+	0x23, 0xb0, 0xc0, 0x29, 0xe5, 0x3b, 0xed, 0x09, 0x85, 0x85, 0xa3, 0x28, 0x7a, 0x92, 0xa3, 0xbd,
+	0xa3, 0xe8, 0xf2, 0x25, 0x46, 0x2e, 0x68, 0x0f, 0xa8, 0x83, 0x52, 0xb6, 0x6b, 0x20, 0x38, 0xf6,
+	0xd7, 0x40, 0xd8, 0x91, 0x29, 0x1d, 0xd6, 0x7e, 0xbc, 0x01, 0x26, 0x38, 0x12, 0xfc, 0x41, 0x01,
+	0x93, 0x62, 0x89, 0xc0, 0xf7, 0xe3, 0x1f, 0x5a, 0xff, 0x0e, 0x53, 0x57, 0x13, 0x44, 0x08, 0x92,
+	0xe8, 0xfe, 0xd7, 0x6f, 0xfe, 0xfe, 0x7e, 0x74, 0x05, 0xde, 0xd5, 0x87, 0x58, 0xa0, 0xf0, 0x1f,
+	0x05, 0xfc, 0x7f, 0xf0, 0x6e, 0x80, 0xdb, 0x43, 0xe4, 0x8e, 0x5d, 0x80, 0x6a, 0xee, 0x2d, 0x10,
+	0xa4, 0x9a, 0x3d, 0xae, 0x26, 0x07, 0xb7, 0xe2, 0xd5, 0x88, 0xe1, 0xaf, 0x9f, 0xf2, 0xcf, 0x33,
+	0xbd, 0x7f, 0x8f, 0xc1, 0x37, 0x0a, 0x98, 0xeb, 0x5b, 0x30, 0xf0, 0xc9, 0xb0, 0x0c, 0x07, 0x6c,
+	0x39, 0xf5, 0xc3, 0xab, 0x05, 0x4b, 0x65, 0x3b, 0x5c, 0xd9, 0x53, 0xf8, 0x64, 0x18, 0x65, 0x56,
+	0xc9, 0xa7, 0xae, 0x25, 0x17, 0xa6, 0x7e, 0x2a, 0xbf, 0x9c, 0xc1, 0xbf, 0x14, 0x70, 0x7b, 0xe0,
+	0x6e, 0x82, 0x5b, 0x43, 0x90, 0x8b, 0xdb, 0x91, 0xea, 0xf6, 0xd5, 0x01, 0xa4, 0xc2, 0x67, 0x5c,
+	0xe1, 0x16, 0x7c, 0x9a, 0xe8, 0xee, 0xc4, 0x3f, 0x83, 0x16, 0x23, 0x9e, 0x6d, 0x1d, 0x52, 0x5a,
+	0x81, 0x3f, 0x2b, 0x60, 0xa6, 0x7b, 0xc0, 0xc2, 0x8d, 0x61, 0x2b, 0xdf, 0x3b, 0xda, 0xd5, 0xc7,
+	0x57, 0x88, 0x94, 0x72, 0xb6, 0xb8, 0x9c, 0xc7, 0x70, 0x3d, 0x91, 0x9c, 0xf6, 0xd8, 0x86, 0xbf,
+	0x2a, 0x60, 0xb6, 0x67, 0xbc, 0xc2, 0xa1, 0xf9, 0xf4, 0x8d, 0x76, 0x75, 0xf3, 0x2a, 0xa1, 0x52,
+	0xcb, 0x36, 0xd7, 0xb2, 0x09, 0x37, 0x12, 0x69, 0x29, 0x71, 0x20, 0xab, 0x1a, 0x12, 0xff, 0x45,
+	0x01, 0xd3, 0x5d, 0x53, 0x12, 0xae, 0x0f, 0xc1, 0x67, 0xd0, 0x28, 0x57, 0x37, 0x92, 0x07, 0xbe,
+	0x55, 0x87, 0x89, 0x11, 0xad, 0x9f, 0xca, 0xa1, 0x7f, 0x66, 0x98, 0xaf, 0xce, 0xd3, 0xca, 0xeb,
+	0xf3, 0xb4, 0xf2, 0xe7, 0x79, 0x5a, 0xf9, 0xf6, 0x22, 0x3d, 0xf2, 0xfa, 0x22, 0x3d, 0xf2, 0xfb,
+	0x45, 0x7a, 0xe4, 0x8b, 0x8d, 0x8e, 0x2d, 0x2d, 0x53, 0x3c, 0xa8, 0xe2, 0x02, 0xbb, 0xcc, 0x77,
+	0xbc, 0xba, 0xae, 0x9f, 0x74, 0x67, 0xe5, 0xbb, 0xbb, 0x30, 0xc9, 0x7f, 0x9a, 0x3c, 0xfc, 0x77,
+	0x00, 0x8a, 0x2b, 0x1a, 0xe6, 0xac, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -472,6 +830,15 @@ type QueryClient interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomMaxSupply defines a gRPC query method for getting the max supply
+	// of a denom.
+	DenomMaxSupply(ctx context.Context, in *QueryDenomMaxSupplyRequest, opts ...grpc.CallOption) (*QueryDenomMaxSupplyResponse, error)
+	// DenomFreezeList defines a gRPC query method for getting the frozen
+	// addresses of a denom and whether its transfers are paused.
+	DenomFreezeList(ctx context.Context, in *QueryDenomFreezeListRequest, opts ...grpc.CallOption) (*QueryDenomFreezeListResponse, error)
+	// FrozenAddress defines a gRPC query method for getting whether an address
+	// is frozen for a denom.
+	FrozenAddress(ctx context.Context, in *QueryFrozenAddressRequest, opts ...grpc.CallOption) (*QueryFrozenAddressResponse, error)
 }
 
 type queryClient struct {
@@ -518,6 +885,33 @@ func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBefore
 	return out, nil
 }
 
+func (c *queryClient) DenomMaxSupply(ctx context.Context, in *QueryDenomMaxSupplyRequest, opts ...grpc.CallOption) (*QueryDenomMaxSupplyResponse, error) {
+	out := new(QueryDenomMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DenomFreezeList(ctx context.Context, in *QueryDenomFreezeListRequest, opts ...grpc.CallOption) (*QueryDenomFreezeListResponse, error) {
+	out := new(QueryDenomFreezeListResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/DenomFreezeList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FrozenAddress(ctx context.Context, in *QueryFrozenAddressRequest, opts ...grpc.CallOption) (*QueryFrozenAddressResponse, error) {
+	out := new(QueryFrozenAddressResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.tokenfactory.v1beta1.Query/FrozenAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -534,6 +928,15 @@ type QueryServer interface {
 	// BeforeSendHookAddress defines a gRPC query method for
 	// getting the address registered for the before send hook.
 	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomMaxSupply defines a gRPC query method for getting the max supply
+	// of a denom.
+	DenomMaxSupply(context.Context, *QueryDenomMaxSupplyRequest) (*QueryDenomMaxSupplyResponse, error)
+	// DenomFreezeList defines a gRPC query method for getting the frozen
+	// addresses of a denom and whether its transfers are paused.
+	DenomFreezeList(context.Context, *QueryDenomFreezeListRequest) (*QueryDenomFreezeListResponse, error)
+	// FrozenAddress defines a gRPC query method for getting whether an address
+	// is frozen for a denom.
+	FrozenAddress(context.Context, *QueryFrozenAddressRequest) (*QueryFrozenAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -552,6 +955,15 @@ func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *Que
 func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
 }
+func (*UnimplementedQueryServer) DenomMaxSupply(ctx context.Context, req *QueryDenomMaxSupplyRequest) (*QueryDenomMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomMaxSupply not implemented")
+}
+func (*UnimplementedQueryServer) DenomFreezeList(ctx context.Context, req *QueryDenomFreezeListRequest) (*QueryDenomFreezeListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomFreezeList not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAddress(ctx context.Context, req *QueryFrozenAddressRequest) (*QueryFrozenAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -629,6 +1041,61 @@ func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomMaxSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomMaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomMaxSupply(ctx, req.(*QueryDenomMaxSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomFreezeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomFreezeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomFreezeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/DenomFreezeList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomFreezeList(ctx, req.(*QueryDenomFreezeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.tokenfactory.v1beta1.Query/FrozenAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAddress(ctx, req.(*QueryFrozenAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.tokenfactory.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -649,6 +1116,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BeforeSendHookAddress",
 			Handler:    _Query_BeforeSendHookAddress_Handler,
 		},
+		{
+			MethodName: "DenomMaxSupply",
+			Handler:    _Query_DenomMaxSupply_Handler,
+		},
+		{
+			MethodName: "DenomFreezeList",
+			Handler:    _Query_DenomFreezeList_Handler,
+		},
+		{
+			MethodName: "FrozenAddress",
+			Handler:    _Query_FrozenAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/tokenfactory/v1beta1/query.proto",
@@ -885,16 +1364,251 @@ func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
-	if len(m.CosmwasmAddress) > 0 {
-		i -= len(m.CosmwasmAddress)
-		copy(dAtA[i:], m.CosmwasmAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmwasmAddress)))
+	if m.TrackDisabled {
 		i--
-		dAtA[i] = 0xa
+		if m.TrackDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return len(dAtA) - i, nil
-}
-
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.GasLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CosmwasmAddress) > 0 {
+		i -= len(m.CosmwasmAddress)
+		copy(dAtA[i:], m.CosmwasmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmwasmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMaxSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMaxSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMaxSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomFreezeListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomFreezeListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomFreezeListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomFreezeListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomFreezeListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomFreezeListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -975,42 +1689,599 @@ func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	l = m.GasLimits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovQuery(uint64(m.ConsecutiveFailures))
+	}
+	if m.TrackDisabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomMaxSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomFreezeListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomFreezeListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.CosmwasmAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,12 +2304,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1060,7 +2363,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1083,15 +2386,66 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= BeforeSendHookVersion(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1118,10 +2472,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GasLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TrackDisabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1143,7 +2536,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMaxSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1166,10 +2559,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMaxSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMaxSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1225,7 +2618,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomMaxSupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1248,17 +2641,17 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomMaxSupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1268,22 +2661,23 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1308,7 +2702,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomFreezeListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1331,15 +2725,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomFreezeListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomFreezeListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1367,7 +2761,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1390,7 +2784,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomFreezeListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1413,15 +2807,35 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomFreezeListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomFreezeListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1449,7 +2863,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1472,7 +2886,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1495,10 +2909,10 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1533,6 +2947,38 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1554,7 +3000,7 @@ func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,17 +3023,17 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1597,24 +3043,12 @@ func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,76 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibchooks "github.com/cosmos/ibc-apps/modules/ibc-hooks/v7"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	customwasmkeeper "github.com/terra-money/core/v2/x/wasm/keeper"
+)
+
+var _ porttypes.IBCModule = IBCHooksMiddleware{}
+
+// IBCHooksMiddleware wraps the ibc-hooks transfer stack to collect the
+// contracts executed through the wasm memo of the received packets.
+// The ibc-hooks module executes the contracts with the default wasmd
+// message server, so without this middleware these contracts would not
+// take part in the distribution of the fees paid by the relayer.
+type IBCHooksMiddleware struct {
+	porttypes.IBCModule
+	keeper *customwasmkeeper.Keeper
+}
+
+// NewIBCHooksMiddleware creates a new IBCHooksMiddleware, the keeper
+// can be set after the middleware is created as long as it is done
+// before any packet is received.
+func NewIBCHooksMiddleware(app porttypes.IBCModule, keeper *customwasmkeeper.Keeper) IBCHooksMiddleware {
+	return IBCHooksMiddleware{
+		IBCModule: app,
+		keeper:    keeper,
+	}
+}
+
+// OnRecvPacket records the contract of the wasm memo as executed with
+// the gas consumed by the packet receipt. The contract is only recorded
+// when the acknowledgement is successful because the state changes of a
+// failed packet receipt are reverted.
+func (im IBCHooksMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	contractAddr, found := getWasmHookContract(packet)
+	if !found {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	var ack ibcexported.Acknowledgement
+	err := im.keeper.TrackContractCall(ctx, func() (string, error) {
+		ack = im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+		if ack != nil && !ack.Success() {
+			return "", nil
+		}
+		return contractAddr, nil
+	})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+// getWasmHookContract returns the address of the contract that
+// ibc-hooks executes when receiving the packet, if any.
+func getWasmHookContract(packet channeltypes.Packet) (string, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return "", false
+	}
+
+	isWasmRouted, contractAddr, _, err := ibchooks.ValidateAndParseMemo(data.GetMemo(), data.GetReceiver())
+	if !isWasmRouted || err != nil {
+		return "", false
+	}
+	return contractAddr.String(), true
+}
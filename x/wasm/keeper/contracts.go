@@ -7,7 +7,7 @@ import (
 )
 
 func (k Keeper) GetExecutedContractAddresses(ctx sdk.Context) (contracts types.ExecutedContracts, found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := ctx.TransientStore(k.tStoreKey)
 	contractAddressesKey := types.GetExecutedContractsKey()
 	b := store.Get(contractAddressesKey)
 	if b == nil {
@@ -19,7 +19,7 @@ func (k Keeper) GetExecutedContractAddresses(ctx sdk.Context) (contracts types.E
 }
 
 func (k Keeper) SetExecutedContractAddresses(ctx sdk.Context, contracts types.ExecutedContracts) error {
-	store := ctx.KVStore(k.storeKey)
+	store := ctx.TransientStore(k.tStoreKey)
 	contractAddressesKey := types.GetExecutedContractsKey()
 	b := k.cdc.MustMarshal(&contracts)
 	store.Set(contractAddressesKey, b)
@@ -27,7 +27,7 @@ func (k Keeper) SetExecutedContractAddresses(ctx sdk.Context, contracts types.Ex
 }
 
 func (k Keeper) DeleteExecutedContractAddresses(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := ctx.TransientStore(k.tStoreKey)
 	contractAddressesKey := types.GetExecutedContractsKey()
 	store.Delete(contractAddressesKey)
 }
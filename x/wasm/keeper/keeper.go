@@ -15,13 +15,15 @@ var _ keepertypes.KeeperInterface = Keeper{}
 
 type Keeper struct {
 	*wasmkeeper.Keeper
-	storeKey storetypes.StoreKey
-	cdc      codec.Codec
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.Codec
 }
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
@@ -61,33 +63,59 @@ func NewKeeper(
 	)
 
 	return Keeper{
-		Keeper:   &keeper,
-		storeKey: storeKey,
-		cdc:      cdc,
+		Keeper:    &keeper,
+		storeKey:  storeKey,
+		tStoreKey: tStoreKey,
+		cdc:       cdc,
 	}
 }
 
-// After executing the contract, get all executed
-// contract addresses from the store, if there is
-// a store already then check if the contract address
-// exists in the list, if not then update the store,
-// If the contract does not exist in the store, add it.
-func (k Keeper) AfterExecuteContract(ctx sdk.Context, msg *types.MsgExecuteContract, res *types.MsgExecuteContractResponse) error {
-	contracts, found := k.GetExecutedContractAddresses(ctx)
+// After calling a contract, get all executed
+// contract addresses from the store, if the contract
+// address does not exist in the list yet add it, then
+// accumulate the gas consumed by the contract call
+// so it can be used to weight the fee distribution.
+func (k Keeper) AfterContractCall(ctx sdk.Context, contractAddr string, gasUsed uint64) error {
+	contracts, _ := k.GetExecutedContractAddresses(ctx)
+	contracts.AddContractGas(contractAddr, gasUsed)
 
-	if found {
-		for _, contract := range contracts.ContractAddresses {
-			if contract == msg.Contract {
-				return nil
-			}
-		}
+	err := k.SetExecutedContractAddresses(ctx, contracts)
+	if err != nil {
+		return err
 	}
+	return nil
+}
 
-	contracts.ContractAddresses = append(contracts.ContractAddresses, msg.Contract)
+// GetExecutedContractsGas returns the gas already attributed
+// to the contracts executed in the current transaction.
+func (k Keeper) GetExecutedContractsGas(ctx sdk.Context) uint64 {
+	contracts, _ := k.GetExecutedContractAddresses(ctx)
+	return contracts.GetTotalGas()
+}
 
-	err := k.SetExecutedContractAddresses(ctx, contracts)
+// TrackContractCall runs the contract call and records the contract
+// it returns as executed in the current transaction. Submessages are
+// routed back through the message server, so the gas consumed by
+// nested contract calls has already been attributed to those contracts
+// and is subtracted from the gas attributed to the caller. Nothing is
+// recorded when the call returns an empty contract address.
+func (k Keeper) TrackContractCall(ctx sdk.Context, call func() (string, error)) error {
+	nestedGasBefore := k.GetExecutedContractsGas(ctx)
+	gasBefore := ctx.GasMeter().GasConsumed()
+
+	contractAddr, err := call()
 	if err != nil {
 		return err
 	}
-	return nil
+	if contractAddr == "" {
+		return nil
+	}
+
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+	nestedGas := k.GetExecutedContractsGas(ctx) - nestedGasBefore
+	if nestedGas > gasUsed {
+		nestedGas = gasUsed
+	}
+
+	return k.AfterContractCall(ctx, contractAddr, gasUsed-nestedGas)
 }
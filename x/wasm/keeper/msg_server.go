@@ -27,35 +27,69 @@ func NewCustomMsgServerImpl(k *Keeper) types.MsgServer {
 	}
 }
 
-// EexcuteContract wraps the original call but it collects
+// ExecuteContract wraps the original call but it collects
 // the addresses of contracts involved in the transaction
-func (m customMsgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (*types.MsgExecuteContractResponse, error) {
-	res, err := m.msgServer.ExecuteContract(goCtx, msg)
+// and the gas consumed by each one of them.
+func (m customMsgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteContract) (res *types.MsgExecuteContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.ExecuteContract(goCtx, msg)
+		return msg.Contract, err
+	})
 	if err != nil {
 		return nil, err
 	}
-
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	err = m.keeper.AfterExecuteContract(ctx, msg, res)
-	if err != nil {
-		return nil, err
-	}
-
 	return res, nil
 }
 
 func (m customMsgServer) StoreCode(goCtx context.Context, msg *types.MsgStoreCode) (*types.MsgStoreCodeResponse, error) {
 	return m.msgServer.StoreCode(goCtx, msg)
 }
-func (m customMsgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInstantiateContract) (*types.MsgInstantiateContractResponse, error) {
-	return m.msgServer.InstantiateContract(goCtx, msg)
+
+// InstantiateContract wraps the original call to collect the
+// address of the new contract and the gas consumed by it.
+func (m customMsgServer) InstantiateContract(goCtx context.Context, msg *types.MsgInstantiateContract) (res *types.MsgInstantiateContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.InstantiateContract(goCtx, msg)
+		if err != nil {
+			return "", err
+		}
+		return res.Address, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
-func (m customMsgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (*types.MsgInstantiateContract2Response, error) {
-	return m.msgServer.InstantiateContract2(goCtx, msg)
+
+// InstantiateContract2 wraps the original call to collect the
+// address of the new contract and the gas consumed by it.
+func (m customMsgServer) InstantiateContract2(goCtx context.Context, msg *types.MsgInstantiateContract2) (res *types.MsgInstantiateContract2Response, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.InstantiateContract2(goCtx, msg)
+		if err != nil {
+			return "", err
+		}
+		return res.Address, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
-func (m customMsgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateContract) (*types.MsgMigrateContractResponse, error) {
-	return m.msgServer.MigrateContract(goCtx, msg)
+
+// MigrateContract wraps the original call to collect the
+// address of the migrated contract and the gas consumed by it.
+func (m customMsgServer) MigrateContract(goCtx context.Context, msg *types.MsgMigrateContract) (res *types.MsgMigrateContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.MigrateContract(goCtx, msg)
+		return msg.Contract, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
+
 func (m customMsgServer) UpdateAdmin(goCtx context.Context, msg *types.MsgUpdateAdmin) (*types.MsgUpdateAdminResponse, error) {
 	return m.msgServer.UpdateAdmin(goCtx, msg)
 }
@@ -74,11 +108,34 @@ func (m customMsgServer) PinCodes(goCtx context.Context, req *types.MsgPinCodes)
 func (m customMsgServer) UnpinCodes(goCtx context.Context, req *types.MsgUnpinCodes) (*types.MsgUnpinCodesResponse, error) {
 	return m.msgServer.UnpinCodes(goCtx, req)
 }
-func (m customMsgServer) SudoContract(goCtx context.Context, req *types.MsgSudoContract) (*types.MsgSudoContractResponse, error) {
-	return m.msgServer.SudoContract(goCtx, req)
+
+// SudoContract wraps the original call to collect the
+// address of the contract and the gas consumed by it.
+func (m customMsgServer) SudoContract(goCtx context.Context, req *types.MsgSudoContract) (res *types.MsgSudoContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.SudoContract(goCtx, req)
+		return req.Contract, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
-func (m customMsgServer) StoreAndInstantiateContract(goCtx context.Context, req *types.MsgStoreAndInstantiateContract) (*types.MsgStoreAndInstantiateContractResponse, error) {
-	return m.msgServer.StoreAndInstantiateContract(goCtx, req)
+
+// StoreAndInstantiateContract wraps the original call to collect
+// the address of the new contract and the gas consumed by it.
+func (m customMsgServer) StoreAndInstantiateContract(goCtx context.Context, req *types.MsgStoreAndInstantiateContract) (res *types.MsgStoreAndInstantiateContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.StoreAndInstantiateContract(goCtx, req)
+		if err != nil {
+			return "", err
+		}
+		return res.Address, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 func (m customMsgServer) AddCodeUploadParamsAddresses(goCtx context.Context, req *types.MsgAddCodeUploadParamsAddresses) (*types.MsgAddCodeUploadParamsAddressesResponse, error) {
 	return m.msgServer.AddCodeUploadParamsAddresses(goCtx, req)
@@ -86,8 +143,18 @@ func (m customMsgServer) AddCodeUploadParamsAddresses(goCtx context.Context, req
 func (m customMsgServer) RemoveCodeUploadParamsAddresses(goCtx context.Context, req *types.MsgRemoveCodeUploadParamsAddresses) (*types.MsgRemoveCodeUploadParamsAddressesResponse, error) {
 	return m.msgServer.RemoveCodeUploadParamsAddresses(goCtx, req)
 }
-func (m customMsgServer) StoreAndMigrateContract(goCtx context.Context, req *types.MsgStoreAndMigrateContract) (*types.MsgStoreAndMigrateContractResponse, error) {
-	return m.msgServer.StoreAndMigrateContract(goCtx, req)
+
+// StoreAndMigrateContract wraps the original call to collect the
+// address of the migrated contract and the gas consumed by it.
+func (m customMsgServer) StoreAndMigrateContract(goCtx context.Context, req *types.MsgStoreAndMigrateContract) (res *types.MsgStoreAndMigrateContractResponse, err error) {
+	err = m.keeper.TrackContractCall(sdk.UnwrapSDKContext(goCtx), func() (string, error) {
+		res, err = m.msgServer.StoreAndMigrateContract(goCtx, req)
+		return req.Contract, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 func (m customMsgServer) UpdateContractLabel(goCtx context.Context, msg *types.MsgUpdateContractLabel) (*types.MsgUpdateContractLabelResponse, error) {
 	return m.msgServer.UpdateContractLabel(goCtx, msg)
@@ -0,0 +1,45 @@
+package types
+
+// AddContractGas appends the contract address to the list of executed
+// contracts if it is not there yet, and adds the gas consumed by the
+// contract execution to the contract's gas accumulator.
+func (ec *ExecutedContracts) AddContractGas(contractAddr string, gasUsed uint64) {
+	// Records created before gas was tracked don't
+	// have the gas list so pad it to keep the indexes aligned.
+	for len(ec.GasUsed) < len(ec.ContractAddresses) {
+		ec.GasUsed = append(ec.GasUsed, 0)
+	}
+
+	for i, contract := range ec.ContractAddresses {
+		if contract == contractAddr {
+			ec.GasUsed[i] += gasUsed
+			return
+		}
+	}
+
+	ec.ContractAddresses = append(ec.ContractAddresses, contractAddr)
+	ec.GasUsed = append(ec.GasUsed, gasUsed)
+}
+
+// GetContractGas returns the gas consumed by the contract
+// in the current transaction or zero if it was not tracked.
+func (ec ExecutedContracts) GetContractGas(contractAddr string) uint64 {
+	for i, contract := range ec.ContractAddresses {
+		if contract == contractAddr {
+			if i < len(ec.GasUsed) {
+				return ec.GasUsed[i]
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// GetTotalGas returns the gas consumed by all
+// the contracts tracked in the current transaction.
+func (ec ExecutedContracts) GetTotalGas() (total uint64) {
+	for _, gasUsed := range ec.GasUsed {
+		total += gasUsed
+	}
+	return total
+}
@@ -26,6 +26,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // in a specific transaction.
 type ExecutedContracts struct {
 	ContractAddresses []string `protobuf:"bytes,1,rep,name=contract_addresses,json=contractAddresses,proto3" json:"contract_addresses,omitempty"`
+	// gas_used is the gas consumed by each contract, indexed
+	// in the same order as contract_addresses.
+	GasUsed []uint64 `protobuf:"varint,2,rep,packed,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *ExecutedContracts) Reset()         { *m = ExecutedContracts{} }
@@ -68,6 +71,13 @@ func (m *ExecutedContracts) GetContractAddresses() []string {
 	return nil
 }
 
+func (m *ExecutedContracts) GetGasUsed() []uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ExecutedContracts)(nil), "terra.wasm.v1.ExecutedContracts")
 }
@@ -77,19 +87,20 @@ func init() {
 }
 
 var fileDescriptor_200f5f891d4ef8d1 = []byte{
-	// 184 bytes of a gzipped FileDescriptorProto
+	// 208 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x49, 0x2d, 0x2a,
 	0x4a, 0xd4, 0x2f, 0x4f, 0x2c, 0xce, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0xad, 0x48, 0x4d, 0x2e, 0x2d,
 	0x49, 0x4d, 0x89, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0x4a, 0x4c, 0x2e, 0x29, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x05, 0xab, 0xd3, 0x03, 0xa9, 0xd3, 0x2b, 0x33, 0x54, 0x72, 0xe2, 0x12,
+	0x2f, 0xc9, 0x17, 0xe2, 0x05, 0xab, 0xd3, 0x03, 0xa9, 0xd3, 0x2b, 0x33, 0x54, 0x8a, 0xe5, 0x12,
 	0x74, 0x85, 0x2a, 0x75, 0x86, 0xa9, 0x14, 0xd2, 0xe5, 0x12, 0x82, 0x69, 0x8b, 0x4f, 0x4c, 0x49,
 	0x29, 0x4a, 0x2d, 0x2e, 0x4e, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x12, 0x84, 0xc9,
-	0x38, 0xc2, 0x24, 0x9c, 0x5c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23,
-	0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a,
-	0x3b, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x6c, 0xaf, 0x6e, 0x6e,
-	0x7e, 0x5e, 0x6a, 0xa5, 0x7e, 0x72, 0x7e, 0x51, 0xaa, 0x7e, 0x99, 0x91, 0x7e, 0x05, 0xc4, 0xbd,
-	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x07, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0x66, 0x6b, 0xb9, 0x17, 0xca, 0x00, 0x00, 0x00,
+	0x38, 0xc2, 0x24, 0x84, 0x24, 0xb9, 0x38, 0xd2, 0x13, 0x8b, 0xe3, 0x4b, 0x8b, 0x53, 0x53, 0x24,
+	0x98, 0x14, 0x98, 0x35, 0x58, 0x82, 0xd8, 0xd3, 0x13, 0x8b, 0x43, 0x8b, 0x53, 0x53, 0x9c, 0x5c,
+	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
+	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x3b, 0x3d, 0xb3, 0x24, 0xa3,
+	0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xec, 0x24, 0xdd, 0xdc, 0xfc, 0xbc, 0xd4, 0x4a, 0xfd,
+	0xe4, 0xfc, 0xa2, 0x54, 0xfd, 0x32, 0x23, 0xfd, 0x0a, 0x88, 0x57, 0x4a, 0x2a, 0x0b, 0x52, 0x8b,
+	0x93, 0xd8, 0xc0, 0x6e, 0x37, 0x06, 0x0c, 0x00, 0xde, 0x58, 0x6a, 0x67, 0xe5, 0x00, 0x00, 0x00,
 }
 
 func (m *ExecutedContracts) Marshal() (dAtA []byte, err error) {
@@ -112,6 +123,24 @@ func (m *ExecutedContracts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasUsed) > 0 {
+		dAtA2 := make([]byte, len(m.GasUsed)*10)
+		var j1 int
+		for _, num := range m.GasUsed {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintExecutedContracts(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ContractAddresses) > 0 {
 		for iNdEx := len(m.ContractAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ContractAddresses[iNdEx])
@@ -147,6 +176,13 @@ func (m *ExecutedContracts) Size() (n int) {
 			n += 1 + l + sovExecutedContracts(uint64(l))
 		}
 	}
+	if len(m.GasUsed) > 0 {
+		l = 0
+		for _, e := range m.GasUsed {
+			l += sovExecutedContracts(uint64(e))
+		}
+		n += 1 + sovExecutedContracts(uint64(l)) + l
+	}
 	return n
 }
 
@@ -217,6 +253,82 @@ func (m *ExecutedContracts) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractAddresses = append(m.ContractAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutedContracts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.GasUsed = append(m.GasUsed, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowExecutedContracts
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthExecutedContracts
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthExecutedContracts
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GasUsed) == 0 {
+					m.GasUsed = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowExecutedContracts
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GasUsed = append(m.GasUsed, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExecutedContracts(dAtA[iNdEx:])
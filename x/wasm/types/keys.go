@@ -1,5 +1,15 @@
 package types
 
+import (
+	"github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+const (
+	// TStoreKey is the transient store key used to keep track
+	// of the contracts executed in the current transaction.
+	TStoreKey = "transient_" + types.ModuleName
+)
+
 var (
 	executedContractsKey = []byte("terra/executedContracts")
 )